@@ -0,0 +1,628 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// How far an action must be pushed to be held, e.g. by a trigger
+const actionHeldThreshold = 0.5
+
+type bindingKind int
+
+const (
+	bindingNone bindingKind = iota
+	bindingKey
+	bindingMouseButton
+	bindingGamepadButton
+	bindingGamepadAxis
+	bindingGamepadStick
+	bindingChord
+	bindingComposite
+)
+
+// An input an action is bound to, made by KeyBinding, MouseButtonBinding,
+// GamepadButtonBinding, GamepadAxisBinding, GamepadStickBinding,
+// ChordBinding or CompositeBinding. The zero Binding is bound to nothing.
+type Binding struct {
+	kind          bindingKind
+	key           Key
+	modifiers     Modifiers
+	button        MouseButton
+	gamepadButton GamepadButton
+	axes          [2]GamepadAxis // The axis, or the X and Y axes of a stick
+	deadZone      float64
+	parts         []Binding // The inputs of a chord, or the left, right, down and up inputs of a composite
+}
+
+// A keyboard key. Without modifiers, the key works whatever modifiers are
+// held. With them, exactly the given ones of Shift, Control, Alt and System
+// must be held, e.g. ModControl for either Control key or ModLControl for
+// only the left one.
+func KeyBinding(key Key, modifiers Modifiers) Binding {
+	return Binding{kind: bindingKey, key: key, modifiers: modifiers.Held()}
+}
+
+// A mouse button, which may be an abstract button like MouseButtonPrimary
+func MouseButtonBinding(button MouseButton) Binding {
+	return Binding{kind: bindingMouseButton, button: button}
+}
+
+// A button of a gamepad
+func GamepadButtonBinding(button GamepadButton) Binding {
+	return Binding{kind: bindingGamepadButton, gamepadButton: button}
+}
+
+// An axis of a gamepad, as the action's value. Positions within deadZone of
+// rest are 0, and the rest of the range is scaled to start from 0.
+func GamepadAxisBinding(axis GamepadAxis, deadZone float64) Binding {
+	return Binding{kind: bindingGamepadAxis, axes: [2]GamepadAxis{axis, axis}, deadZone: deadZone}
+}
+
+// Two axes of a gamepad, usually a stick, as the action's vector. The dead
+// zone is a circle, so diagonals aren't snapped to the axes. The Y axis is
+// flipped so that up is positive, as with CompositeBinding.
+func GamepadStickBinding(x, y GamepadAxis, deadZone float64) Binding {
+	return Binding{kind: bindingGamepadStick, axes: [2]GamepadAxis{x, y}, deadZone: deadZone}
+}
+
+// Inputs which must be held together, e.g. Shift and Space. The chord is
+// pressed when the last of them is.
+func ChordBinding(inputs ...Binding) Binding {
+	return Binding{kind: bindingChord, parts: append([]Binding(nil), inputs...)}
+}
+
+// Inputs pushing the action's vector in each direction, e.g. the W, A, S
+// and D keys. Unused directions are left as Binding{}. Diagonals are scaled
+// to a length of 1.
+func CompositeBinding(left, right, down, up Binding) Binding {
+	return Binding{kind: bindingComposite, parts: []Binding{left, right, down, up}}
+}
+
+// Can the held modifiers work a key bound with the required modifiers?
+func modifiersMatch(required, held Modifiers) bool {
+	if required == 0 {
+		return true
+	}
+
+	for _, group := range [...]Modifiers{ModShift, ModControl, ModAlt, ModSystem} {
+		want, have := required&group, held&group
+		if want == 0 && have != 0 || want != 0 && have&want == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Scale an axis position so the dead zone is 0
+func applyDeadZone(value, deadZone float64) float64 {
+	magnitude := math.Abs(value)
+	if magnitude <= deadZone {
+		return 0
+	}
+	return math.Copysign(math.Min(1, (magnitude-deadZone)/(1-deadZone)), value)
+}
+
+// Read the vector of a binding, and whether it went down this frame
+func (b *Binding) read(input *InputState, gamepad *GamepadState) (x, y float64, pressed bool) {
+	switch b.kind {
+	case bindingKey:
+		if input == nil || !modifiersMatch(b.modifiers, input.Modifiers()) {
+			break
+		}
+		if input.KeyDown(b.key) {
+			x = 1
+		}
+		pressed = input.KeyPressed(b.key)
+
+	case bindingMouseButton:
+		if input == nil {
+			break
+		}
+		if input.ButtonDown(b.button) {
+			x = 1
+		}
+		pressed = input.ButtonPressed(b.button)
+
+	case bindingGamepadButton:
+		if gamepad != nil && b.gamepadButton >= 0 && b.gamepadButton < GamepadButtonCount && gamepad.Buttons[b.gamepadButton] {
+			x = 1
+		}
+
+	case bindingGamepadAxis:
+		if gamepad != nil && b.axes[0] >= 0 && b.axes[0] < GamepadAxisCount {
+			x = applyDeadZone(gamepad.Axes[b.axes[0]], b.deadZone)
+		}
+
+	case bindingGamepadStick:
+		if gamepad == nil || b.axes[0] < 0 || b.axes[0] >= GamepadAxisCount || b.axes[1] < 0 || b.axes[1] >= GamepadAxisCount {
+			break
+		}
+		x, y = gamepad.Axes[b.axes[0]], -gamepad.Axes[b.axes[1]] // Gamepads use -1 for up
+		if magnitude := math.Hypot(x, y); magnitude != 0 {
+			scale := applyDeadZone(magnitude, b.deadZone) / magnitude
+			x, y = x*scale, y*scale
+		}
+
+	case bindingChord:
+		if len(b.parts) == 0 {
+			break
+		}
+		held, active := true, true
+		for i := range b.parts {
+			px, py, partPressed := b.parts[i].read(input, gamepad)
+			partHeld := math.Hypot(px, py) >= actionHeldThreshold
+			held = held && partHeld
+			active = active && (partHeld || partPressed)
+			pressed = pressed || partPressed
+		}
+		if held {
+			x = 1
+		}
+		pressed = pressed && active
+
+	case bindingComposite:
+		var values [4]float64
+		for i := range b.parts {
+			px, py, partPressed := b.parts[i].read(input, gamepad)
+			values[i] = math.Hypot(px, py)
+			pressed = pressed || partPressed
+		}
+		x, y = values[1]-values[0], values[3]-values[2]
+		if magnitude := math.Hypot(x, y); magnitude > 1 {
+			x, y = x/magnitude, y/magnitude
+		}
+	}
+	return
+}
+
+// The bindings inside a binding which read input directly
+func (b Binding) inputs() []Binding {
+	switch b.kind {
+	case bindingNone:
+		return nil
+	case bindingChord, bindingComposite:
+		var inputs []Binding
+		for _, part := range b.parts {
+			inputs = append(inputs, part.inputs()...)
+		}
+		return inputs
+	}
+	return []Binding{b}
+}
+
+// Can the same input work both bindings, which read input directly?
+func inputsOverlap(a, b Binding) bool {
+	switch {
+	case a.kind == bindingKey && b.kind == bindingKey:
+		// Holding the modifiers of both is the likeliest way to work both,
+		// e.g. Left Control works ModControl and ModLControl
+		held := a.modifiers | b.modifiers
+		return a.key == b.key && modifiersMatch(a.modifiers, held) && modifiersMatch(b.modifiers, held)
+
+	case a.kind == bindingMouseButton && b.kind == bindingMouseButton:
+		for _, ca := range concreteMouseButtons(a.button) {
+			for _, cb := range concreteMouseButtons(b.button) {
+				if ca == cb {
+					return true
+				}
+			}
+		}
+
+	case a.kind == bindingGamepadButton && b.kind == bindingGamepadButton:
+		return a.gamepadButton == b.gamepadButton
+
+	case (a.kind == bindingGamepadAxis || a.kind == bindingGamepadStick) && (b.kind == bindingGamepadAxis || b.kind == bindingGamepadStick):
+		for _, aa := range a.axes {
+			for _, ab := range b.axes {
+				if aa == ab {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Name modifiers, using Shift for both Shift keys and so on
+func modifierLabels(mods Modifiers) []string {
+	var labels []string
+	for i, group := range [...]Modifiers{ModShift, ModControl, ModAlt, ModSystem} {
+		switch mods & group {
+		case group:
+			labels = append(labels, [...]string{"Shift", "Control", "Alt", "System"}[i])
+		case group & (ModLShift | ModLControl | ModLAlt | ModLSystem):
+			labels = append(labels, modifierNames[2*i])
+		case group & (ModRShift | ModRControl | ModRAlt | ModRSystem):
+			labels = append(labels, modifierNames[2*i+1])
+		}
+	}
+	return labels
+}
+
+// Find a modifier by the names modifierLabels gives
+func parseModifierLabel(label string) (Modifiers, bool) {
+	for i, name := range [...]string{"Shift", "Control", "Alt", "System"} {
+		if label == name {
+			return Modifiers(3) << uint(2*i), true
+		}
+	}
+	for i, name := range modifierNames[:8] {
+		if label == name {
+			return Modifiers(1) << uint(i), true
+		}
+	}
+	return 0, false
+}
+
+func (b Binding) String() string {
+	switch b.kind {
+	case bindingKey:
+		return strings.Join(append(modifierLabels(b.modifiers), b.key.String()), "+")
+	case bindingMouseButton:
+		return "Mouse" + b.button.String()
+	case bindingGamepadButton:
+		return "Gamepad(" + b.gamepadButton.String() + ")"
+	case bindingGamepadAxis:
+		return "Gamepad(" + b.axes[0].String() + ")"
+	case bindingGamepadStick:
+		return "Gamepad(" + b.axes[0].String() + "," + b.axes[1].String() + ")"
+	case bindingChord:
+		var parts []string
+		for _, part := range b.parts {
+			parts = append(parts, part.String())
+		}
+		return "Chord(" + strings.Join(parts, " ") + ")"
+	case bindingComposite:
+		var parts []string
+		for _, part := range b.parts {
+			parts = append(parts, part.String())
+		}
+		return "Composite(" + strings.Join(parts, " ") + ")"
+	}
+	return "None"
+}
+
+// The JSON form of a binding, where exactly one of Key, MouseButton,
+// GamepadButton, GamepadAxis, Chord and Composite is set, e.g.
+//
+//	{"key": "S", "modifiers": ["Control"]}
+//	{"gamepad_axis": "leftx", "gamepad_axis_y": "lefty", "dead_zone": 0.2}
+//	{"composite": {"left": {"key": "A"}, "right": {"key": "D"}}}
+type bindingJSON struct {
+	Key           string         `json:"key,omitempty"`
+	Modifiers     []string       `json:"modifiers,omitempty"`
+	MouseButton   string         `json:"mouse_button,omitempty"`
+	GamepadButton string         `json:"gamepad_button,omitempty"`
+	GamepadAxis   string         `json:"gamepad_axis,omitempty"`
+	GamepadAxisY  string         `json:"gamepad_axis_y,omitempty"`
+	DeadZone      float64        `json:"dead_zone,omitempty"`
+	Chord         []Binding      `json:"chord,omitempty"`
+	Composite     *compositeJSON `json:"composite,omitempty"`
+}
+
+type compositeJSON struct {
+	Left  *Binding `json:"left,omitempty"`
+	Right *Binding `json:"right,omitempty"`
+	Down  *Binding `json:"down,omitempty"`
+	Up    *Binding `json:"up,omitempty"`
+}
+
+func (b Binding) MarshalJSON() ([]byte, error) {
+	var j bindingJSON
+	switch b.kind {
+	case bindingKey:
+		j.Key = b.key.String()
+		j.Modifiers = modifierLabels(b.modifiers)
+	case bindingMouseButton:
+		j.MouseButton = b.button.String()
+	case bindingGamepadButton:
+		j.GamepadButton = b.gamepadButton.String()
+	case bindingGamepadAxis, bindingGamepadStick:
+		j.GamepadAxis = b.axes[0].String()
+		if b.kind == bindingGamepadStick {
+			j.GamepadAxisY = b.axes[1].String()
+		}
+		j.DeadZone = b.deadZone
+	case bindingChord:
+		j.Chord = b.parts
+	case bindingComposite:
+		j.Composite = &compositeJSON{}
+		for i, part := range []**Binding{&j.Composite.Left, &j.Composite.Right, &j.Composite.Down, &j.Composite.Up} {
+			if b.parts[i].kind != bindingNone {
+				*part = &b.parts[i]
+			}
+		}
+	default:
+		return nil, errors.New("can't save an empty binding")
+	}
+	return json.Marshal(j)
+}
+
+// Find the index of a name in a table of names
+func lookupName(names []string, name string) (int, bool) {
+	for i, n := range names {
+		if n == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (b *Binding) UnmarshalJSON(data []byte) error {
+	var j bindingJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	inputs := 0
+	for _, set := range []bool{j.Key != "", j.MouseButton != "", j.GamepadButton != "", j.GamepadAxis != "", j.Chord != nil, j.Composite != nil} {
+		if set {
+			inputs++
+		}
+	}
+	if inputs != 1 {
+		return fmt.Errorf("binding %s must have exactly one input", data)
+	}
+	if j.Modifiers != nil && j.Key == "" {
+		return errors.New("only keys have modifiers")
+	}
+	if (j.GamepadAxisY != "" || j.DeadZone != 0) && j.GamepadAxis == "" {
+		return errors.New("only gamepad axes have a second axis or dead zone")
+	}
+
+	switch {
+	case j.Key != "":
+		key, ok := lookupName(keyNames[:], j.Key)
+		if !ok {
+			return fmt.Errorf("unknown key %q", j.Key)
+		}
+		var mods Modifiers
+		for _, label := range j.Modifiers {
+			mod, ok := parseModifierLabel(label)
+			if !ok {
+				return fmt.Errorf("unknown modifier %q", label)
+			}
+			mods |= mod
+		}
+		*b = KeyBinding(Key(key), mods)
+
+	case j.MouseButton != "":
+		button, ok := lookupName(mouseButtonNames[:], j.MouseButton)
+		if !ok {
+			return fmt.Errorf("unknown mouse button %q", j.MouseButton)
+		}
+		*b = MouseButtonBinding(MouseButton(button))
+
+	case j.GamepadButton != "":
+		button, ok := lookupName(gamepadButtonNames[:], j.GamepadButton)
+		if !ok {
+			return fmt.Errorf("unknown gamepad button %q", j.GamepadButton)
+		}
+		*b = GamepadButtonBinding(GamepadButton(button))
+
+	case j.GamepadAxis != "":
+		if j.DeadZone < 0 || j.DeadZone >= 1 {
+			return fmt.Errorf("dead zone %v isn't between 0 and 1", j.DeadZone)
+		}
+		axis, ok := lookupName(gamepadAxisNames[:], j.GamepadAxis)
+		if !ok {
+			return fmt.Errorf("unknown gamepad axis %q", j.GamepadAxis)
+		}
+		if j.GamepadAxisY == "" {
+			*b = GamepadAxisBinding(GamepadAxis(axis), j.DeadZone)
+			break
+		}
+		axisY, ok := lookupName(gamepadAxisNames[:], j.GamepadAxisY)
+		if !ok {
+			return fmt.Errorf("unknown gamepad axis %q", j.GamepadAxisY)
+		}
+		*b = GamepadStickBinding(GamepadAxis(axis), GamepadAxis(axisY), j.DeadZone)
+
+	case j.Chord != nil:
+		if len(j.Chord) < 2 {
+			return errors.New("a chord needs at least two inputs")
+		}
+		*b = ChordBinding(j.Chord...)
+
+	default:
+		var parts [4]Binding
+		for i, part := range []*Binding{j.Composite.Left, j.Composite.Right, j.Composite.Down, j.Composite.Up} {
+			if part != nil {
+				parts[i] = *part
+			}
+		}
+		*b = CompositeBinding(parts[0], parts[1], parts[2], parts[3])
+	}
+	return nil
+}
+
+// The value of an action in the last update
+type actionState struct {
+	x, y              float64
+	held              bool
+	pressed, released bool
+}
+
+// Maps named actions, e.g. "jump" or "move", to the inputs which work them.
+// Each action can have several bindings, and reads whichever is pushed the
+// furthest.
+//
+// Call Update once per frame, after updating the InputState.
+type ActionMap struct {
+	bindings map[string][]Binding
+	states   map[string]actionState
+}
+
+func NewActionMap() *ActionMap {
+	return &ActionMap{
+		bindings: make(map[string][]Binding),
+		states:   make(map[string]actionState),
+	}
+}
+
+// Read an action map saved by ActionMap.Save
+func LoadActionMap(r io.Reader) (*ActionMap, error) {
+	m := NewActionMap()
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Write the bindings as JSON, an object of arrays of bindings by action
+func (m *ActionMap) Save(w io.Writer) error {
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func (m *ActionMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.bindings)
+}
+
+// Replace the bindings with those in JSON
+func (m *ActionMap) UnmarshalJSON(data []byte) error {
+	var bindings map[string][]Binding
+	if err := json.Unmarshal(data, &bindings); err != nil {
+		return err
+	}
+	if bindings == nil {
+		bindings = make(map[string][]Binding)
+	}
+
+	m.bindings = bindings
+	m.states = make(map[string]actionState)
+	return nil
+}
+
+// Add bindings to an action
+func (m *ActionMap) Bind(action string, bindings ...Binding) {
+	m.bindings[action] = append(m.bindings[action], bindings...)
+}
+
+// Remove an action and its bindings
+func (m *ActionMap) Unbind(action string) {
+	delete(m.bindings, action)
+	delete(m.states, action)
+}
+
+// Get the bindings of an action
+func (m *ActionMap) Bindings(action string) []Binding {
+	return append([]Binding(nil), m.bindings[action]...)
+}
+
+// Get the names of the actions with bindings, sorted
+func (m *ActionMap) Actions() []string {
+	var actions []string
+	for action := range m.bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// Read the actions for a new frame. The gamepad may be nil.
+func (m *ActionMap) Update(input *InputState, gamepad *GamepadState) {
+	for action, bindings := range m.bindings {
+		previous := m.states[action]
+
+		var state actionState
+		var tapped bool
+		magnitude := 0.0
+		for i := range bindings {
+			x, y, pressed := bindings[i].read(input, gamepad)
+			if bindingMagnitude := math.Hypot(x, y); bindingMagnitude > magnitude {
+				state.x, state.y, magnitude = x, y, bindingMagnitude
+			}
+			tapped = tapped || pressed
+		}
+
+		// A tap within the frame is pressed and released at once
+		state.held = magnitude >= actionHeldThreshold
+		state.pressed = !previous.held && (state.held || tapped)
+		state.released = (previous.held || state.pressed) && !state.held
+		m.states[action] = state
+	}
+}
+
+// Check if an action is held, i.e. pushed at least halfway
+func (m *ActionMap) Held(action string) bool {
+	return m.states[action].held
+}
+
+// Check if an action started being held this frame
+func (m *ActionMap) Pressed(action string) bool {
+	return m.states[action].pressed
+}
+
+// Check if an action stopped being held this frame
+func (m *ActionMap) Released(action string) bool {
+	return m.states[action].released
+}
+
+// Get the value of a one dimensional action: 0 or 1 for buttons, and -1 to
+// 1 for axes
+func (m *ActionMap) Value(action string) float64 {
+	return m.states[action].x
+}
+
+// Get the vector of a two dimensional action, e.g. a stick or composite.
+// Its length is at most 1, and positive Y is up.
+func (m *ActionMap) Vector(action string) (x, y float64) {
+	state := m.states[action]
+	return state.x, state.y
+}
+
+// Two actions which the same input works
+type ActionConflict struct {
+	Actions  [2]string  // The actions, in order
+	Bindings [2]Binding // The conflicting binding of each action
+}
+
+func (c ActionConflict) String() string {
+	return fmt.Sprintf("%s (%s) and %s (%s)", c.Actions[0], c.Bindings[0], c.Actions[1], c.Bindings[1])
+}
+
+// Find the bindings of different actions which the same input works, e.g.
+// S for "back" and Control+S for "save"
+func (m *ActionMap) Conflicts() []ActionConflict {
+	var conflicts []ActionConflict
+	actions := m.Actions()
+	for i, a := range actions {
+		for _, b := range actions[i+1:] {
+			for _, ba := range m.bindings[a] {
+				for _, bb := range m.bindings[b] {
+					if bindingsOverlap(ba, bb) {
+						conflicts = append(conflicts, ActionConflict{
+							Actions:  [2]string{a, b},
+							Bindings: [2]Binding{ba, bb},
+						})
+					}
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+// Can the same input work both bindings?
+func bindingsOverlap(a, b Binding) bool {
+	for _, ia := range a.inputs() {
+		for _, ib := range b.inputs() {
+			if inputsOverlap(ia, ib) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func wasdBinding() Binding {
+	return CompositeBinding(KeyBinding(KeyA, 0), KeyBinding(KeyD, 0), KeyBinding(KeyS, 0), KeyBinding(KeyW, 0))
+}
+
+func TestActionMap_Update(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("jump", KeyBinding(KeySpace, 0), GamepadButtonBinding(GamepadA))
+	m.Bind("save", KeyBinding(KeyS, ModControl))
+	m.Bind("dash", ChordBinding(KeyBinding(KeyLShift, 0), KeyBinding(KeyE, 0)))
+	m.Bind("move", wasdBinding(), GamepadStickBinding(GamepadLeftX, GamepadLeftY, 0.2))
+	m.Bind("fire", MouseButtonBinding(MouseButtonPrimary), GamepadAxisBinding(GamepadRightTrigger, 0.1))
+
+	var input InputState
+	var gamepad GamepadState
+	update := func(events ...Event) {
+		input.Update(events)
+		m.Update(&input, &gamepad)
+	}
+
+	// A diagonal is scaled to a length of 1
+	update(KeyPressedEvent{Code: KeyW}, KeyPressedEvent{Code: KeyD})
+	if x, y := m.Vector("move"); math.Abs(x-math.Sqrt2/2) > 1e-9 || math.Abs(y-math.Sqrt2/2) > 1e-9 {
+		t.Errorf("expected a unit diagonal, got %v,%v", x, y)
+	}
+	if !m.Pressed("move") || !m.Held("move") {
+		t.Error("expected move to be pressed")
+	}
+
+	// The stick is pushed further than the keys, which are released
+	gamepad.Axes[GamepadLeftX] = -0.1 // Inside the dead zone
+	gamepad.Axes[GamepadLeftY] = 0.6
+	update(KeyReleasedEvent{Code: KeyW}, KeyReleasedEvent{Code: KeyD})
+	if x, y := m.Vector("move"); math.Abs(math.Hypot(x, y)-(math.Hypot(-0.1, 0.6)-0.2)/0.8) > 1e-9 || x >= 0 || y >= 0 {
+		t.Errorf("expected the stick to move down and left, got %v,%v", x, y)
+	}
+	if !m.Held("move") || m.Pressed("move") || m.Released("move") {
+		t.Error("expected move to stay held")
+	}
+
+	// The stick and the keys agree on which way is up
+	gamepad.Axes[GamepadLeftX] = 1
+	gamepad.Axes[GamepadLeftY] = -1
+	update()
+	if x, y := m.Vector("move"); x <= 0 || y <= 0 {
+		t.Errorf("expected the stick to move up and right, got %v,%v", x, y)
+	}
+	gamepad.Axes[GamepadLeftX], gamepad.Axes[GamepadLeftY] = 0, 0
+	update(KeyPressedEvent{Code: KeyW}, KeyPressedEvent{Code: KeyD})
+	if x, y := m.Vector("move"); x <= 0 || y <= 0 {
+		t.Errorf("expected W and D to move up and right, got %v,%v", x, y)
+	}
+	gamepad.Axes[GamepadLeftY] = 0.8
+	update(KeyReleasedEvent{Code: KeyW}, KeyReleasedEvent{Code: KeyD})
+
+	gamepad.Axes[GamepadLeftY] = 0.15
+	update()
+	if x, y := m.Vector("move"); x != 0 || y != 0 || !m.Released("move") {
+		t.Errorf("expected the dead zone to release move, got %v,%v", x, y)
+	}
+
+	// Modifiers must match exactly, and unmodified keys ignore them
+	update(KeyPressedEvent{Code: KeyLControl}, KeyPressedEvent{Code: KeyS})
+	if !m.Pressed("save") {
+		t.Error("expected Control+S to save")
+	}
+	if x, y := m.Vector("move"); x != 0 || y != -1 {
+		t.Errorf("expected S to move back too, got %v,%v", x, y)
+	}
+	update(KeyReleasedEvent{Code: KeyS}, KeyPressedEvent{Code: KeyLShift}, KeyPressedEvent{Code: KeyS})
+	if m.Held("save") || !m.Released("save") {
+		t.Error("expected Control+Shift+S not to save")
+	}
+	update(KeyReleasedEvent{Code: KeyS}, KeyReleasedEvent{Code: KeyLControl}, KeyReleasedEvent{Code: KeyLShift})
+
+	// A chord is pressed by its last input, and a tap within a frame is
+	// pressed and released at once
+	update(KeyPressedEvent{Code: KeyLShift})
+	if m.Held("dash") {
+		t.Error("expected half a chord not to dash")
+	}
+	update(KeyPressedEvent{Code: KeyE}, KeyReleasedEvent{Code: KeyE})
+	if !m.Pressed("dash") || !m.Released("dash") || m.Held("dash") {
+		t.Error("expected the chord to be tapped")
+	}
+	update(KeyPressedEvent{Code: KeySpace}, KeyReleasedEvent{Code: KeySpace})
+	if !m.Pressed("jump") || !m.Released("jump") || m.Held("jump") {
+		t.Error("expected jump to be tapped")
+	}
+
+	// Several bindings hold an action together
+	gamepad.Buttons[GamepadA] = true
+	update(KeyPressedEvent{Code: KeySpace})
+	update(KeyReleasedEvent{Code: KeySpace})
+	if !m.Held("jump") || m.Released("jump") {
+		t.Error("expected the gamepad to keep jump held")
+	}
+
+	gamepad.Axes[GamepadRightTrigger] = 0.55
+	update(MouseButtonPressedEvent{Button: MouseRightLH})
+	if value := m.Value("fire"); value != 1 || !m.Pressed("fire") {
+		t.Errorf("expected the primary button to fire, got %v", value)
+	}
+	update(MouseButtonReleasedEvent{Button: MouseRightLH})
+	if value := m.Value("fire"); math.Abs(value-0.5) > 1e-9 || !m.Held("fire") {
+		t.Errorf("expected the trigger to fire at 0.5, got %v", value)
+	}
+
+	if m.Held("missing") || m.Value("missing") != 0 {
+		t.Error("expected an unbound action to be idle")
+	}
+}
+
+func TestActionMap_JSON(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("jump", KeyBinding(KeySpace, 0), GamepadButtonBinding(GamepadA))
+	m.Bind("save", KeyBinding(KeyS, ModControl|ModLShift))
+	m.Bind("dash", ChordBinding(KeyBinding(KeyLShift, 0), MouseButtonBinding(MouseXButton1)))
+	m.Bind("move", wasdBinding(), GamepadStickBinding(GamepadLeftX, GamepadLeftY, 0.25))
+	m.Bind("steer", CompositeBinding(KeyBinding(KeyLeft, 0), KeyBinding(KeyRight, 0), Binding{}, Binding{}), GamepadAxisBinding(GamepadLeftX, 0.1))
+
+	var saved bytes.Buffer
+	if err := m.Save(&saved); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(saved.String(), `"modifiers": [
+				"LShift",
+				"Control"
+			]`) {
+		t.Errorf("unexpected modifiers in %s", saved.String())
+	}
+
+	loaded, err := LoadActionMap(&saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded.Actions(), m.Actions()) {
+		t.Errorf("expected actions %v, got %v", m.Actions(), loaded.Actions())
+	}
+	for _, action := range m.Actions() {
+		if !reflect.DeepEqual(loaded.Bindings(action), m.Bindings(action)) {
+			t.Errorf("%s: expected %v, got %v", action, m.Bindings(action), loaded.Bindings(action))
+		}
+	}
+
+	for _, bad := range []string{
+		`{"jump": [{}]}`,
+		`{"jump": [{"key": "Space", "gamepad_button": "a"}]}`,
+		`{"jump": [{"key": "Spacebar"}]}`,
+		`{"jump": [{"key": "Space", "modifiers": ["CapsLock"]}]}`,
+		`{"jump": [{"mouse_button": "Left", "modifiers": ["Shift"]}]}`,
+		`{"jump": [{"gamepad_axis": "leftx", "dead_zone": 1}]}`,
+		`{"jump": [{"gamepad_axis": "leftx", "gamepad_axis_y": "up"}]}`,
+		`{"jump": [{"chord": [{"key": "Space"}]}]}`,
+		`{"jump": [{"composite": {"left": {"key": "Nope"}}}]}`,
+	} {
+		if _, err := LoadActionMap(strings.NewReader(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+}
+
+func TestActionMap_Conflicts(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("back", KeyBinding(KeyS, 0))
+	m.Bind("save", KeyBinding(KeyS, ModControl))
+	m.Bind("open", KeyBinding(KeyO, ModControl))
+	m.Bind("quit", KeyBinding(KeyO, ModAlt), KeyBinding(KeyQ, ModLControl))
+	m.Bind("move", wasdBinding(), GamepadStickBinding(GamepadLeftX, GamepadLeftY, 0.2))
+	m.Bind("steer", GamepadAxisBinding(GamepadLeftX, 0))
+	m.Bind("fire", MouseButtonBinding(MouseButtonPrimary))
+	m.Bind("select", MouseButtonBinding(MouseLeftRH))
+	m.Bind("menu", MouseButtonBinding(MouseButtonSecondary))
+
+	var conflicts []string
+	for _, c := range m.Conflicts() {
+		conflicts = append(conflicts, c.String())
+	}
+	expected := []string{
+		"back (S) and move (Composite(A D S W))",
+		"back (S) and save (Control+S)",
+		"fire (MouseButtonPrimary) and select (MouseLeftRH)",
+		"move (Composite(A D S W)) and save (Control+S)",
+		"move (Gamepad(leftx,lefty)) and steer (Gamepad(leftx))",
+	}
+	if !reflect.DeepEqual(conflicts, expected) {
+		t.Errorf("expected %q, got %q", expected, conflicts)
+	}
+}
+
+func TestActionMap_ModifierConflicts(t *testing.T) {
+	tests := []struct {
+		a, b     Modifiers
+		conflict bool
+	}{
+		{0, ModControl, true},
+		{ModControl, ModControl, true},
+		{ModControl, ModLControl, true},
+		{ModLControl, ModRControl, true}, // Both Control keys held
+		{ModControl, ModControl | ModShift, false},
+		{ModLControl, ModAlt, false},
+		{ModShift | ModLControl, ModLShift | ModControl, true},
+	}
+	for _, test := range tests {
+		a, b := KeyBinding(KeyS, test.a), KeyBinding(KeyS, test.b)
+		if conflict := bindingsOverlap(a, b); conflict != test.conflict {
+			t.Errorf("%v and %v: expected conflict %v, got %v", a, b, test.conflict, conflict)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+)
+
+// Expects to be called on InitialThread()
+// Get the text on the clipboard, or "" if it holds no text. Line endings
+// are converted to "\n".
+func (w *Window) ThreadGetClipboardText(thread *Thread) (string, ThreadError) {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return w.internal.getClipboardText()
+}
+
+// A thread command helper for Window.ThreadGetClipboardText
+// If an error occurs, results will not be sent, so be sure to check Window.Errors()
+func WindowThreadGetClipboardText(results chan<- string) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		text, err := t.(*Window).ThreadGetClipboardText(thread)
+		if err != nil {
+			return err
+		}
+
+		results <- text
+		return nil
+	}
+}
+
+// Expects to be called on InitialThread()
+// Put text on the clipboard, replacing what it holds. The window owns the
+// clipboard until another application or window replaces it.
+func (w *Window) ThreadSetClipboardText(thread *Thread, text string) ThreadError {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return w.internal.setClipboardText(text)
+}
+
+// A thread command helper for Window.ThreadSetClipboardText
+func WindowThreadSetClipboardText(text string) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetClipboardText(thread, text)
+	}
+}
+
+// Expects to be called on InitialThread()
+// Get the image on the clipboard, or nil if it holds no image. PNG images
+// are preferred, as they keep transparency.
+func (w *Window) ThreadGetClipboardImage(thread *Thread) (image.Image, ThreadError) {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return w.internal.getClipboardImage()
+}
+
+// A thread command helper for Window.ThreadGetClipboardImage
+// If an error occurs, results will not be sent, so be sure to check Window.Errors()
+func WindowThreadGetClipboardImage(results chan<- image.Image) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		img, err := t.(*Window).ThreadGetClipboardImage(thread)
+		if err != nil {
+			return err
+		}
+
+		results <- img
+		return nil
+	}
+}
+
+// Expects to be called on InitialThread()
+// Put an image on the clipboard, replacing what it holds. It's offered as
+// a PNG, and in the platform's own image format for applications which
+// don't read PNGs.
+func (w *Window) ThreadSetClipboardImage(thread *Thread, img image.Image) ThreadError {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	if img.Bounds().Empty() {
+		return NewThreadError(errors.New("clipboard image is empty"), false)
+	}
+	nrgba := imageToNRGBA(img)
+
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, nrgba); err != nil {
+		return NewThreadError(err, false)
+	}
+
+	return w.internal.setClipboardImage(nrgba, encoded.Bytes())
+}
+
+// A thread command helper for Window.ThreadSetClipboardImage
+func WindowThreadSetClipboardImage(img image.Image) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetClipboardImage(thread, img)
+	}
+}
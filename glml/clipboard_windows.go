@@ -0,0 +1,166 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "helper_windows.h"
+import "C"
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+)
+
+// Applications exchange PNG images under this registered format
+func clipboardPNGFormat() C.UINT {
+	name, _ := utf16Convert("PNG")
+	return C.RegisterClipboardFormatW(name)
+}
+
+// Open the clipboard, waiting briefly while another application has it
+// open
+func (wi *windowInternal) openClipboard() ThreadError {
+	for attempt := 0; ; attempt++ {
+		if C.OpenClipboard(wi.window.Handle) != 0 {
+			return nil
+		}
+		if attempt == 10 {
+			return NewThreadError(fmt.Errorf("OpenClipboard failed (%d)", C.GetLastError()), false)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// Read a format from the open clipboard, or nil if it doesn't hold it
+func readClipboardData(format C.UINT) []byte {
+	handle := C.HGLOBAL(C.GetClipboardData(format))
+	if handle == nil {
+		return nil
+	}
+
+	data := C.GlobalLock(handle)
+	if data == nil {
+		return nil
+	}
+	defer C.GlobalUnlock(handle)
+
+	return C.GoBytes(data, C.int(C.GlobalSize(handle)))
+}
+
+// Put a format on the open, emptied clipboard, which takes the memory
+func writeClipboardData(format C.UINT, data []byte) ThreadError {
+	handle := C.GlobalAlloc(C.GMEM_MOVEABLE, C.SIZE_T(len(data)))
+	if handle == nil {
+		return NewThreadError(fmt.Errorf("GlobalAlloc failed (%d)", C.GetLastError()), false)
+	}
+
+	locked := C.GlobalLock(handle)
+	if locked == nil {
+		err := NewThreadError(fmt.Errorf("GlobalLock failed (%d)", C.GetLastError()), false)
+		C.GlobalFree(handle)
+		return err
+	}
+	copy(unsafe.Slice((*byte)(locked), len(data)), data)
+	C.GlobalUnlock(handle)
+
+	if C.SetClipboardData(format, C.HANDLE(handle)) == nil {
+		C.GlobalFree(handle)
+		return NewThreadError(fmt.Errorf("SetClipboardData failed (%d)", C.GetLastError()), false)
+	}
+	return nil
+}
+
+// Open the clipboard and take ownership of it, emptying it
+func (wi *windowInternal) emptyClipboard() ThreadError {
+	if err := wi.openClipboard(); err != nil {
+		return err
+	}
+	if C.EmptyClipboard() == 0 {
+		err := NewThreadError(fmt.Errorf("EmptyClipboard failed (%d)", C.GetLastError()), false)
+		C.CloseClipboard()
+		return err
+	}
+	return nil
+}
+
+func (wi *windowInternal) getClipboardText() (string, ThreadError) {
+	if err := wi.openClipboard(); err != nil {
+		return "", err
+	}
+	defer C.CloseClipboard()
+
+	// Windows converts other text formats to CF_UNICODETEXT
+	data := readClipboardData(C.CF_UNICODETEXT)
+	units := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		unit := binary.LittleEndian.Uint16(data[i:])
+		if unit == 0 {
+			break
+		}
+		units = append(units, unit)
+	}
+
+	return strings.ReplaceAll(string(utf16.Decode(units)), "\r\n", "\n"), nil
+}
+
+func (wi *windowInternal) setClipboardText(text string) ThreadError {
+	// Windows applications expect "\r\n" line endings
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\n", "\r\n")
+	units := utf16.Encode([]rune(text + "\x00"))
+	data := make([]byte, 2*len(units))
+	for i, unit := range units {
+		binary.LittleEndian.PutUint16(data[2*i:], unit)
+	}
+
+	if err := wi.emptyClipboard(); err != nil {
+		return err
+	}
+	defer C.CloseClipboard()
+
+	return writeClipboardData(C.CF_UNICODETEXT, data)
+}
+
+func (wi *windowInternal) getClipboardImage() (image.Image, ThreadError) {
+	if err := wi.openClipboard(); err != nil {
+		return nil, err
+	}
+	defer C.CloseClipboard()
+
+	if data := readClipboardData(clipboardPNGFormat()); data != nil {
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, NewThreadError(fmt.Errorf("clipboard PNG: %v", err), false)
+		}
+		return img, nil
+	}
+
+	// Windows converts between the bitmap formats, but only CF_DIBV5 keeps
+	// the alpha channel
+	for _, format := range []C.UINT{C.CF_DIBV5, C.CF_DIB} {
+		if data := readClipboardData(format); data != nil {
+			img, err := decodeDIB(data)
+			if err != nil {
+				return nil, NewThreadError(fmt.Errorf("clipboard bitmap: %v", err), false)
+			}
+			return img, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (wi *windowInternal) setClipboardImage(img *image.NRGBA, encoded []byte) ThreadError {
+	if err := wi.emptyClipboard(); err != nil {
+		return err
+	}
+	defer C.CloseClipboard()
+
+	if err := writeClipboardData(clipboardPNGFormat(), encoded); err != nil {
+		return err
+	}
+	return writeClipboardData(C.CF_DIBV5, encodeDIB(img))
+}
@@ -0,0 +1,74 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// Run a command on a window's thread and wait for it
+func runWindowCommand(t *testing.T, window *Window, command func(thread *Thread, t Threadable) ThreadError) {
+	t.Helper()
+	finished := make(chan bool, 1)
+	window.Commands() <- func(thread *Thread, t Threadable) ThreadError {
+		err := command(thread, t)
+		finished <- err == nil
+		return err
+	}
+
+	select {
+	case err := <-window.Errors():
+		t.Fatal(err)
+	case <-finished:
+	}
+}
+
+func TestClipboard(t *testing.T) {
+	mode := VideoMode{Width: 320, Height: 240, BitsPerPixel: 32}
+	var windows [2]*Window
+	for i := range windows {
+		window, err := CreateWindow(GetDefaultMonitor(), mode, "Clipboard", WindowStyleDefault, ContextSettingsDefault)
+		if err != nil {
+			t.Fatal(err)
+		}
+		thread := CreateThread()
+		defer thread.Close()
+		defer window.Close()
+		if err := thread.SetActive(window); err != nil {
+			t.Fatal(err)
+		}
+		windows[i] = window
+	}
+
+	// Text written by one window is read by the other
+	runWindowCommand(t, windows[0], WindowThreadSetClipboardText("first line\nsecond line ✓"))
+	texts := make(chan string, 1)
+	runWindowCommand(t, windows[1], WindowThreadGetClipboardText(texts))
+	if text := <-texts; text != "first line\nsecond line ✓" {
+		t.Errorf("unexpected clipboard text %q", text)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 255})
+	img.SetNRGBA(2, 1, color.NRGBA{0, 0, 255, 128})
+	runWindowCommand(t, windows[1], WindowThreadSetClipboardImage(img))
+
+	images := make(chan image.Image, 1)
+	runWindowCommand(t, windows[0], WindowThreadGetClipboardImage(images))
+	got := <-images
+	if got == nil || got.Bounds() != img.Bounds() {
+		t.Fatalf("expected a 3x2 image, got %v", got)
+	}
+	for _, p := range []image.Point{{0, 0}, {1, 0}, {2, 1}} {
+		if c := color.NRGBAModel.Convert(got.At(p.X, p.Y)); c != img.NRGBAAt(p.X, p.Y) {
+			t.Errorf("%v: expected %v, got %v", p, img.NRGBAAt(p.X, p.Y), c)
+		}
+	}
+
+	// Setting an image replaces the text
+	runWindowCommand(t, windows[1], WindowThreadGetClipboardText(texts))
+	if text := <-texts; text != "" {
+		t.Errorf("expected no text, got %q", text)
+	}
+}
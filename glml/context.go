@@ -1,43 +1,55 @@
 // Copyright © 2012 Popog
 package glml
 
-var (
-	sharedContext       = CreateContext()
-	sharedContextThread = CreateThread()
+import (
+	"sync"
 )
 
-func init() {
-	sharedContextThread.SetActive(sharedContext)
-}
+//go:generate go run glgen/main.go -header glext.h -version 3.0 -ext GL_ARB_framebuffer_object,GL_EXT_framebuffer_object -o gl.go
 
 type Context struct {
 	initialThread *Thread                                             // The thread this context was initialized on.
-	thread        *Thread                                             // The thread this context is running on.
+	thread        *Thread                                             // The thread this context is running on. Guarded by threadMutex.
+	deactivated   chan bool                                           // Closed when the context leaves thread. Guarded by threadMutex.
+	threadMutex   sync.Mutex                                          // Guards thread, which any goroutine may read
 	commands      chan func(thread *Thread, t Threadable) ThreadError // The channel for input functions to run on this context.
 	errors        chan ThreadError                                    // The error reporting channel
-	initialize    func(c *Context) ThreadError                        // The initialization function. Nil if already initialized
+	initialize    func(c *Context, share *Context) ThreadError        // The initialization function. Nil if already initialized
+	group         *ShareGroup                                         // The share group of the context. Nil if it shares with nothing.
 	closed        bool                                                // Whether or not Close has already been called.
+	gl            GL                                                  // The OpenGL functions, loaded on first activation.
+	info          ContextInfo                                         // The implementation strings, queried on first activation.
+	extensions    Extensions                                          // The supported extensions, queried on first activation.
 	internal      contextInternal                                     // The os specific context implementation. This should only be touched on threads.
+
+	renderTextures map[*RenderTexture]bool // The render textures to free when the context closes
+	boundTexture   *RenderTexture          // The render texture bound with ThreadBind, nil if none
 }
 
 // Create a context with default settings and dimensions
+//
+// The context does not share objects with any other context. Use
+// ShareGroup.CreateContext to create contexts which do.
 func CreateContext() *Context {
 	return &Context{
 		commands: make(chan func(thread *Thread, t Threadable) ThreadError),
 		errors:   make(chan ThreadError),
-		initialize: func(c *Context) ThreadError {
-			return c.internal.initialize()
+		initialize: func(c *Context, share *Context) ThreadError {
+			return c.internal.initialize(shareInternal(share))
 		},
 	}
 }
 
 // A context with specific settings and back buffer dimensions
+//
+// The context does not share objects with any other context. Use
+// ShareGroup.CreateContextFromSettings to create contexts which do.
 func CreateContextFromSettings(settings ContextSettings, width, height int) *Context {
 	return &Context{
 		commands: make(chan func(thread *Thread, t Threadable) ThreadError),
 		errors:   make(chan ThreadError),
-		initialize: func(c *Context) ThreadError {
-			return c.internal.initializeFromSettings(settings, width, height)
+		initialize: func(c *Context, share *Context) ThreadError {
+			return c.internal.initializeFromSettings(shareInternal(share), settings, width, height)
 		},
 	}
 }
@@ -47,12 +59,26 @@ func createFromOwner(settings ContextSettings, owner *windowInternal, bitsPerPix
 	return &Context{
 		commands: make(chan func(thread *Thread, t Threadable) ThreadError),
 		errors:   make(chan ThreadError),
-		initialize: func(c *Context) ThreadError {
-			return c.internal.initializeFromOwner(settings, owner, bitsPerPixel)
+		initialize: func(c *Context, share *Context) ThreadError {
+			return c.internal.initializeFromOwner(shareInternal(share), settings, owner, bitsPerPixel)
 		},
 	}
 }
 
+// The internal context to share objects with, or nil
+func shareInternal(share *Context) *contextInternal {
+	if share == nil {
+		return nil
+	}
+	return &share.internal
+}
+
+// Get the share group of the context, or nil if it does not share objects
+// with other contexts
+func (c *Context) ShareGroup() *ShareGroup {
+	return c.group
+}
+
 // The channel for input functions to run on this context.
 func (c *Context) Commands() chan<- func(thread *Thread, t Threadable) ThreadError {
 	return c.commands
@@ -71,13 +97,26 @@ func (c *Context) IsActive() bool {
 // Returns the thread the context is running on or nil if it is not currently
 // running on a thread
 func (c *Context) GetThread() *Thread {
+	c.threadMutex.Lock()
+	defer c.threadMutex.Unlock()
+
 	return c.thread
 }
 
 // Expects to be called by Thread
 // Sets the thread
 func (c *Context) SetThread(thread *Thread) {
+	c.threadMutex.Lock()
+	defer c.threadMutex.Unlock()
+
+	if c.deactivated != nil {
+		close(c.deactivated)
+		c.deactivated = nil
+	}
 	c.thread = thread
+	if thread != nil {
+		c.deactivated = make(chan bool)
+	}
 }
 
 // Gets the thread that the context was initialized on.
@@ -95,10 +134,6 @@ func (c *Context) Close() {
 		return
 	}
 
-	if c == sharedContext {
-		panic("sharedContext can never close")
-	}
-
 	// deactivate if need be
 	if c.IsActive() {
 		c.GetThread().SetActive(nil)
@@ -132,24 +167,26 @@ func (c *Context) ThreadInitialize(thread *Thread) ThreadError {
 	if c.ThreadIsInitialized() {
 		panic("ThreadIsInitialized")
 	}
+	if !IsInitialized() {
+		return NewThreadError(ErrNotInitialized, true)
+	}
 
-	if c != sharedContext {
-		pause_signal := make(chan bool)
-		sharedContext.Commands() <- func(_ *Thread, t Threadable) ThreadError {
-			return t.(*Context).internal.pause(pause_signal)
-		}
-
-		<-pause_signal
-		defer func() { pause_signal <- true }()
-
-		// Try to take the shared context
-		if err := sharedContext.internal.take(); err != nil {
-			return err
-		}
-		defer sharedContext.internal.release()
+	// Find a member of the share group to share objects with. Drivers
+	// refuse to share with a context current on another thread, so it is
+	// paused until this one is created.
+	var share *Context
+	if c.group != nil {
+		share = c.group.lockJoin()
+	}
+	if share != nil {
+		defer share.pause()()
 	}
 
-	if err := c.initialize(c); err != nil {
+	err := c.initialize(c, share)
+	if c.group != nil {
+		c.group.unlockJoin(c, err == nil)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -159,12 +196,68 @@ func (c *Context) ThreadInitialize(thread *Thread) ThreadError {
 	return nil
 }
 
+// Make the context not current on its thread until the returned function
+// is called. Does nothing if the context isn't active.
+func (c *Context) pause() (resume func()) {
+	c.threadMutex.Lock()
+	deactivated := c.deactivated
+	c.threadMutex.Unlock()
+	if deactivated == nil {
+		return func() {}
+	}
+
+	signal := make(chan bool)
+	pause := func(*Thread, Threadable) ThreadError {
+		if err := c.internal.pause(signal); err != nil {
+			return err
+		}
+
+		// Resuming made the context current, not the pbuffer it was
+		// rendering to
+		if rt := c.boundTexture; rt != nil && rt.pbuffer != nil {
+			return rt.pbuffer.activate()
+		}
+		return nil
+	}
+
+	select {
+	case c.Commands() <- pause:
+		if <-signal {
+			return func() { signal <- true }
+		}
+		return func() {}
+
+	case <-deactivated:
+		// The context left its thread before it got to the command
+		return func() {}
+	}
+}
+
 // Expects to be called on a Thread
 func (c *Context) ThreadClose(thread *Thread) {
 	if c.InitialThread() != thread {
 		panic("thread is not initialThread")
 	}
 
+	if c.group != nil {
+		c.group.leave(c)
+	}
+
+	// Free the render textures which weren't closed. The context is no
+	// longer active, so it is made current just for this.
+	if len(c.renderTextures) != 0 {
+		err := c.internal.borrow(func() {
+			for rt := range c.renderTextures {
+				if err := rt.ThreadClose(); err != nil {
+					c.ThreadReportError(err)
+				}
+			}
+		})
+		if err != nil {
+			c.ThreadReportError(err)
+		}
+	}
+
 	if err := c.internal.close(); err != nil {
 		c.ThreadReportError(err)
 	}
@@ -175,14 +268,40 @@ func (c *Context) ThreadActivate(*Thread) ThreadError {
 	if c.closed {
 		panic("context is closed")
 	}
-	return c.internal.activate()
+	if err := c.internal.activate(); err != nil {
+		return err
+	}
+
+	// Function pointers are tied to the context, so they can only be
+	// resolved once it is current
+	if !c.gl.IsLoaded() {
+		c.gl.load()
+		c.info = queryContextInfo()
+		c.extensions = queryExtensions(&c.gl, c.internal.getPlatformExtensions())
+	}
+	return nil
+}
+
+// Expects to be called on a Thread
+// Get the OpenGL functions of this context
+//
+// The returned GL is only valid while the context is active on the calling
+// thread. Use GL.Missing to check which functions the driver provided.
+func (c *Context) ThreadGL() *GL {
+	return &c.gl
 }
 
 // Expects to be called on a Thread
+// Deactivating the context unbinds its render texture, if one is bound.
 func (c *Context) ThreadDeactivate(*Thread) ThreadError {
 	if c.closed {
 		panic("context is closed")
 	}
+	if c.boundTexture != nil {
+		if err := c.boundTexture.ThreadUnbind(); err != nil {
+			c.ThreadReportError(err)
+		}
+	}
 	return c.internal.deactivate()
 }
 
@@ -237,6 +356,44 @@ func ContextThreadGetSettings(results chan<- ContextSettings) func(thread *Threa
 	}
 }
 
+// Expects to be called on a Thread
+// Get the GL and platform (WGL, GLX, EGL) extensions supported by the context
+//
+// The set is queried once, when the context is first activated, and must
+// not be modified.
+func (c *Context) ThreadExtensions() Extensions {
+	return c.extensions
+}
+
+// Expects to be called on a Thread
+// Returns true if the context supports the named GL or platform extension
+func (c *Context) ThreadHasExtension(name string) bool {
+	return c.extensions.Has(name)
+}
+
+// A thread command helper for Context.ThreadExtensions
+func ContextThreadExtensions(results chan<- Extensions) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		results <- t.(*Context).ThreadExtensions()
+		return nil
+	}
+}
+
+// Expects to be called on a Thread
+// Retrieve the vendor, renderer and version strings of the OpenGL
+// implementation
+func (c *Context) ThreadGetInfo() ContextInfo {
+	return c.info
+}
+
+// A thread command helper for Context.ThreadGetInfo
+func ContextThreadGetInfo(results chan<- ContextInfo) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		results <- t.(*Context).ThreadGetInfo()
+		return nil
+	}
+}
+
 // Expects to be called on a Thread
 // Enable or disable vertical synchronization.
 //
@@ -244,8 +401,13 @@ func ContextThreadGetSettings(results chan<- ContextSettings) func(thread *Threa
 // of frames displayed to the refresh rate of the monitor.
 // This can avoid some visual artifacts, and limit the framerate
 // to a good value (but not constant across different computers).
+//
+// This is equivalent to a swap interval of 1 or 0.
 func (c *Context) ThreadSetVSyncEnabled(enabled bool) ThreadError {
-	return c.internal.setVerticalSyncEnabled(enabled)
+	if enabled {
+		return c.ThreadSetSwapInterval(1)
+	}
+	return c.ThreadSetSwapInterval(0)
 }
 
 // A thread command helper for Context.ThreadSetVSyncEnabled
@@ -254,3 +416,50 @@ func ContextThreadSetVSyncEnabled(enabled bool) func(thread *Thread, t Threadabl
 		return t.(*Context).ThreadSetVSyncEnabled(enabled)
 	}
 }
+
+// Expects to be called on a Thread
+// Set the number of vertical blanks to wait between buffer swaps.
+//
+// 0 disables vertical synchronization and 1 swaps once per refresh. Larger
+// intervals divide the frame rate further. Negative intervals enable
+// adaptive vsync: swaps wait for abs(interval) vertical blanks, unless the
+// frame is late, in which case it is shown immediately and may tear.
+//
+// If the interval is not supported, a *SwapIntervalError is returned and the
+// previous interval remains in effect.
+func (c *Context) ThreadSetSwapInterval(interval int) ThreadError {
+	if err := c.internal.getSwapControl(c.extensions).check(interval); err != nil {
+		return err
+	}
+	return c.internal.setSwapInterval(interval)
+}
+
+// A thread command helper for Context.ThreadSetSwapInterval
+func ContextThreadSetSwapInterval(interval int) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		return t.(*Context).ThreadSetSwapInterval(interval)
+	}
+}
+
+// Expects to be called on a Thread
+// Get the swap interval actually applied by the driver
+//
+// This may differ from the last requested interval if the driver or the
+// user's settings override it.
+func (c *Context) ThreadGetSwapInterval() (int, ThreadError) {
+	return c.internal.getSwapInterval()
+}
+
+// A thread command helper for Context.ThreadGetSwapInterval
+// If an error occurs, results will not be sent, so be sure to check Context.Errors()
+func ContextThreadGetSwapInterval(results chan<- int) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		interval, err := t.(*Context).ThreadGetSwapInterval()
+		if err != nil {
+			return err
+		}
+
+		results <- interval
+		return nil
+	}
+}
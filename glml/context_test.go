@@ -3,8 +3,136 @@ package glml
 
 import (
 	"testing"
+	"unsafe"
 )
 
 func TestNothing(t *testing.T) {
 	t.Log(CreateContext())
 }
+
+// Run a command on a context's thread and wait for it
+func runContextCommand(t *testing.T, c *Context, command func(thread *Thread, t Threadable) ThreadError) {
+	t.Helper()
+	finished := make(chan bool, 1)
+	c.Commands() <- func(thread *Thread, t Threadable) ThreadError {
+		err := command(thread, t)
+		finished <- err == nil
+		return err
+	}
+
+	select {
+	case err := <-c.Errors():
+		t.Fatal(err)
+	case <-finished:
+	}
+}
+
+func TestShareGroup(t *testing.T) {
+	group := CreateShareGroup()
+	contexts := []*Context{group.CreateContext(), group.CreateContext(), CreateContext()}
+	threads := []*Thread{CreateThread(), CreateThread(), CreateThread()}
+
+	// The first context stays current while the others join the group
+	for i, c := range contexts {
+		if err := threads[i].SetActive(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		for i, c := range contexts {
+			c.Close()
+			threads[i].Close()
+		}
+	}()
+
+	if contexts[0].ShareGroup() != group || contexts[1].ShareGroup() != group {
+		t.Error("expected the contexts to be in the share group")
+	}
+	if contexts[2].ShareGroup() != nil {
+		t.Error("standalone context has a share group")
+	}
+
+	// A buffer made by one member is visible to the other, but not to the
+	// standalone context
+	var buffer uint32
+	runContextCommand(t, contexts[0], func(thread *Thread, _ Threadable) ThreadError {
+		gl := contexts[0].ThreadGL()
+		gl.GenBuffers(1, unsafe.Pointer(&buffer))
+		gl.BindBuffer(GL_ARRAY_BUFFER, buffer)
+		return nil
+	})
+	for i, expected := range []bool{true, true, false} {
+		var shared bool
+		runContextCommand(t, contexts[i], func(thread *Thread, _ Threadable) ThreadError {
+			shared = contexts[i].ThreadGL().IsBuffer(buffer)
+			return nil
+		})
+		if shared != expected {
+			t.Errorf("context %d: expected IsBuffer %v, got %v", i, expected, shared)
+		}
+	}
+}
+
+// A member may close while other contexts join, without waiting for them
+func TestShareGroup_CloseWhileJoining(t *testing.T) {
+	group := CreateShareGroup()
+	first, firstThread := group.CreateContext(), CreateThread()
+	if err := firstThread.SetActive(first); err != nil {
+		t.Fatal(err)
+	}
+
+	const joiners = 4
+	contexts := make([]*Context, joiners)
+	threads := make([]*Thread, joiners)
+	results := make(chan error, joiners)
+	for i := range contexts {
+		contexts[i], threads[i] = group.CreateContext(), CreateThread()
+		go func(i int) { results <- threads[i].SetActive(contexts[i]) }(i)
+	}
+
+	first.Close()
+	firstThread.Close()
+
+	for i := 0; i < joiners; i++ {
+		if err := <-results; err != nil {
+			t.Error(err)
+		}
+	}
+	for i, c := range contexts {
+		c.Close()
+		threads[i].Close()
+	}
+
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+	if len(group.members) != 0 || group.joining {
+		t.Errorf("expected an empty group, got %d members, joining %v", len(group.members), group.joining)
+	}
+}
+
+// Render textures which weren't closed are freed with their context
+func TestContextClosesRenderTextures(t *testing.T) {
+	c, thread := CreateContext(), CreateThread()
+	if err := thread.SetActive(c); err != nil {
+		t.Fatal(err)
+	}
+	defer thread.Close()
+
+	var rt *RenderTexture
+	runContextCommand(t, c, func(*Thread, Threadable) ThreadError {
+		var err ThreadError
+		if rt, err = c.ThreadCreateRenderTexture(16, 16, RenderTextureRGBA8, true); err != nil {
+			return err
+		}
+		return rt.ThreadBind()
+	})
+
+	// The render texture is still bound when the context closes
+	c.Close()
+	if !rt.closed || rt.bound {
+		t.Errorf("expected a closed, unbound render texture, got closed %v, bound %v", rt.closed, rt.bound)
+	}
+	if len(c.renderTextures) != 0 || c.boundTexture != nil {
+		t.Error("expected the context to forget its render textures")
+	}
+}
@@ -9,8 +9,15 @@ package glml
 //
 // #define PROCLIST                                                    \
 // PROC(PFNWGLSWAPINTERVALEXTPROC,         wglSwapIntervalEXT)         \
+// PROC(PFNWGLGETSWAPINTERVALEXTPROC,      wglGetSwapIntervalEXT)      \
 // PROC(PFNWGLCHOOSEPIXELFORMATARBPROC,    wglChoosePixelFormatARB)    \
 // PROC(PFNWGLCREATECONTEXTATTRIBSARBPROC, wglCreateContextAttribsARB) \
+// PROC(PFNWGLGETEXTENSIONSSTRINGARBPROC,  wglGetExtensionsStringARB)  \
+// PROC(PFNWGLGETEXTENSIONSSTRINGEXTPROC,  wglGetExtensionsStringEXT)  \
+// PROC(PFNWGLCREATEPBUFFERARBPROC,        wglCreatePbufferARB)        \
+// PROC(PFNWGLGETPBUFFERDCARBPROC,         wglGetPbufferDCARB)         \
+// PROC(PFNWGLRELEASEPBUFFERDCARBPROC,     wglReleasePbufferDCARB)     \
+// PROC(PFNWGLDESTROYPBUFFERARBPROC,       wglDestroyPbufferARB)       \
 //
 // #define PROC(type, name)  \
 // 	type p_##name;           \
@@ -32,6 +39,9 @@ package glml
 // 
 // BOOL __wglSwapIntervalEXT(wglProcs const * procs, int interval)
 // { return procs->p_wglSwapIntervalEXT(interval); }
+//
+// int __wglGetSwapIntervalEXT(wglProcs const * procs)
+// { return procs->p_wglGetSwapIntervalEXT(); }
 // 
 // BOOL __wglChoosePixelFormatARB(wglProcs const * procs, HDC hdc, const int *piAttribIList, const FLOAT *pfAttribFList, UINT nMaxFormats, int *piFormats, UINT *nNumFormats)
 // { return procs->p_wglChoosePixelFormatARB(hdc, piAttribIList, pfAttribFList, nMaxFormats, piFormats, nNumFormats); }
@@ -39,10 +49,31 @@ package glml
 // HGLRC __wglCreateContextAttribsARB(wglProcs const * procs, HDC hDC, HGLRC hShareContext, const int *attribList)
 // { return procs->p_wglCreateContextAttribsARB(hDC, hShareContext, attribList); }
 //
+// const char * __wglGetExtensionsStringARB(wglProcs const * procs, HDC hdc)
+// { return procs->p_wglGetExtensionsStringARB(hdc); }
+//
+// const char * __wglGetExtensionsStringEXT(wglProcs const * procs)
+// { return procs->p_wglGetExtensionsStringEXT(); }
+//
+// HPBUFFERARB __wglCreatePbufferARB(wglProcs const * procs, HDC hDC, int iPixelFormat, int iWidth, int iHeight, const int *piAttribList)
+// { return procs->p_wglCreatePbufferARB(hDC, iPixelFormat, iWidth, iHeight, piAttribList); }
+//
+// HDC __wglGetPbufferDCARB(wglProcs const * procs, HPBUFFERARB hPbuffer)
+// { return procs->p_wglGetPbufferDCARB(hPbuffer); }
+//
+// int __wglReleasePbufferDCARB(wglProcs const * procs, HPBUFFERARB hPbuffer, HDC hDC)
+// { return procs->p_wglReleasePbufferDCARB(hPbuffer, hDC); }
+//
+// BOOL __wglDestroyPbufferARB(wglProcs const * procs, HPBUFFERARB hPbuffer)
+// { return procs->p_wglDestroyPbufferARB(hPbuffer); }
+//
 import "C"
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"syscall"
 )
 
 var contextInternal_className, _ = utf16Convert("STATIC")
@@ -141,7 +172,7 @@ func BestChoosePixelFormat(hdc C.HDC, bitsPerPixel uint, settings *ContextSettin
 	return C.ChoosePixelFormat(hdc, &descriptor)
 }
 
-func createContext(procs *C.wglProcs, sharedContext C.HGLRC, hdc C.HDC, bitsPerPixel uint, settings *ContextSettings) C.HGLRC {
+func createContext(procs *C.wglProcs, share C.HGLRC, hdc C.HDC, bitsPerPixel uint, settings *ContextSettings) C.HGLRC {
 	bestFormat := BestwglChoosePixelFormatARB(procs, hdc, bitsPerPixel, settings)
 	if bestFormat == 0 {
 		bestFormat = BestChoosePixelFormat(hdc, bitsPerPixel, settings)
@@ -175,7 +206,7 @@ func createContext(procs *C.wglProcs, sharedContext C.HGLRC, hdc C.HDC, bitsPerP
 				0, 0,
 			}
 
-			if context := C.__wglCreateContextAttribsARB(procs, hdc, sharedContext, &attributes[0]); context != nil {
+			if context := C.__wglCreateContextAttribsARB(procs, hdc, share, &attributes[0]); context != nil {
 				return context
 			}
 
@@ -202,26 +233,123 @@ func createContext(procs *C.wglProcs, sharedContext C.HGLRC, hdc C.HDC, bitsPerP
 	}
 
 	// Share this context with others
-	C.wglShareLists(sharedContext, context)
+	if share != nil && C.wglShareLists(share, context) == C.FALSE {
+		C.wglDeleteContext(context)
+		return nil
+	}
 	return context
 }
 
+// The WGL extensions used to create contexts, loaded by the first context
+// created
+var creationProcs struct {
+	sync.Mutex
+	loaded bool
+	procs  C.wglProcs
+}
+
+// Get the WGL extensions used to create a context, loading them if need be
+func getCreationProcs() (*C.wglProcs, ThreadError) {
+	creationProcs.Lock()
+	defer creationProcs.Unlock()
+
+	if !creationProcs.loaded {
+		if err := loadCreationProcs(&creationProcs.procs); err != nil {
+			return nil, err
+		}
+		creationProcs.loaded = true
+	}
+	return &creationProcs.procs, nil
+}
+
+// The WGL extensions used to create a context can only be loaded while
+// some context is current, so create a throwaway one on a hidden window.
+func loadCreationProcs(procs *C.wglProcs) ThreadError {
+	window := createHiddenWindow(1, 1)
+	if window == nil {
+		return NewThreadError(fmt.Errorf("could not create window (%d)", C.GetLastError()), true)
+	}
+	defer C.DestroyWindow(window)
+
+	hdc := C.GetDC(window)
+	if hdc == nil {
+		return NewThreadError(errors.New("no device context"), true)
+	}
+	defer C.ReleaseDC(window, hdc)
+
+	pfd := C.PIXELFORMATDESCRIPTOR{
+		nSize:      C.PIXELFORMATDESCRIPTOR_size, // size of this pfd
+		nVersion:   1,                            // version number
+		iPixelType: C.PFD_TYPE_RGBA,              // RGBA type
+		cColorBits: 24,                           // 24-bit color depth
+		cDepthBits: 32,                           // 32-bit z-buffer
+		iLayerType: C.PFD_MAIN_PLANE,             // main layer
+
+		// support window | OpenGL | double buffer
+		dwFlags: C.PFD_DRAW_TO_WINDOW | C.PFD_SUPPORT_OPENGL | C.PFD_DOUBLEBUFFER,
+	}
+
+	// get the best available match of pixel format for the device context
+	// make that the pixel format of the device context
+	if iPixelFormat := C.ChoosePixelFormat(hdc, &pfd); iPixelFormat == 0 {
+		return NewThreadError(fmt.Errorf("ChoosePixelFormat failed (%d)", C.GetLastError()), true)
+	} else if C.SetPixelFormat(hdc, iPixelFormat, &pfd) == C.FALSE {
+		return NewThreadError(fmt.Errorf("SetPixelFormat failed (%d)", C.GetLastError()), true)
+	}
+
+	context := C.wglCreateContext(hdc)
+	if context == nil {
+		return NewThreadError(fmt.Errorf("wglCreateContext failed (%d)", C.GetLastError()), true)
+	}
+	defer C.wglDeleteContext(context)
+
+	// Leave whatever was current on this thread as it was
+	previousDC, previousContext := C.wglGetCurrentDC(), C.wglGetCurrentContext()
+	defer C.wglMakeCurrent(previousDC, previousContext)
+
+	if C.wglMakeCurrent(hdc, context) == C.FALSE {
+		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), true)
+	}
+
+	C.wglLoadProcs(procs)
+	return nil
+}
+
 type contextInternal struct {
 	deactivateSignal chan bool
 	procs            C.wglProcs      // The function pointers for this context
 	window           C.HWND          // Window to which the context is attached
 	ownsWindow       bool            // Do we own the target window?
 	hdc              C.HDC           // Device context associated to the context
-	isSharedContext  bool            // whether or not this is the shared context
 	context          C.HGLRC         // OpenGL context
 	settings         ContextSettings // The settings for the context
 }
 
-func (ic *contextInternal) initialize() ThreadError {
-	return ic.initializeFromSettings(ContextSettingsDefault, 1, 1)
+func (ic *contextInternal) initialize(share *contextInternal) ThreadError {
+	return ic.initializeFromSettings(share, ContextSettingsDefault, 1, 1)
+}
+
+// Create the OpenGL context on ic.hdc, sharing objects with share if it is
+// not nil
+func (ic *contextInternal) createContext(share *contextInternal, bitsPerPixel uint) ThreadError {
+	procs, err := getCreationProcs()
+	if err != nil {
+		return err
+	}
+
+	var shareContext C.HGLRC
+	if share != nil {
+		shareContext = share.context
+	}
+
+	ic.context = createContext(procs, shareContext, ic.hdc, bitsPerPixel, &ic.settings)
+	if ic.context == nil {
+		return NewThreadError(fmt.Errorf("could not create context (%d)", C.GetLastError()), true)
+	}
+	return nil
 }
 
-func (ic *contextInternal) initializeFromOwner(settings ContextSettings, owner *windowInternal, bitsPerPixel uint) ThreadError {
+func (ic *contextInternal) initializeFromOwner(share *contextInternal, settings ContextSettings, owner *windowInternal, bitsPerPixel uint) ThreadError {
 	ic.deactivateSignal = make(chan bool)
 	ic.settings = settings
 
@@ -235,9 +363,8 @@ func (ic *contextInternal) initializeFromOwner(settings ContextSettings, owner *
 		return NewThreadError(errors.New("no device context"), true)
 	}
 
-	ic.context = createContext(&sharedContext.internal.procs, sharedContext.internal.context, ic.hdc, bitsPerPixel, &ic.settings)
-	if ic.context == nil {
-		return NewThreadError(fmt.Errorf("could not create context (%d)", C.GetLastError()), true)
+	if err := ic.createContext(share, bitsPerPixel); err != nil {
+		return err
 	}
 
 	// signal because we start out deactivated
@@ -245,7 +372,7 @@ func (ic *contextInternal) initializeFromOwner(settings ContextSettings, owner *
 	return nil
 }
 
-func (ic *contextInternal) initializeFromSettings(settings ContextSettings, width, height int) ThreadError {
+func (ic *contextInternal) initializeFromSettings(share *contextInternal, settings ContextSettings, width, height int) ThreadError {
 	ic.deactivateSignal = make(chan bool)
 	ic.settings = settings
 
@@ -261,41 +388,11 @@ func (ic *contextInternal) initializeFromSettings(settings ContextSettings, widt
 		return NewThreadError(errors.New("no device context"), true)
 	}
 
-	if sharedContext == nil { // if there is no shared context, we are it
-		ic.isSharedContext = true
-
-		pfd := C.PIXELFORMATDESCRIPTOR{
-			nSize:      C.PIXELFORMATDESCRIPTOR_size, // size of this pfd
-			nVersion:   1,                            // version number
-			iPixelType: C.PFD_TYPE_RGBA,              // RGBA type
-			cColorBits: 24,                           // 24-bit color depth
-			cDepthBits: 32,                           // 32-bit z-buffer
-			iLayerType: C.PFD_MAIN_PLANE,             // main layer
-
-			// support window | OpenGL | double buffer
-			dwFlags: C.PFD_DRAW_TO_WINDOW | C.PFD_SUPPORT_OPENGL | C.PFD_DOUBLEBUFFER,
-		}
-
-		// get the best available match of pixel format for the device context
-		// make that the pixel format of the device context  
-		if iPixelFormat := C.ChoosePixelFormat(ic.hdc, &pfd); iPixelFormat == 0 {
-			return NewThreadError(fmt.Errorf("sharedContext: ChoosePixelFormat failed (%d)", C.GetLastError()), true)
-		} else if C.SetPixelFormat(ic.hdc, iPixelFormat, &pfd) == C.FALSE {
-			return NewThreadError(fmt.Errorf("sharedContext: SetPixelFormat failed (%d)", C.GetLastError()), true)
-		}
-
-		ic.context = C.wglCreateContext(ic.hdc)
-		if ic.context == nil {
-			return NewThreadError(fmt.Errorf("sharedContext: wglCreateContext failed (%d)", C.GetLastError()), true)
-		}
-	} else { // otherwise we push the commands onto the shared context thread
-
-		bitsPerPixel := GetDefaultMonitor().GetDesktopMode().BitsPerPixel
-		ic.context = createContext(&sharedContext.internal.procs, sharedContext.internal.context, ic.hdc, bitsPerPixel, &ic.settings)
-		if ic.context == nil {
-			return NewThreadError(fmt.Errorf("could not create context (%d)", C.GetLastError()), true)
-		}
+	bitsPerPixel := GetDefaultMonitor().GetDesktopMode().BitsPerPixel
+	if err := ic.createContext(share, bitsPerPixel); err != nil {
+		return err
 	}
+
 	// signal because we start out deactivated
 	ic.signalDeactivation()
 	return nil
@@ -305,22 +402,50 @@ func (ic *contextInternal) getSettings() (ContextSettings, ThreadError) {
 	return ic.settings, nil
 }
 
-func (ic *contextInternal) setVerticalSyncEnabled(enabled bool) ThreadError {
-	var interval C.int
-	if enabled {
-		interval = 1
+// Get the swap control supported by the context
+func (ic *contextInternal) getSwapControl(extensions Extensions) swapControl {
+	return swapControl{
+		supported: ic.procs.p_wglSwapIntervalEXT != nil,
+		adaptive:  extensions.Has("WGL_EXT_swap_control_tear"),
 	}
+}
 
+// Set the number of vertical blanks to wait between buffer swaps
+func (ic *contextInternal) setSwapInterval(interval int) ThreadError {
 	if ic.procs.p_wglSwapIntervalEXT == nil {
-		return NewThreadError(fmt.Errorf("wglSwapIntervalEXT == nil (%d)", ic.procs.error_wglSwapIntervalEXT), false)
+		return &SwapIntervalError{Interval: interval, Reason: "swap control is not supported"}
 	}
 
-	if C.__wglSwapIntervalEXT(&ic.procs, interval) == C.FALSE {
-		return NewThreadError(fmt.Errorf("wglSwapIntervalEXT failed (%d)", C.GetLastError()), false)
+	if C.__wglSwapIntervalEXT(&ic.procs, C.int(interval)) == C.FALSE {
+		return &SwapIntervalError{Interval: interval, Reason: "wglSwapIntervalEXT failed", Err: syscall.Errno(C.GetLastError())}
 	}
 	return nil
 }
 
+// Get the swap interval the driver is actually using
+func (ic *contextInternal) getSwapInterval() (int, ThreadError) {
+	if ic.procs.p_wglGetSwapIntervalEXT == nil {
+		return 0, &SwapIntervalError{Reason: "reading the swap interval is not supported"}
+	}
+
+	return int(C.__wglGetSwapIntervalEXT(&ic.procs)), nil
+}
+
+// Get the WGL extensions supported by the context
+func (ic *contextInternal) getPlatformExtensions() []string {
+	var extensions *C.char
+	if ic.procs.p_wglGetExtensionsStringARB != nil {
+		extensions = C.__wglGetExtensionsStringARB(&ic.procs, ic.hdc)
+	} else if ic.procs.p_wglGetExtensionsStringEXT != nil {
+		extensions = C.__wglGetExtensionsStringEXT(&ic.procs)
+	}
+
+	if extensions == nil {
+		return nil
+	}
+	return strings.Fields(C.GoString(extensions))
+}
+
 // Display what has been rendered to the context so far
 func (ic *contextInternal) swapBuffers() ThreadError {
 	if C.SwapBuffers(ic.hdc) == C.FALSE {
@@ -357,11 +482,14 @@ func (ic *contextInternal) deactivate() ThreadError {
 	return nil
 }
 
-// Deactivate, signal, wait for response, activate
+// Release the context until signalled, so that another thread can share
+// objects with it. Sends false if it couldn't be released.
 func (ic *contextInternal) pause(signal chan bool) ThreadError {
 	// disable the current context
 	if C.wglMakeCurrent(ic.hdc, nil) == C.FALSE {
-		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), true)
+		err := NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), true)
+		signal <- false
+		return err
 	}
 
 	// let the other thread know and then wait for them
@@ -375,7 +503,7 @@ func (ic *contextInternal) pause(signal chan bool) ThreadError {
 	return nil
 }
 
-// Temporary activate the context
+// Make the context current again, e.g. after rendering to a pbuffer
 func (ic *contextInternal) take() ThreadError {
 	// start up the context
 	if C.wglMakeCurrent(ic.hdc, ic.context) == C.FALSE {
@@ -384,11 +512,19 @@ func (ic *contextInternal) take() ThreadError {
 	return nil
 }
 
-// Temporary deactivate the context
-func (ic *contextInternal) release() ThreadError {
-	// disable the current context
+// Make the inactive context current on the calling thread while f runs,
+// e.g. to free objects before closing it
+func (ic *contextInternal) borrow(f func()) ThreadError {
+	// start by waiting for deactivation to finish, and hand it back after
+	<-ic.deactivateSignal
+	defer ic.signalDeactivation()
+
+	if C.wglMakeCurrent(ic.hdc, ic.context) == C.FALSE {
+		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), false)
+	}
+	f()
 	if C.wglMakeCurrent(ic.hdc, nil) == C.FALSE {
-		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), true)
+		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), false)
 	}
 	return nil
 }
@@ -418,3 +554,105 @@ func (ic *contextInternal) close() ThreadError {
 
 	return nil
 }
+
+// Returns true if pbuffers can be created from the context
+func (ic *contextInternal) supportsPbuffers() bool {
+	return ic.procs.p_wglCreatePbufferARB != nil &&
+		ic.procs.p_wglGetPbufferDCARB != nil &&
+		ic.procs.p_wglReleasePbufferDCARB != nil &&
+		ic.procs.p_wglDestroyPbufferARB != nil &&
+		ic.procs.p_wglChoosePixelFormatARB != nil
+}
+
+// An offscreen pbuffer with its own OpenGL context, sharing objects with
+// the context that created it
+type pbufferInternal struct {
+	owner   *contextInternal // The context the pbuffer shares objects with
+	pbuffer C.HPBUFFERARB    // The pbuffer
+	hdc     C.HDC            // Device context associated to the pbuffer
+	context C.HGLRC          // OpenGL context rendering to the pbuffer
+}
+
+// Create a pbuffer sharing objects with the context. Expects the context to
+// be current.
+func (ic *contextInternal) createPbuffer(width, height int, bitsPerPixel uint, depth bool) (*pbufferInternal, ThreadError) {
+	if !ic.supportsPbuffers() {
+		return nil, NewThreadError(errors.New("WGL_ARB_pbuffer is not supported"), false)
+	}
+
+	var depthBits, alphaBits C.int
+	if depth {
+		depthBits = 24
+	}
+	if bitsPerPixel == 32 {
+		alphaBits = 8
+	}
+
+	AttribIList := [...]C.int{
+		C.WGL_DRAW_TO_PBUFFER_ARB, C.GL_TRUE,
+		C.WGL_SUPPORT_OPENGL_ARB, C.GL_TRUE,
+		C.WGL_COLOR_BITS_ARB, 24,
+		C.WGL_ALPHA_BITS_ARB, alphaBits,
+		C.WGL_DEPTH_BITS_ARB, depthBits,
+		0, 0,
+	}
+	AttribFList := []C.FLOAT{0, 0}
+
+	var format C.int
+	var nbFormats C.UINT
+	if C.__wglChoosePixelFormatARB(&ic.procs, ic.hdc, &AttribIList[0], &AttribFList[0], 1, &format, &nbFormats) == C.FALSE || nbFormats == 0 {
+		return nil, NewThreadError(fmt.Errorf("no pbuffer pixel format (%d)", C.GetLastError()), false)
+	}
+
+	pi := &pbufferInternal{owner: ic}
+	attributes := [...]C.int{0, 0}
+	pi.pbuffer = C.__wglCreatePbufferARB(&ic.procs, ic.hdc, format, C.int(width), C.int(height), &attributes[0])
+	if pi.pbuffer == nil {
+		return nil, NewThreadError(fmt.Errorf("wglCreatePbufferARB failed (%d)", C.GetLastError()), false)
+	}
+
+	pi.hdc = C.__wglGetPbufferDCARB(&ic.procs, pi.pbuffer)
+	if pi.hdc == nil {
+		pi.close()
+		return nil, NewThreadError(fmt.Errorf("wglGetPbufferDCARB failed (%d)", C.GetLastError()), false)
+	}
+
+	pi.context = C.wglCreateContext(pi.hdc)
+	if pi.context == nil {
+		pi.close()
+		return nil, NewThreadError(fmt.Errorf("wglCreateContext failed (%d)", C.GetLastError()), false)
+	}
+
+	// Share the owner's textures so the pbuffer can copy into them
+	if C.wglShareLists(ic.context, pi.context) == C.FALSE {
+		pi.close()
+		return nil, NewThreadError(fmt.Errorf("wglShareLists failed (%d)", C.GetLastError()), false)
+	}
+
+	return pi, nil
+}
+
+// Make the pbuffer the current rendering target instead of its owner
+func (pi *pbufferInternal) activate() ThreadError {
+	if C.wglMakeCurrent(pi.hdc, pi.context) == C.FALSE {
+		return NewThreadError(fmt.Errorf("wglMakeCurrent failed (%d)", C.GetLastError()), false)
+	}
+	return nil
+}
+
+// Make the owner the current rendering target again
+func (pi *pbufferInternal) deactivate() ThreadError {
+	return pi.owner.take()
+}
+
+func (pi *pbufferInternal) close() {
+	if pi.context != nil {
+		C.wglDeleteContext(pi.context)
+	}
+	if pi.hdc != nil {
+		C.__wglReleasePbufferDCARB(&pi.owner.procs, pi.pbuffer, pi.hdc)
+	}
+	if pi.pbuffer != nil {
+		C.__wglDestroyPbufferARB(&pi.owner.procs, pi.pbuffer)
+	}
+}
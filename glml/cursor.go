@@ -0,0 +1,140 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"strconv"
+	"sync"
+)
+
+// The standard mouse cursor shapes of the OS
+type CursorShape int
+
+const (
+	CursorShapeArrow            CursorShape = iota // The default arrow
+	CursorShapeIBeam                               // Text selection
+	CursorShapeCrosshair                           // Precise selection
+	CursorShapeHand                                // A link or other clickable element
+	CursorShapeResizeHorizontal                    // Resize left or right
+	CursorShapeResizeVertical                      // Resize up or down
+	CursorShapeResizeNWSE                          // Resize along the top-left to bottom-right diagonal
+	CursorShapeResizeNESW                          // Resize along the top-right to bottom-left diagonal
+	CursorShapeResizeAll                           // Move in any direction
+	CursorShapeNotAllowed                          // The action is not allowed here
+	CursorShapeWait                                // The application is busy
+
+	CursorShapeCount // Keep last -- the total number of cursor shapes
+)
+
+var cursorShapeNames = [CursorShapeCount]string{
+	CursorShapeArrow:            "Arrow",
+	CursorShapeIBeam:            "IBeam",
+	CursorShapeCrosshair:        "Crosshair",
+	CursorShapeHand:             "Hand",
+	CursorShapeResizeHorizontal: "ResizeHorizontal",
+	CursorShapeResizeVertical:   "ResizeVertical",
+	CursorShapeResizeNWSE:       "ResizeNWSE",
+	CursorShapeResizeNESW:       "ResizeNESW",
+	CursorShapeResizeAll:        "ResizeAll",
+	CursorShapeNotAllowed:       "NotAllowed",
+	CursorShapeWait:             "Wait",
+}
+
+func (s CursorShape) String() string {
+	if s < 0 || s >= CursorShapeCount {
+		return "CursorShape(" + strconv.Itoa(int(s)) + ")"
+	}
+	return cursorShapeNames[s]
+}
+
+// A mouse cursor image, displayed with Window.ThreadSetCursor
+//
+// Cursors are reference counted. The creator holds a reference, which it
+// gives up by calling Release, and each window displaying the cursor holds
+// one until it displays another cursor or is closed. The cursor is freed
+// when the last reference is released.
+type Cursor struct {
+	mutex    sync.Mutex
+	refs     int // The number of references held, 0 once freed
+	internal cursorInternal
+}
+
+// Create a cursor with one of the OS's standard shapes
+func CreateStandardCursor(shape CursorShape) (*Cursor, error) {
+	if shape < 0 || shape >= CursorShapeCount {
+		panic(errors.New("cursor shape out of range"))
+	}
+
+	c := &Cursor{refs: 1}
+	if err := c.internal.initializeStandard(shape); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Create a cursor from an image. The hotspot, the point of the cursor that
+// clicks, is relative to the top-left of the image. Transparency is
+// supported, but some systems only display cursors of particular sizes
+// (e.g. 32x32) and will scale others.
+func CreateCursorFromImage(img image.Image, hotX, hotY int) (*Cursor, error) {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil, errors.New("cursor image is empty")
+	}
+	if hotX < 0 || hotY < 0 || hotX >= bounds.Dx() || hotY >= bounds.Dy() {
+		return nil, errors.New("cursor hotspot is outside the image")
+	}
+
+	c := &Cursor{refs: 1}
+	if err := c.internal.initializeImage(imageToNRGBA(img), hotX, hotY); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Give up the creator's reference to the cursor. Windows displaying the
+// cursor keep it alive until they stop.
+func (c *Cursor) Release() {
+	c.release()
+}
+
+// Take a reference to the cursor
+func (c *Cursor) retain() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.refs == 0 {
+		panic("cursor has already been freed")
+	}
+	c.refs++
+}
+
+// Give up a reference to the cursor, freeing it if it was the last
+func (c *Cursor) release() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.refs == 0 {
+		panic("cursor has already been freed")
+	}
+
+	c.refs--
+	if c.refs == 0 {
+		c.internal.destroy()
+	}
+}
+
+// Convert an image to non-premultiplied RGBA pixels with its top-left at
+// the origin
+func imageToNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	if nrgba, ok := img.(*image.NRGBA); ok && bounds.Min == (image.Point{}) && nrgba.Stride == 4*bounds.Dx() {
+		return nrgba
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+	return nrgba
+}
@@ -0,0 +1,69 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestCursor_References(t *testing.T) {
+	c := &Cursor{refs: 1}
+	c.retain() // A window displays it
+	c.Release()
+	if c.refs != 1 {
+		t.Fatalf("expected the window's reference to remain, got %d", c.refs)
+	}
+
+	c.release() // The window closes
+	if c.refs != 0 {
+		t.Fatalf("expected the cursor to be freed, got %d references", c.refs)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected retaining a freed cursor to panic")
+		}
+	}()
+	c.retain()
+}
+
+func TestCreateCursorFromImage_Invalid(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	tests := []struct {
+		img        image.Image
+		hotX, hotY int
+	}{
+		{image.NewNRGBA(image.Rect(0, 0, 0, 16)), 0, 0},
+		{img, -1, 0},
+		{img, 0, 16},
+		{img, 16, 0},
+	}
+
+	for _, test := range tests {
+		if _, err := CreateCursorFromImage(test.img, test.hotX, test.hotY); err == nil {
+			t.Errorf("expected an error for %v with hotspot %d,%d", test.img.Bounds(), test.hotX, test.hotY)
+		}
+	}
+}
+
+func TestImageToNRGBA(t *testing.T) {
+	// An offset image with premultiplied colors
+	img := image.NewRGBA(image.Rect(10, 20, 12, 22))
+	img.Set(10, 20, color.RGBA{0x80, 0, 0, 0x80})
+	img.Set(11, 21, color.RGBA{0, 0, 0xFF, 0xFF})
+
+	nrgba := imageToNRGBA(img)
+	if nrgba.Bounds() != image.Rect(0, 0, 2, 2) {
+		t.Fatalf("unexpected bounds %v", nrgba.Bounds())
+	}
+	if c := nrgba.NRGBAAt(0, 0); c != (color.NRGBA{0xFF, 0, 0, 0x80}) {
+		t.Errorf("expected unpremultiplied red, got %v", c)
+	}
+	if c := nrgba.NRGBAAt(1, 1); c != (color.NRGBA{0, 0, 0xFF, 0xFF}) {
+		t.Errorf("expected blue, got %v", c)
+	}
+	if c := nrgba.NRGBAAt(1, 0); c.A != 0 {
+		t.Errorf("expected transparent, got %v", c)
+	}
+}
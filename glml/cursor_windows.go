@@ -0,0 +1,63 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "helper_windows.h"
+import "C"
+import (
+	"fmt"
+	"image"
+)
+
+// The resource IDs of the standard cursors, e.g. IDC_IBEAM
+var cursorShapeIDs = [CursorShapeCount]C.WORD{
+	CursorShapeArrow:            32512, // IDC_ARROW
+	CursorShapeIBeam:            32513, // IDC_IBEAM
+	CursorShapeCrosshair:        32515, // IDC_CROSS
+	CursorShapeHand:             32649, // IDC_HAND
+	CursorShapeResizeHorizontal: 32644, // IDC_SIZEWE
+	CursorShapeResizeVertical:   32645, // IDC_SIZENS
+	CursorShapeResizeNWSE:       32642, // IDC_SIZENWSE
+	CursorShapeResizeNESW:       32643, // IDC_SIZENESW
+	CursorShapeResizeAll:        32646, // IDC_SIZEALL
+	CursorShapeNotAllowed:       32648, // IDC_NO
+	CursorShapeWait:             32514, // IDC_WAIT
+}
+
+type cursorInternal struct {
+	handle C.HCURSOR
+	shared bool // Standard cursors belong to the system and must not be destroyed
+}
+
+func (ci *cursorInternal) initializeStandard(shape CursorShape) error {
+	ci.handle = C.__LoadSystemCursor(cursorShapeIDs[shape])
+	if ci.handle == nil {
+		return fmt.Errorf("LoadCursorW failed (%d)", C.GetLastError())
+	}
+	ci.shared = true
+	return nil
+}
+
+func (ci *cursorInternal) initializeImage(img *image.NRGBA, hotX, hotY int) error {
+	// Windows wants BGRA pixels: swap red and blue channels
+	bounds := img.Bounds()
+	pixels := make([]C.BYTE, 0, 4*bounds.Dx()*bounds.Dy())
+	for y := 0; y < bounds.Dy(); y++ {
+		row := img.Pix[y*img.Stride : y*img.Stride+4*bounds.Dx()]
+		for x := 0; x < len(row); x += 4 {
+			pixels = append(pixels, C.BYTE(row[x+2]), C.BYTE(row[x+1]), C.BYTE(row[x]), C.BYTE(row[x+3]))
+		}
+	}
+
+	ci.handle = C.__CreateCursorBGRA(C.int(bounds.Dx()), C.int(bounds.Dy()), &pixels[0], C.int(hotX), C.int(hotY))
+	if ci.handle == nil {
+		return fmt.Errorf("could not create cursor (%d)", C.GetLastError())
+	}
+	return nil
+}
+
+func (ci *cursorInternal) destroy() {
+	if ci.handle != nil && !ci.shared {
+		C.DestroyCursor(ci.handle)
+	}
+	ci.handle = nil
+}
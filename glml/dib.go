@@ -0,0 +1,145 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+)
+
+// Device independent bitmaps, a BITMAPINFOHEADER or one of its extensions
+// followed by the pixels, are how Windows applications exchange images on
+// the clipboard
+
+const (
+	dibRGB       = 0 // BI_RGB
+	dibBitfields = 3 // BI_BITFIELDS
+
+	dibV5HeaderSize = 124 // sizeof(BITMAPV5HEADER)
+)
+
+// Read an 8 bit channel through a BI_BITFIELDS mask
+func dibChannel(pixel, mask uint32) uint8 {
+	if mask == 0 {
+		return 0
+	}
+	value := (pixel & mask) >> uint(bits.TrailingZeros32(mask))
+	max := mask >> uint(bits.TrailingZeros32(mask))
+	return uint8(uint64(value) * 255 / uint64(max))
+}
+
+// Decode a 24 or 32 bit device independent bitmap
+func decodeDIB(data []byte) (*image.NRGBA, error) {
+	if len(data) < 40 {
+		return nil, errors.New("bitmap header is truncated")
+	}
+
+	headerSize := int(binary.LittleEndian.Uint32(data[0:]))
+	width := int(int32(binary.LittleEndian.Uint32(data[4:])))
+	height := int(int32(binary.LittleEndian.Uint32(data[8:])))
+	bitCount := int(binary.LittleEndian.Uint16(data[14:]))
+	compression := binary.LittleEndian.Uint32(data[16:])
+	colorsUsed := int(binary.LittleEndian.Uint32(data[32:]))
+	if headerSize < 40 || headerSize > len(data) {
+		return nil, fmt.Errorf("invalid bitmap header size %d", headerSize)
+	}
+
+	// Rows are stored bottom-up unless the height is negative
+	bottomUp := height > 0
+	if !bottomUp {
+		height = -height
+	}
+	if width <= 0 || height <= 0 || width > 1<<16 || height > 1<<16 {
+		return nil, fmt.Errorf("invalid bitmap size %dx%d", width, height)
+	}
+
+	// Masks follow a BITMAPINFOHEADER, but are part of the larger headers
+	offset := headerSize
+	red, green, blue, alpha := uint32(0x00FF0000), uint32(0x0000FF00), uint32(0x000000FF), uint32(0)
+	switch {
+	case compression == dibBitfields && bitCount == 32:
+		if headerSize == 40 {
+			offset += 12
+		}
+		if len(data) < 52 || headerSize == 40 && len(data) < offset {
+			return nil, errors.New("bitmap masks are truncated")
+		}
+		red = binary.LittleEndian.Uint32(data[40:])
+		green = binary.LittleEndian.Uint32(data[44:])
+		blue = binary.LittleEndian.Uint32(data[48:])
+		if headerSize >= 56 {
+			alpha = binary.LittleEndian.Uint32(data[52:])
+		}
+	case compression == dibRGB && (bitCount == 24 || bitCount == 32):
+	default:
+		return nil, fmt.Errorf("unsupported %d bit bitmap with compression %d", bitCount, compression)
+	}
+	offset += 4 * colorsUsed
+
+	stride := (width*bitCount + 31) / 32 * 4
+	if offset > len(data) || (len(data)-offset)/stride < height {
+		return nil, errors.New("bitmap pixels are truncated")
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		row := y
+		if bottomUp {
+			row = height - 1 - y
+		}
+		src := data[offset+row*stride:]
+
+		for x := 0; x < width; x++ {
+			var c color.NRGBA
+			if bitCount == 24 {
+				c = color.NRGBA{src[3*x+2], src[3*x+1], src[3*x], 0xFF}
+			} else {
+				pixel := binary.LittleEndian.Uint32(src[4*x:])
+				c = color.NRGBA{dibChannel(pixel, red), dibChannel(pixel, green), dibChannel(pixel, blue), 0xFF}
+				if alpha != 0 {
+					c.A = dibChannel(pixel, alpha)
+				}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img, nil
+}
+
+// Encode an image as a 32 bit bitmap with a BITMAPV5HEADER, so its alpha
+// channel is kept
+func encodeDIB(img *image.NRGBA) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	data := make([]byte, dibV5HeaderSize+4*width*height)
+
+	binary.LittleEndian.PutUint32(data[0:], dibV5HeaderSize)
+	binary.LittleEndian.PutUint32(data[4:], uint32(width))
+	binary.LittleEndian.PutUint32(data[8:], uint32(height)) // Bottom-up, which every application reads
+	binary.LittleEndian.PutUint16(data[12:], 1)             // Planes
+	binary.LittleEndian.PutUint16(data[14:], 32)
+	binary.LittleEndian.PutUint32(data[16:], dibBitfields)
+	binary.LittleEndian.PutUint32(data[20:], uint32(4*width*height))
+	binary.LittleEndian.PutUint32(data[40:], 0x00FF0000)
+	binary.LittleEndian.PutUint32(data[44:], 0x0000FF00)
+	binary.LittleEndian.PutUint32(data[48:], 0x000000FF)
+	binary.LittleEndian.PutUint32(data[52:], 0xFF000000)
+	binary.LittleEndian.PutUint32(data[56:], 0x73524742) // LCS_sRGB
+	binary.LittleEndian.PutUint32(data[108:], 4)         // LCS_GM_IMAGES
+
+	pixels := data[dibV5HeaderSize:]
+	for y := 0; y < height; y++ {
+		dst := pixels[4*width*(height-1-y):]
+		src := img.Pix[img.PixOffset(bounds.Min.X, bounds.Min.Y+y):]
+		for x := 0; x < width; x++ {
+			dst[4*x+0] = src[4*x+2]
+			dst[4*x+1] = src[4*x+1]
+			dst[4*x+2] = src[4*x+0]
+			dst[4*x+3] = src[4*x+3]
+		}
+	}
+	return data
+}
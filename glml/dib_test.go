@@ -0,0 +1,99 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+// Build a BITMAPINFOHEADER
+func dibHeader(width, height int32, bitCount uint16, compression uint32) []byte {
+	header := make([]byte, 40)
+	binary.LittleEndian.PutUint32(header[0:], 40)
+	binary.LittleEndian.PutUint32(header[4:], uint32(width))
+	binary.LittleEndian.PutUint32(header[8:], uint32(height))
+	binary.LittleEndian.PutUint16(header[12:], 1)
+	binary.LittleEndian.PutUint16(header[14:], bitCount)
+	binary.LittleEndian.PutUint32(header[16:], compression)
+	return header
+}
+
+func TestDecodeDIB(t *testing.T) {
+	// 24 bit rows are padded to 4 bytes, and stored bottom-up
+	data := dibHeader(2, 2, 24, dibRGB)
+	data = append(data,
+		0, 0, 255, 0, 255, 0, 0, 0, // Bottom row: red, green, padding
+		255, 0, 0, 255, 255, 255, 0, 0, // Top row: blue, white, padding
+	)
+	img, err := decodeDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []color.NRGBA{{0, 0, 255, 255}, {255, 255, 255, 255}, {255, 0, 0, 255}, {0, 255, 0, 255}}
+	for i, c := range expected {
+		if got := img.NRGBAAt(i%2, i/2); got != c {
+			t.Errorf("pixel %d: expected %v, got %v", i, c, got)
+		}
+	}
+
+	// 32 bit BI_RGB has no alpha, and a negative height is top-down
+	data = append(dibHeader(1, -2, 32, dibRGB), 1, 2, 3, 0, 4, 5, 6, 0)
+	if img, err = decodeDIB(data); err != nil {
+		t.Fatal(err)
+	}
+	if c := img.NRGBAAt(0, 0); c != (color.NRGBA{3, 2, 1, 255}) {
+		t.Errorf("unexpected top pixel %v", c)
+	}
+
+	// Masks follow a BITMAPINFOHEADER, here 5 bits per channel
+	data = dibHeader(1, 1, 32, dibBitfields)
+	for _, mask := range []uint32{0x7C00, 0x03E0, 0x001F} {
+		data = binary.LittleEndian.AppendUint32(data, mask)
+	}
+	data = binary.LittleEndian.AppendUint32(data, 0x7C1F)
+	if img, err = decodeDIB(data); err != nil {
+		t.Fatal(err)
+	}
+	if c := img.NRGBAAt(0, 0); c != (color.NRGBA{255, 0, 255, 255}) {
+		t.Errorf("unexpected masked pixel %v", c)
+	}
+
+	for _, bad := range [][]byte{
+		data[:30],
+		dibHeader(1, 1, 8, dibRGB),
+		dibHeader(1, 1, 32, 1),
+		dibHeader(0, 1, 32, dibRGB),
+		append(dibHeader(2, 2, 24, dibRGB), make([]byte, 12)...),
+	} {
+		if _, err := decodeDIB(bad); err == nil {
+			t.Errorf("expected an error for %v", bad)
+		}
+	}
+}
+
+func TestEncodeDIB(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 255})
+	img.SetNRGBA(1, 0, color.NRGBA{10, 20, 30, 0})
+	img.SetNRGBA(2, 1, color.NRGBA{0, 0, 255, 128})
+
+	data := encodeDIB(img)
+	if len(data) != dibV5HeaderSize+4*3*2 {
+		t.Fatalf("unexpected size %d", len(data))
+	}
+	// The bottom row comes first, in BGRA order
+	if pixel := data[dibV5HeaderSize+8 : dibV5HeaderSize+12]; !reflect.DeepEqual(pixel, []byte{255, 0, 0, 128}) {
+		t.Errorf("unexpected bottom right pixel %v", pixel)
+	}
+
+	decoded, err := decodeDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Pix, img.Pix) {
+		t.Errorf("expected %v, got %v", img.Pix, decoded.Pix)
+	}
+}
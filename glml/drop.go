@@ -0,0 +1,95 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Turns the platform's drag and drop callbacks into drag and drop events.
+// Platforms report the position of a drag repeatedly even when it doesn't
+// move, so only moves are reported.
+type dropInput struct {
+	dragging bool // Is an accepted drag over the window?
+	x, y     int  // The last position of the drag
+}
+
+// A drag entered the window. Returns whether the drag is accepted, which
+// it is if it carries files or text.
+func (di *dropInput) enter(header EventHeader, x, y int, files, text bool) ([]Event, bool) {
+	var events []Event
+	if di.dragging {
+		events = di.leave(header)
+	}
+	if !files && !text {
+		return events, false
+	}
+
+	di.dragging = true
+	di.x, di.y = x, y
+	return append(events, DragEnterEvent{
+		EventHeader: header,
+		X:           x,
+		Y:           y,
+		Files:       files,
+		Text:        text,
+	}), true
+}
+
+// A drag is over the window
+func (di *dropInput) over(header EventHeader, x, y int) []Event {
+	if !di.dragging || di.x == x && di.y == y {
+		return nil
+	}
+
+	di.x, di.y = x, y
+	return []Event{DragOverEvent{EventHeader: header, X: x, Y: y}}
+}
+
+// A drag left the window or was cancelled
+func (di *dropInput) leave(header EventHeader) []Event {
+	if !di.dragging {
+		return nil
+	}
+
+	di.dragging = false
+	return []Event{DragLeaveEvent{EventHeader: header}}
+}
+
+// A drag was dropped on the window. Files are reported rather than text
+// when a drag carries both, e.g. from a file manager. Returns whether
+// anything was dropped.
+func (di *dropInput) drop(header EventHeader, x, y int, paths []string, text string, hasText bool) ([]Event, bool) {
+	if !di.dragging {
+		return nil, false
+	}
+	di.dragging = false
+
+	switch {
+	case len(paths) != 0:
+		return []Event{FileDropEvent{EventHeader: header, Paths: paths, X: x, Y: y}}, true
+	case hasText:
+		return []Event{TextDropEvent{EventHeader: header, Text: strings.ReplaceAll(text, "\r\n", "\n"), X: x, Y: y}}, true
+	}
+
+	// The drag didn't carry what it offered
+	return []Event{DragLeaveEvent{EventHeader: header}}, false
+}
+
+// Split a list of NUL terminated UTF-16 strings, which ends with an empty
+// string or the end of the list
+func splitUTF16List(list []uint16) []string {
+	var strs []string
+	for len(list) != 0 && list[0] != 0 {
+		end := 0
+		for end < len(list) && list[end] != 0 {
+			end++
+		}
+		strs = append(strs, string(utf16.Decode(list[:end])))
+		if end == len(list) {
+			break
+		}
+		list = list[end+1:]
+	}
+	return strs
+}
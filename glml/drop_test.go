@@ -0,0 +1,104 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// Describe drag and drop events without their times
+func describeDropEvents(events []Event) []string {
+	var descriptions []string
+	for _, e := range events {
+		switch e := e.(type) {
+		case DragEnterEvent:
+			descriptions = append(descriptions, fmt.Sprintf("enter %d,%d files %v text %v", e.X, e.Y, e.Files, e.Text))
+		case DragOverEvent:
+			descriptions = append(descriptions, fmt.Sprintf("over %d,%d", e.X, e.Y))
+		case DragLeaveEvent:
+			descriptions = append(descriptions, "leave")
+		case FileDropEvent:
+			descriptions = append(descriptions, fmt.Sprintf("files %q %d,%d", e.Paths, e.X, e.Y))
+		case TextDropEvent:
+			descriptions = append(descriptions, fmt.Sprintf("text %q %d,%d", e.Text, e.X, e.Y))
+		default:
+			descriptions = append(descriptions, fmt.Sprintf("%T", e))
+		}
+	}
+	return descriptions
+}
+
+func TestDropInput(t *testing.T) {
+	var di dropInput
+	var events []Event
+	collect := func(e []Event, accepted bool) bool {
+		events = append(events, e...)
+		return accepted
+	}
+
+	// A drag of neither files nor text is ignored
+	if collect(di.enter(EventHeader{}, 1, 1, false, false)) {
+		t.Error("expected an empty drag to be refused")
+	}
+	events = append(events, di.over(EventHeader{}, 2, 2)...)
+	events = append(events, di.leave(EventHeader{})...)
+
+	if !collect(di.enter(EventHeader{}, 10, 20, true, true)) {
+		t.Error("expected a file drag to be accepted")
+	}
+	events = append(events, di.over(EventHeader{}, 10, 20)...) // Didn't move
+	events = append(events, di.over(EventHeader{}, 11, 20)...)
+	events = append(events, di.leave(EventHeader{})...)
+	events = append(events, di.leave(EventHeader{})...)
+
+	collect(di.enter(EventHeader{}, 5, 5, true, true))
+	if !collect(di.drop(EventHeader{}, 6, 7, []string{`C:\a.png`, `C:\b c.txt`}, "ignored", true)) {
+		t.Error("expected the files to be dropped")
+	}
+	collect(di.enter(EventHeader{}, 5, 5, false, true))
+	collect(di.drop(EventHeader{}, 8, 9, nil, "one\r\ntwo", true))
+
+	// Offered text which isn't delivered ends the drag
+	collect(di.enter(EventHeader{}, 5, 5, false, true))
+	if collect(di.drop(EventHeader{}, 8, 9, nil, "", false)) {
+		t.Error("expected nothing to be dropped")
+	}
+	if collect(di.drop(EventHeader{}, 8, 9, []string{"late"}, "", false)) {
+		t.Error("expected a drop without a drag to be refused")
+	}
+
+	expected := []string{
+		"enter 10,20 files true text true",
+		"over 11,20",
+		"leave",
+		"enter 5,5 files true text true",
+		`files ["C:\\a.png" "C:\\b c.txt"] 6,7`,
+		"enter 5,5 files false text true",
+		`text "one\ntwo" 8,9`,
+		"enter 5,5 files false text true",
+		"leave",
+	}
+	if descriptions := describeDropEvents(events); !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("expected %q, got %q", expected, descriptions)
+	}
+}
+
+func TestSplitUTF16List(t *testing.T) {
+	tests := []struct {
+		list     string
+		expected []string
+	}{
+		{"", nil},
+		{"\x00", nil},
+		{"a\x00\x00", []string{"a"}},
+		{"C:\\dir\x00C:\\ü.txt\x00\x00ignored\x00", []string{"C:\\dir", "C:\\ü.txt"}},
+		{"unterminated", []string{"unterminated"}},
+	}
+	for _, test := range tests {
+		if strs := splitUTF16List(utf16.Encode([]rune(test.list))); !reflect.DeepEqual(strs, test.expected) {
+			t.Errorf("%q: expected %q, got %q", test.list, test.expected, strs)
+		}
+	}
+}
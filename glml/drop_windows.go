@@ -0,0 +1,111 @@
+// Copyright © 2012 Popog
+package glml
+
+// #cgo windows LDFLAGS: -lole32 -lshell32
+// #include "helper_windows.h"
+// #include <ole2.h>
+import "C"
+import (
+	"fmt"
+	"unicode/utf16"
+	"unsafe"
+)
+
+// The actions and offers of dropTargetDrag, as in dropinternal_windows_c.c
+const (
+	dropEnter = 0
+	dropOver  = 1
+	dropLeave = 2
+
+	dropOffersFiles = 1
+	dropOffersText  = 2
+)
+
+// Register the window as a drop target. On failure, e.g. RPC_E_CHANGED_MODE
+// when the thread already joined a multithreaded apartment, the window
+// keeps working but nothing can be dropped on it.
+func (wi *windowInternal) registerDropTarget() ThreadError {
+	if result := C.OleInitialize(nil); result != C.S_OK && result != C.S_FALSE {
+		return NewThreadError(fmt.Errorf("OleInitialize failed (0x%x)", uint32(result)), false)
+	}
+
+	if result := C.__RegisterDropTarget(wi.window.Handle); result != C.S_OK {
+		C.OleUninitialize()
+		return NewThreadError(fmt.Errorf("RegisterDragDrop failed (0x%x)", uint32(result)), false)
+	}
+	wi.oleInitialized = true
+	return nil
+}
+
+func (wi *windowInternal) revokeDropTarget() {
+	if !wi.oleInitialized {
+		return
+	}
+
+	C.RevokeDragDrop(wi.window.Handle)
+	C.OleUninitialize()
+	wi.oleInitialized = false
+}
+
+// Get the window a drop target was registered for
+func dropTargetWindow(handle C.HWND) *windowInternal {
+	return (*windowInternal)(C.__GetWindowLongPtr(handle, C.GWLP_USERDATA))
+}
+
+//export dropTargetDrag
+func dropTargetDrag(handle C.HWND, action C.int, x, y C.LONG, offers C.int) C.BOOL {
+	wi := dropTargetWindow(handle)
+	if wi == nil {
+		return C.FALSE
+	}
+
+	header := EventHeader{
+		Time:   currentTimestamp(),
+		Source: wi.owner,
+	}
+
+	switch action {
+	case dropEnter:
+		events, accepted := wi.drops.enter(header, int(x), int(y), offers&dropOffersFiles != 0, offers&dropOffersText != 0)
+		wi.events = append(wi.events, events...)
+		if accepted {
+			return C.TRUE
+		}
+	case dropOver:
+		wi.events = append(wi.events, wi.drops.over(header, int(x), int(y))...)
+		return C.TRUE
+	case dropLeave:
+		wi.events = append(wi.events, wi.drops.leave(header)...)
+	}
+
+	return C.FALSE
+}
+
+//export dropTargetDrop
+func dropTargetDrop(handle C.HWND, x, y C.LONG, files *C.WCHAR, filesLength C.int, text *C.WCHAR, textLength C.int) C.BOOL {
+	wi := dropTargetWindow(handle)
+	if wi == nil {
+		return C.FALSE
+	}
+
+	header := EventHeader{
+		Time:   currentTimestamp(),
+		Source: wi.owner,
+	}
+
+	var paths []string
+	if files != nil {
+		paths = splitUTF16List(unsafe.Slice((*uint16)(unsafe.Pointer(files)), filesLength))
+	}
+	var dropped string
+	if text != nil {
+		dropped = string(utf16.Decode(unsafe.Slice((*uint16)(unsafe.Pointer(text)), textLength)))
+	}
+
+	events, accepted := wi.drops.drop(header, int(x), int(y), paths, dropped, textLength >= 0)
+	wi.events = append(wi.events, events...)
+	if accepted {
+		return C.TRUE
+	}
+	return C.FALSE
+}
@@ -0,0 +1,213 @@
+// Copyright © 2012 Popog
+
+#define WIN32_LEAN_AND_MEAN 1
+#include <windows.h>
+#include <ole2.h>
+#include <shellapi.h>
+
+#include "_cgo_export.h"
+
+// The actions and offers passed to dropTargetDrag
+#define DROP_ENTER 0
+#define DROP_OVER  1
+#define DROP_LEAVE 2
+
+#define DROP_OFFERS_FILES 1
+#define DROP_OFFERS_TEXT  2
+
+// Defined here rather than linking uuid.lib
+static const IID dropIID_IUnknown = {0x00000000, 0x0000, 0x0000, {0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}};
+static const IID dropIID_IDropTarget = {0x00000122, 0x0000, 0x0000, {0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}};
+
+// The IDropTarget registered for a window, which forwards to Go
+typedef struct
+{
+	IDropTarget target;
+	LONG refs;
+	HWND window;
+	BOOL accepted; // Was the current drag accepted?
+} glmlDropTarget;
+
+static HRESULT STDMETHODCALLTYPE dropQueryInterface(IDropTarget *This, REFIID riid, void **ppvObject)
+{
+	if (IsEqualIID(riid, &dropIID_IUnknown) || IsEqualIID(riid, &dropIID_IDropTarget))
+	{
+		*ppvObject = This;
+		This->lpVtbl->AddRef(This);
+		return S_OK;
+	}
+	*ppvObject = NULL;
+	return E_NOINTERFACE;
+}
+
+static ULONG STDMETHODCALLTYPE dropAddRef(IDropTarget *This)
+{
+	return InterlockedIncrement(&((glmlDropTarget *)This)->refs);
+}
+
+static ULONG STDMETHODCALLTYPE dropRelease(IDropTarget *This)
+{
+	LONG refs = InterlockedDecrement(&((glmlDropTarget *)This)->refs);
+	if (refs == 0)
+		HeapFree(GetProcessHeap(), 0, This);
+	return refs;
+}
+
+// Does the data hold a format as a global memory handle?
+static BOOL dropHasFormat(IDataObject *data, CLIPFORMAT format)
+{
+	FORMATETC formatEtc = {format, NULL, DVASPECT_CONTENT, -1, TYMED_HGLOBAL};
+	return data->lpVtbl->QueryGetData(data, &formatEtc) == S_OK;
+}
+
+// Report a drag position to Go, in client coordinates
+static BOOL dropDrag(glmlDropTarget *target, int action, POINTL pt, int offers)
+{
+	POINT point = {pt.x, pt.y};
+	ScreenToClient(target->window, &point);
+	return dropTargetDrag(target->window, action, point.x, point.y, offers);
+}
+
+static HRESULT STDMETHODCALLTYPE dropDragEnter(IDropTarget *This, IDataObject *pDataObj, DWORD grfKeyState, POINTL pt, DWORD *pdwEffect)
+{
+	glmlDropTarget *target = (glmlDropTarget *)This;
+	int offers = 0;
+	if (dropHasFormat(pDataObj, CF_HDROP))
+		offers |= DROP_OFFERS_FILES;
+	if (dropHasFormat(pDataObj, CF_UNICODETEXT))
+		offers |= DROP_OFFERS_TEXT;
+
+	target->accepted = dropDrag(target, DROP_ENTER, pt, offers);
+	*pdwEffect = target->accepted ? *pdwEffect & DROPEFFECT_COPY : DROPEFFECT_NONE;
+	return S_OK;
+}
+
+static HRESULT STDMETHODCALLTYPE dropDragOver(IDropTarget *This, DWORD grfKeyState, POINTL pt, DWORD *pdwEffect)
+{
+	glmlDropTarget *target = (glmlDropTarget *)This;
+	if (target->accepted)
+		dropDrag(target, DROP_OVER, pt, 0);
+	*pdwEffect = target->accepted ? *pdwEffect & DROPEFFECT_COPY : DROPEFFECT_NONE;
+	return S_OK;
+}
+
+static HRESULT STDMETHODCALLTYPE dropDragLeave(IDropTarget *This)
+{
+	glmlDropTarget *target = (glmlDropTarget *)This;
+	POINTL pt = {0, 0};
+	if (target->accepted)
+		dropDrag(target, DROP_LEAVE, pt, 0);
+	target->accepted = FALSE;
+	return S_OK;
+}
+
+// Get the dropped files as a list of NUL terminated paths
+static WCHAR *dropGetFiles(IDataObject *data, int *length)
+{
+	FORMATETC formatEtc = {CF_HDROP, NULL, DVASPECT_CONTENT, -1, TYMED_HGLOBAL};
+	STGMEDIUM medium;
+	*length = 0;
+	if (data->lpVtbl->GetData(data, &formatEtc, &medium) != S_OK)
+		return NULL;
+
+	HDROP drop = (HDROP)GlobalLock(medium.hGlobal);
+	WCHAR *files = NULL;
+	if (drop != NULL)
+	{
+		UINT count = DragQueryFileW(drop, 0xFFFFFFFF, NULL, 0);
+		int size = 0;
+		for (UINT i = 0; i < count; i++)
+			size += DragQueryFileW(drop, i, NULL, 0) + 1;
+
+		files = HeapAlloc(GetProcessHeap(), 0, (size + 1) * sizeof(WCHAR));
+		if (files != NULL)
+		{
+			for (UINT i = 0; i < count; i++)
+				*length += DragQueryFileW(drop, i, files + *length, size + 1 - *length) + 1;
+			files[*length] = 0;
+		}
+		GlobalUnlock(medium.hGlobal);
+	}
+	ReleaseStgMedium(&medium);
+	return files;
+}
+
+// Get the dropped text, without its NUL terminator
+static WCHAR *dropGetText(IDataObject *data, int *length)
+{
+	FORMATETC formatEtc = {CF_UNICODETEXT, NULL, DVASPECT_CONTENT, -1, TYMED_HGLOBAL};
+	STGMEDIUM medium;
+	*length = 0;
+	if (data->lpVtbl->GetData(data, &formatEtc, &medium) != S_OK)
+		return NULL;
+
+	const WCHAR *text = GlobalLock(medium.hGlobal);
+	WCHAR *copy = NULL;
+	if (text != NULL)
+	{
+		SIZE_T size = GlobalSize(medium.hGlobal) / sizeof(WCHAR);
+		while ((SIZE_T)*length < size && text[*length] != 0)
+			(*length)++;
+
+		copy = HeapAlloc(GetProcessHeap(), 0, (*length + 1) * sizeof(WCHAR));
+		if (copy != NULL)
+			CopyMemory(copy, text, *length * sizeof(WCHAR));
+		GlobalUnlock(medium.hGlobal);
+	}
+	ReleaseStgMedium(&medium);
+	return copy;
+}
+
+static HRESULT STDMETHODCALLTYPE dropDrop(IDropTarget *This, IDataObject *pDataObj, DWORD grfKeyState, POINTL pt, DWORD *pdwEffect)
+{
+	glmlDropTarget *target = (glmlDropTarget *)This;
+	if (!target->accepted)
+	{
+		*pdwEffect = DROPEFFECT_NONE;
+		return S_OK;
+	}
+	target->accepted = FALSE;
+
+	int filesLength, textLength;
+	WCHAR *files = dropGetFiles(pDataObj, &filesLength);
+	WCHAR *text = files == NULL ? dropGetText(pDataObj, &textLength) : NULL;
+
+	POINT point = {pt.x, pt.y};
+	ScreenToClient(target->window, &point);
+	BOOL dropped = dropTargetDrop(target->window, point.x, point.y, files, filesLength, text, text == NULL ? -1 : textLength);
+
+	if (files != NULL)
+		HeapFree(GetProcessHeap(), 0, files);
+	if (text != NULL)
+		HeapFree(GetProcessHeap(), 0, text);
+
+	*pdwEffect = dropped ? *pdwEffect & DROPEFFECT_COPY : DROPEFFECT_NONE;
+	return S_OK;
+}
+
+static IDropTargetVtbl dropTargetVtbl = {
+	dropQueryInterface,
+	dropAddRef,
+	dropRelease,
+	dropDragEnter,
+	dropDragOver,
+	dropDragLeave,
+	dropDrop,
+};
+
+// Register a window as a drop target. OLE must be initialized on the thread.
+HRESULT __RegisterDropTarget(HWND hWnd)
+{
+	glmlDropTarget *target = HeapAlloc(GetProcessHeap(), HEAP_ZERO_MEMORY, sizeof(glmlDropTarget));
+	if (target == NULL)
+		return E_OUTOFMEMORY;
+
+	target->target.lpVtbl = &dropTargetVtbl;
+	target->refs = 1;
+	target->window = hWnd;
+
+	// RegisterDragDrop holds its own reference until RevokeDragDrop
+	HRESULT result = RegisterDragDrop(hWnd, &target->target);
+	dropRelease(&target->target);
+	return result;
+}
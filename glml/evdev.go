@@ -0,0 +1,177 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"encoding/binary"
+	"time"
+	"unsafe"
+)
+
+// Where joysticks come from. Devices speak the Linux evdev protocol: the
+// default source reads /dev/input/event* on Linux and presents XInput
+// controllers as evdev devices on Windows, and tests substitute recorded
+// event streams.
+type JoystickSource interface {
+	// List the paths of the input devices currently present
+	Devices() ([]string, error)
+
+	// Open an input device
+	Open(path string) (JoystickDevice, error)
+}
+
+// An open evdev input device. Devices which can rumble or have LEDs also
+// implement JoystickFeedbackDevice.
+type JoystickDevice interface {
+	// Read the identity, capabilities and current state of the device
+	Info() (EvdevInfo, error)
+
+	// Read pending input events, each a struct input_event. Returns 0, nil
+	// when no events are pending, and an error once the device is gone.
+	Read(p []byte) (int, error)
+
+	Close() error
+}
+
+// An evdev device with outputs, see Joystick.Capabilities
+type JoystickFeedbackDevice interface {
+	JoystickDevice
+
+	// Upload a force feedback effect, a struct ff_effect, with EVIOCSFF. An
+	// id of -1 uploads a new effect, and the device sets the id it got.
+	UploadEffect(effect []byte) error
+
+	// Remove an uploaded effect with EVIOCRMFF
+	RemoveEffect(id int16) error
+
+	// Write input events, each a struct input_event, e.g. to play an effect
+	Write(p []byte) (int, error)
+
+	// Set the brightness of one of the LEDs in EvdevInfo.LEDs
+	SetLED(name string, brightness int) error
+}
+
+// The identity, capabilities and state of an evdev device
+type EvdevInfo struct {
+	Name                          string
+	Bus, Vendor, Product, Version uint16         // From struct input_id
+	Keys                          []uint16       // The key and button codes the device reports, e.g. BTN_SOUTH
+	Pressed                       []uint16       // The keys currently pressed
+	Axes                          []EvdevAxis    // The absolute axes the device reports
+	ForceFeedback                 []uint16       // The force feedback effect types the device plays, e.g. FF_RUMBLE
+	Effects                       int            // The number of effects which can be uploaded at once
+	LEDs                          map[string]int // The LEDs of the device, e.g. "xpad0", with their maximum brightness
+}
+
+// An absolute axis of an evdev device, from struct input_absinfo
+type EvdevAxis struct {
+	Code                  uint16 // e.g. ABS_X
+	Value, Min, Max, Flat int32
+}
+
+// Event types and codes from linux/input-event-codes.h
+const (
+	evdevSyn = 0x00 // EV_SYN
+	evdevKey = 0x01 // EV_KEY
+	evdevAbs = 0x03 // EV_ABS
+	evdevFF  = 0x15 // EV_FF
+
+	evdevSynReport  = 0 // SYN_REPORT
+	evdevSynDropped = 3 // SYN_DROPPED
+
+	evdevBtnMisc     = 0x100 // BTN_MISC
+	evdevBtnJoystick = 0x120 // BTN_JOYSTICK
+	evdevBtnDigi     = 0x140 // BTN_DIGI, the first button after the joystick and gamepad buttons
+	evdevKeyMax      = 0x2FF // KEY_MAX
+
+	evdevAbsHat0X = 0x10 // ABS_HAT0X
+	evdevAbsHat3Y = 0x17 // ABS_HAT3Y
+	evdevAbsMax   = 0x3F // ABS_MAX
+
+	evdevFFRumble = 0x50 // FF_RUMBLE
+	evdevFFMax    = 0x7F // FF_MAX
+)
+
+// The size of a struct input_event: a struct timeval of two longs, then
+// the type, code and value
+const evdevEventSize = 2*int(unsafe.Sizeof(uintptr(0))) + 8
+
+// The size of a struct ff_effect: a header of 16 bytes and a union whose
+// largest member, struct ff_periodic_effect, ends with a pointer
+const evdevEffectSize = 16 + 24 + int(unsafe.Sizeof(uintptr(0)))
+
+// The byte order of the kernel's structs, which is the machine's own
+var evdevByteOrder = func() binary.ByteOrder {
+	one := uint16(1)
+	if *(*byte)(unsafe.Pointer(&one)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
+
+// A decoded struct input_event, without its time
+type evdevEvent struct {
+	Type, Code uint16
+	Value      int32
+}
+
+// Decode the whole events at the start of data, returning the rest
+func decodeEvdevEvents(data []byte) ([]evdevEvent, []byte) {
+	var events []evdevEvent
+	for ; len(data) >= evdevEventSize; data = data[evdevEventSize:] {
+		event := data[evdevEventSize-8 : evdevEventSize]
+		events = append(events, evdevEvent{
+			Type:  evdevByteOrder.Uint16(event[0:]),
+			Code:  evdevByteOrder.Uint16(event[2:]),
+			Value: int32(evdevByteOrder.Uint32(event[4:])),
+		})
+	}
+	return events, data
+}
+
+// Encode a struct input_event, with a zero time
+func encodeEvdevEvent(e evdevEvent) []byte {
+	data := make([]byte, evdevEventSize)
+	event := data[evdevEventSize-8:]
+	evdevByteOrder.PutUint16(event[0:], e.Type)
+	evdevByteOrder.PutUint16(event[2:], e.Code)
+	evdevByteOrder.PutUint32(event[4:], uint32(e.Value))
+	return data
+}
+
+// Encode a struct ff_effect for an FF_RUMBLE effect. The magnitudes are of
+// the strong (low frequency) and weak (high frequency) motors.
+func encodeEvdevRumble(id int16, strong, weak uint16, length time.Duration) []byte {
+	ms := length / time.Millisecond
+	if ms > 0xFFFF {
+		ms = 0xFFFF
+	}
+
+	effect := make([]byte, evdevEffectSize)
+	evdevByteOrder.PutUint16(effect[0:], evdevFFRumble) // type
+	evdevByteOrder.PutUint16(effect[2:], uint16(id))    // id
+	evdevByteOrder.PutUint16(effect[10:], uint16(ms))   // replay.length
+	evdevByteOrder.PutUint16(effect[16:], strong)       // u.rumble.strong_magnitude
+	evdevByteOrder.PutUint16(effect[18:], weak)         // u.rumble.weak_magnitude
+	return effect
+}
+
+// Is the code a joystick or gamepad button, as opposed to a keyboard key
+// or a mouse, touchpad or tablet button?
+func evdevIsJoystickButton(code uint16) bool {
+	return code >= evdevBtnJoystick && code < evdevBtnDigi
+}
+
+// Is the axis part of a hat switch?
+func evdevIsHat(code uint16) bool {
+	return code >= evdevAbsHat0X && code <= evdevAbsHat3Y
+}
+
+// Order buttons the way SDL numbers them, so gamepad mappings line up:
+// from BTN_JOYSTICK up, then from BTN_MISC up. Keyboard keys, below
+// BTN_MISC, aren't buttons.
+func evdevButtonOrder(code uint16) int {
+	if code >= evdevBtnJoystick {
+		return int(code) - evdevBtnJoystick
+	}
+	return evdevKeyMax + int(code)
+}
@@ -1,91 +1,562 @@
 // Copyright © 2012 Popog
 package glml
 
+import (
+	"strconv"
+	"time"
+)
+
+// All events implement Event
 type Event interface {
+	Kind() EventKind          // The type of the event
+	Timestamp() time.Duration // When the event occurred, see EventHeader.Time
+	Window() *Window          // The window the event was sent to
+}
+
+// The type of an event, so events can be dispatched without a type switch
+type EventKind int
+
+const (
+	EventKindWindowClosed EventKind = iota
+	EventKindWindowResize
+	EventKindWindowLostFocus
+	EventKindWindowGainedFocus
+	EventKindWindowMoved
+	EventKindWindowMinimized
+	EventKindWindowMaximized
+	EventKindWindowRestored
+	EventKindWindowExposed
+	EventKindTextEntered
+	EventKindTextComposition
+	EventKindTextCommit
+	EventKindKeyPressed
+	EventKindKeyReleased
+	EventKindKeyboardLayoutChanged
+	EventKindMouseMove
+	EventKindMouseRawMotion
+	EventKindMouseButtonPressed
+	EventKindMouseButtonReleased
+	EventKindMouseWheel
+	EventKindMouseScroll
+	EventKindMouseEntered
+	EventKindMouseLeft
+	EventKindMouseDoubleClick
+	EventKindMouseLongPress
+	EventKindTouchBegan
+	EventKindTouchMoved
+	EventKindTouchEnded
+	EventKindTouchCancelled
+	EventKindPen
+	EventKindDragEnter
+	EventKindDragOver
+	EventKindDragLeave
+	EventKindFileDrop
+	EventKindTextDrop
+	EventKindJoystickConnected
+	EventKindJoystickDisconnected
+	EventKindJoystickButtonPressed
+	EventKindJoystickButtonReleased
+	EventKindJoystickAxisMoved
+	EventKindJoystickHatMoved
+
+	EventKindCount // Keep last -- the total number of event kinds
+)
+
+var eventKindNames = [EventKindCount]string{
+	EventKindWindowClosed:           "WindowClosed",
+	EventKindWindowResize:           "WindowResize",
+	EventKindWindowLostFocus:        "WindowLostFocus",
+	EventKindWindowGainedFocus:      "WindowGainedFocus",
+	EventKindWindowMoved:            "WindowMoved",
+	EventKindWindowMinimized:        "WindowMinimized",
+	EventKindWindowMaximized:        "WindowMaximized",
+	EventKindWindowRestored:         "WindowRestored",
+	EventKindWindowExposed:          "WindowExposed",
+	EventKindTextEntered:            "TextEntered",
+	EventKindTextComposition:        "TextComposition",
+	EventKindTextCommit:             "TextCommit",
+	EventKindKeyPressed:             "KeyPressed",
+	EventKindKeyReleased:            "KeyReleased",
+	EventKindKeyboardLayoutChanged:  "KeyboardLayoutChanged",
+	EventKindMouseMove:              "MouseMove",
+	EventKindMouseRawMotion:         "MouseRawMotion",
+	EventKindMouseButtonPressed:     "MouseButtonPressed",
+	EventKindMouseButtonReleased:    "MouseButtonReleased",
+	EventKindMouseWheel:             "MouseWheel",
+	EventKindMouseScroll:            "MouseScroll",
+	EventKindMouseEntered:           "MouseEntered",
+	EventKindMouseLeft:              "MouseLeft",
+	EventKindMouseDoubleClick:       "MouseDoubleClick",
+	EventKindMouseLongPress:         "MouseLongPress",
+	EventKindTouchBegan:             "TouchBegan",
+	EventKindTouchMoved:             "TouchMoved",
+	EventKindTouchEnded:             "TouchEnded",
+	EventKindTouchCancelled:         "TouchCancelled",
+	EventKindPen:                    "Pen",
+	EventKindDragEnter:              "DragEnter",
+	EventKindDragOver:               "DragOver",
+	EventKindDragLeave:              "DragLeave",
+	EventKindFileDrop:               "FileDrop",
+	EventKindTextDrop:               "TextDrop",
+	EventKindJoystickConnected:      "JoystickConnected",
+	EventKindJoystickDisconnected:   "JoystickDisconnected",
+	EventKindJoystickButtonPressed:  "JoystickButtonPressed",
+	EventKindJoystickButtonReleased: "JoystickButtonReleased",
+	EventKindJoystickAxisMoved:      "JoystickAxisMoved",
+	EventKindJoystickHatMoved:       "JoystickHatMoved",
 }
 
-// db   d8b   db d888888b d8b   db d8888b.  .d88b.  db   d8b   db 
-// 88   I8I   88   `88'   888o  88 88  `8D .8P  Y8. 88   I8I   88 
-// 88   I8I   88    88    88V8o 88 88   88 88    88 88   I8I   88 
-// Y8   I8I   88    88    88 V8o88 88   88 88    88 Y8   I8I   88 
-// `8b d8'8b d8'   .88.   88  V888 88  .8D `8b  d8' `8b d8'8b d8' 
-//  `8b8' `8d8'  Y888888P VP   V8P Y8888D'  `Y88P'   `8b8' `8d8'  
+func (k EventKind) String() string {
+	if k < 0 || k >= EventKindCount {
+		return "EventKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return eventKindNames[k]
+}
+
+// Information common to every event. All event types embed it.
+type EventHeader struct {
+	// When the event occurred, on a monotonic clock with a system specific
+	// origin. Only differences between timestamps are meaningful.
+	Time   time.Duration
+	Source *Window // The window the event was sent to
+}
+
+func (h EventHeader) Timestamp() time.Duration { return h.Time }
+func (h EventHeader) Window() *Window          { return h.Source }
+
+// db   d8b   db d888888b d8b   db d8888b.  .d88b.  db   d8b   db
+// 88   I8I   88   `88'   888o  88 88  `8D .8P  Y8. 88   I8I   88
+// 88   I8I   88    88    88V8o 88 88   88 88    88 88   I8I   88
+// Y8   I8I   88    88    88 V8o88 88   88 88    88 Y8   I8I   88
+// `8b d8'8b d8'   .88.   88  V888 88  .8D `8b  d8' `8b d8'8b d8'
+//  `8b8' `8d8'  Y888888P VP   V8P Y8888D'  `Y88P'   `8b8' `8d8'
 
 // The window requested to be closed
 type WindowClosedEvent struct {
+	EventHeader
 }
 
+func (WindowClosedEvent) Kind() EventKind { return EventKindWindowClosed }
+
 // The window was resized
 type WindowResizeEvent struct {
+	EventHeader
 	Width, Height uint // New width and height, in pixels
 }
 
+func (WindowResizeEvent) Kind() EventKind { return EventKindWindowResize }
+
 // The window lost the focus
 type WindowLostFocusEvent struct {
+	EventHeader
 }
 
+func (WindowLostFocusEvent) Kind() EventKind { return EventKindWindowLostFocus }
+
 // The window gained the focus
 type WindowGainedFocusEvent struct {
+	EventHeader
+}
+
+func (WindowGainedFocusEvent) Kind() EventKind { return EventKindWindowGainedFocus }
+
+// The window was moved
+type WindowMovedEvent struct {
+	EventHeader
+	X, Y int // New position of the top-left of the window's client area, in screen coordinates
+}
+
+func (WindowMovedEvent) Kind() EventKind { return EventKindWindowMoved }
+
+// The window was minimized (iconified)
+type WindowMinimizedEvent struct {
+	EventHeader
+}
+
+func (WindowMinimizedEvent) Kind() EventKind { return EventKindWindowMinimized }
+
+// The window was maximized
+type WindowMaximizedEvent struct {
+	EventHeader
+}
+
+func (WindowMaximizedEvent) Kind() EventKind { return EventKindWindowMaximized }
+
+// The window was restored from being minimized or maximized
+type WindowRestoredEvent struct {
+	EventHeader
+}
+
+func (WindowRestoredEvent) Kind() EventKind { return EventKindWindowRestored }
+
+// Part of the window was damaged and needs to be redrawn
+type WindowExposedEvent struct {
+	EventHeader
+	X, Y          int  // Top-left of the damaged area, relative to the top-left of the window
+	Width, Height uint // Size of the damaged area, in pixels
 }
 
-// db   dD d88888b db    db d8888b.  .d88b.   .d8b.  d8888b. d8888b. 
-// 88 ,8P' 88'     `8b  d8' 88  `8D .8P  Y8. d8' `8b 88  `8D 88  `8D 
-// 88,8P   88ooooo  `8bd8'  88oooY' 88    88 88ooo88 88oobY' 88   88 
-// 88`8b   88~~~~~    88    88~~~b. 88    88 88~~~88 88`8b   88   88 
-// 88 `88. 88.        88    88   8D `8b  d8' 88   88 88 `88. 88  .8D 
-// YP   YD Y88888P    YP    Y8888P'  `Y88P'  YP   YP 88   YD Y8888D' 
+func (WindowExposedEvent) Kind() EventKind { return EventKindWindowExposed }
 
-// A character was entered
+// db   dD d88888b db    db d8888b.  .d88b.   .d8b.  d8888b. d8888b.
+// 88 ,8P' 88'     `8b  d8' 88  `8D .8P  Y8. d8' `8b 88  `8D 88  `8D
+// 88,8P   88ooooo  `8bd8'  88oooY' 88    88 88ooo88 88oobY' 88   88
+// 88`8b   88~~~~~    88    88~~~b. 88    88 88~~~88 88`8b   88   88
+// 88 `88. 88.        88    88   8D `8b  d8' 88   88 88 `88. 88  .8D
+// YP   YD Y88888P    YP    Y8888P'  `Y88P'  YP   YP 88   YD Y8888D'
+
+// A character was entered. Each character of a TextCommitEvent is also
+// reported as a TextEnteredEvent, so handle one or the other.
 type TextEnteredEvent struct {
+	EventHeader
 	Character rune // character
 }
 
+func (TextEnteredEvent) Kind() EventKind { return EventKindTextEntered }
+
+// An input method changed the text being composed (the preedit text).
+// Applications drawing text inline should show it at the text cursor until
+// it is committed, and call Window.ThreadSetTextInputRect so the input
+// method doesn't draw it too. An empty Text ends composition.
+type TextCompositionEvent struct {
+	EventHeader
+	Text                         string // The text being composed
+	Cursor                       int    // Byte offset of the cursor in Text
+	SelectionStart, SelectionEnd int    // Byte offsets of the part being converted, empty if equal
+}
+
+func (TextCompositionEvent) Kind() EventKind { return EventKindTextComposition }
+
+// Text was entered, either typed or committed by an input method
+type TextCommitEvent struct {
+	EventHeader
+	Text string // The complete text
+}
+
+func (TextCommitEvent) Kind() EventKind { return EventKindTextCommit }
+
 // A key was pressed
 type KeyPressedEvent struct {
-	Code                        Key  // Code of the key that has been pressed
-	Alt, Control, Shift, System bool // Is a modifier key pressed?
+	EventHeader
+	Code      Key         // Code of the key that has been pressed
+	Scancode  Scancode    // Platform specific code of the key's position
+	Physical  PhysicalKey // Position of the key, regardless of keyboard layout
+	Modifiers Modifiers   // Modifier and lock keys at the time of the event
 }
 
+func (KeyPressedEvent) Kind() EventKind { return EventKindKeyPressed }
+
 // A key was released
 type KeyReleasedEvent struct {
-	Code                        Key  // Code of the key that has been released
-	Alt, Control, Shift, System bool // Is a modifier key pressed?
+	EventHeader
+	Code      Key         // Code of the key that has been released
+	Scancode  Scancode    // Platform specific code of the key's position
+	Physical  PhysicalKey // Position of the key, regardless of keyboard layout
+	Modifiers Modifiers   // Modifier and lock keys at the time of the event
+}
+
+func (KeyReleasedEvent) Kind() EventKind { return EventKindKeyReleased }
+
+// The keyboard layout changed, e.g. the user switched input language
+type KeyboardLayoutChangedEvent struct {
+	EventHeader
+	Keymap *Keymap // The new layout
 }
 
-// .88b  d88.  .d88b.  db    db .d8888. d88888b 
-// 88'YbdP`88 .8P  Y8. 88    88 88'  YP 88'     
-// 88  88  88 88    88 88    88 `8bo.   88ooooo 
-// 88  88  88 88    88 88    88   `Y8b. 88~~~~~ 
-// 88  88  88 `8b  d8' 88b  d88 db   8D 88.     
-// YP  YP  YP  `Y88P'  ~Y8888P' `8888Y' Y88888P 
+func (KeyboardLayoutChangedEvent) Kind() EventKind { return EventKindKeyboardLayoutChanged }
+
+// .88b  d88.  .d88b.  db    db .d8888. d88888b
+// 88'YbdP`88 .8P  Y8. 88    88 88'  YP 88'
+// 88  88  88 88    88 88    88 `8bo.   88ooooo
+// 88  88  88 88    88 88    88   `Y8b. 88~~~~~
+// 88  88  88 `8b  d8' 88b  d88 db   8D 88.
+// YP  YP  YP  `Y88P'  ~Y8888P' `8888Y' Y88888P
 
 // The mouse cursor moved
 type MouseMoveEvent struct {
+	EventHeader
 	X, Y int // X and Y positions of the mouse pointer, relative to the top-left of the owner window
 }
 
+func (MouseMoveEvent) Kind() EventKind { return EventKindMouseMove }
+
+// The mouse moved while the window is in CursorModeRelative. The motion is
+// read from the device, before pointer acceleration is applied and
+// regardless of the cursor reaching the edge of the window.
+type MouseRawMotionEvent struct {
+	EventHeader
+	DX, DY int // Distance moved in device units (positive is right and down)
+}
+
+func (MouseRawMotionEvent) Kind() EventKind { return EventKindMouseRawMotion }
+
 // A mouse button was pressed
 type MouseButtonPressedEvent struct {
-	Button MouseButton // Code of the concrete button that has been pressed
-	X, Y   int         // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	EventHeader
+	Button    MouseButton // Code of the concrete button that has been pressed
+	X, Y      int         // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	Modifiers Modifiers   // Modifier and lock keys at the time of the event
 }
 
+func (MouseButtonPressedEvent) Kind() EventKind { return EventKindMouseButtonPressed }
+
 // A mouse button was released
 type MouseButtonReleasedEvent struct {
-	Button MouseButton // Code of the concrete button that has been released
-	X, Y   int         // X and Y positions of the mouse pointer, relative to the top-left of the owner window
+	EventHeader
+	Button    MouseButton // Code of the concrete button that has been released
+	X, Y      int         // X and Y positions of the mouse pointer, relative to the top-left of the owner window
+	Modifiers Modifiers   // Modifier and lock keys at the time of the event
 }
 
-// The mouse wheel was scrolled
+func (MouseButtonReleasedEvent) Kind() EventKind { return EventKindMouseButtonReleased }
+
+// The mouse wheel was scrolled by at least a notch. See MouseScrollEvent for
+// finer and horizontal scrolling.
 type MouseWheelEvent struct {
-	Delta int // Number of ticks the wheel has moved (positive is up, negative is down)
-	X, Y  int // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	EventHeader
+	Delta     int       // Number of ticks the wheel has moved (positive is up, negative is down)
+	X, Y      int       // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	Modifiers Modifiers // Modifier and lock keys at the time of the event
+}
+
+func (MouseWheelEvent) Kind() EventKind { return EventKindMouseWheel }
+
+// Where a scroll event falls in a gesture on devices that report one, such
+// as touchpads
+type ScrollPhase int
+
+const (
+	ScrollPhaseNone          ScrollPhase = iota // The device doesn't report gestures, e.g. a wheel
+	ScrollPhaseBegan                            // The first event of a gesture
+	ScrollPhaseChanged                          // The user is scrolling
+	ScrollPhaseEnded                            // The user lifted their fingers
+	ScrollPhaseMomentum                         // Inertial scrolling after the gesture ended
+	ScrollPhaseMomentumEnded                    // Inertial scrolling stopped
+)
+
+// The mouse wheel or a touchpad scrolled. Unlike MouseWheelEvent, scrolls
+// of less than a notch and horizontal scrolls are reported.
+type MouseScrollEvent struct {
+	EventHeader
+	DeltaX, DeltaY float64     // Distance scrolled (positive is right and up)
+	Precise        bool        // Deltas are in pixels rather than wheel notches, which may be fractional
+	Phase          ScrollPhase // Where the event falls in a scroll gesture
+	X, Y           int         // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	Modifiers      Modifiers   // Modifier and lock keys at the time of the event
 }
 
+func (MouseScrollEvent) Kind() EventKind { return EventKindMouseScroll }
+
 // The mouse cursor entered the area of the window
 type MouseEnteredEvent struct {
+	EventHeader
 }
 
+func (MouseEnteredEvent) Kind() EventKind { return EventKindMouseEntered }
+
 // The mouse cursor left the area of the window
 type MouseLeftEvent struct {
+	EventHeader
+}
+
+func (MouseLeftEvent) Kind() EventKind { return EventKindMouseLeft }
+
+// A mouse button was pressed twice in quick succession. Synthesized by
+// DoubleClickFilter after the second MouseButtonPressedEvent.
+type MouseDoubleClickEvent struct {
+	EventHeader
+	Button    MouseButton // Code of the concrete button that has been double clicked
+	X, Y      int         // X and Y position of the mouse pointer, relative to the top-left of the owner window
+	Modifiers Modifiers   // Modifier and lock keys at the time of the event
 }
+
+func (MouseDoubleClickEvent) Kind() EventKind { return EventKindMouseDoubleClick }
+
+// A mouse button was held without moving. Synthesized by LongPressFilter.
+type MouseLongPressEvent struct {
+	EventHeader
+	Button MouseButton // Code of the concrete button that has been held
+	X, Y   int         // X and Y position of the mouse pointer when the button was pressed
+}
+
+func (MouseLongPressEvent) Kind() EventKind { return EventKindMouseLongPress }
+
+// d888888b  .d88b.  db    db  .o88b. db   db
+// `~~88~~' .8P  Y8. 88    88 d8P  Y8 88   88
+//    88    88    88 88    88 8P      88ooo88
+//    88    88    88 88    88 8b      88~~~88
+//    88    `8b  d8' 88b  d88 Y8b  d8 88   88
+//    YP     `Y88P'  ~Y8888P'  `Y88P' YP   YP
+
+// Touches and pens also move the mouse, so applications which only handle
+// mouse events keep working.
+
+// A finger touched the window
+type TouchBeganEvent struct {
+	EventHeader
+	Finger                   int     // Identifies the touch until it ends, the lowest number not in use
+	X, Y                     int     // X and Y position of the finger, relative to the top-left of the owner window
+	NormalizedX, NormalizedY float64 // X and Y position as a fraction of the size of the owner window
+}
+
+func (TouchBeganEvent) Kind() EventKind { return EventKindTouchBegan }
+
+// A finger moved on the window
+type TouchMovedEvent struct {
+	EventHeader
+	Finger                   int     // Identifies the touch, as in its TouchBeganEvent
+	X, Y                     int     // X and Y position of the finger, relative to the top-left of the owner window
+	NormalizedX, NormalizedY float64 // X and Y position as a fraction of the size of the owner window
+}
+
+func (TouchMovedEvent) Kind() EventKind { return EventKindTouchMoved }
+
+// A finger was lifted from the window
+type TouchEndedEvent struct {
+	EventHeader
+	Finger                   int     // Identifies the touch, as in its TouchBeganEvent
+	X, Y                     int     // X and Y position of the finger, relative to the top-left of the owner window
+	NormalizedX, NormalizedY float64 // X and Y position as a fraction of the size of the owner window
+}
+
+func (TouchEndedEvent) Kind() EventKind { return EventKindTouchEnded }
+
+// The system took a touch away from the window, e.g. for a gesture. The
+// touch should be treated as if it never happened.
+type TouchCancelledEvent struct {
+	EventHeader
+	Finger                   int     // Identifies the touch, as in its TouchBeganEvent
+	X, Y                     int     // Last X and Y position of the finger, relative to the top-left of the owner window
+	NormalizedX, NormalizedY float64 // Last X and Y position as a fraction of the size of the owner window
+}
+
+func (TouchCancelledEvent) Kind() EventKind { return EventKindTouchCancelled }
+
+// A pen moved over the window, touched it or was lifted from it
+type PenEvent struct {
+	EventHeader
+	Action                   PenAction // What the pen did
+	X, Y                     int       // X and Y position of the pen, relative to the top-left of the owner window
+	NormalizedX, NormalizedY float64   // X and Y position as a fraction of the size of the owner window
+	Pressure                 float64   // From 0 to 1, 0 when not touching
+	TiltX, TiltY             float64   // Angle of the pen from the perpendicular in degrees, from -90 to 90 (positive is right and towards the user)
+	Eraser                   bool      // The eraser end of the pen, or its eraser button, is in use
+	Barrel                   bool      // The barrel button of the pen is pressed
+}
+
+func (PenEvent) Kind() EventKind { return EventKindPen }
+
+// d8888b. d8888b.  .d88b.  d8888b.
+// 88  `8D 88  `8D .8P  Y8. 88  `8D
+// 88   88 88oobY' 88    88 88oodD'
+// 88   88 88`8b   88    88 88~~~
+// 88  .8D 88 `88. `8b  d8' 88
+// Y8888D' 88   YD  `Y88P'  88
+
+// A drag from another application, or another window, entered the window.
+// Only drags carrying files or text are reported.
+type DragEnterEvent struct {
+	EventHeader
+	X, Y  int  // X and Y position of the drag, relative to the top-left of the owner window
+	Files bool // The drag carries files, which a FileDropEvent will report
+	Text  bool // The drag carries text, which a TextDropEvent will report if it carries no files
+}
+
+func (DragEnterEvent) Kind() EventKind { return EventKindDragEnter }
+
+// A drag moved over the window
+type DragOverEvent struct {
+	EventHeader
+	X, Y int // X and Y position of the drag, relative to the top-left of the owner window
+}
+
+func (DragOverEvent) Kind() EventKind { return EventKindDragOver }
+
+// A drag left the window, or was cancelled, without dropping
+type DragLeaveEvent struct {
+	EventHeader
+}
+
+func (DragLeaveEvent) Kind() EventKind { return EventKindDragLeave }
+
+// Files were dropped on the window, ending the drag
+type FileDropEvent struct {
+	EventHeader
+	Paths []string // Absolute paths of the files and directories
+	X, Y  int      // X and Y position of the drop, relative to the top-left of the owner window
+}
+
+func (FileDropEvent) Kind() EventKind { return EventKindFileDrop }
+
+// Text was dropped on the window, ending the drag
+type TextDropEvent struct {
+	EventHeader
+	Text string // The text, with "\n" line endings
+	X, Y int    // X and Y position of the drop, relative to the top-left of the owner window
+}
+
+func (TextDropEvent) Kind() EventKind { return EventKindTextDrop }
+
+//    d88b  .d88b.  db    db .d8888. d888888b d888888b  .o88b. db   dD
+//    `8P' .8P  Y8. `8b  d8' 88'  YP `~~88~~'   `88'   d8P  Y8 88 ,8P'
+//     88  88    88  `8bd8'  `8bo.      88       88    8P      88,8P
+//     88  88    88    88      `Y8b.    88       88    8b      88`8b
+// db. 88  `8b  d8'    88    db   8D    88      .88.   Y8b  d8 88 `88.
+// Y8888P   `Y88P'     YP    `8888Y'    YP    Y888888P  `Y88P' YP   YD
+
+// Joystick events are reported by JoystickManager.Poll rather than a
+// window, so their Window() is nil.
+
+// A joystick was connected
+type JoystickConnectedEvent struct {
+	EventHeader
+	Joystick *Joystick
+}
+
+func (JoystickConnectedEvent) Kind() EventKind { return EventKindJoystickConnected }
+
+// A joystick was disconnected. Its state is no longer updated.
+type JoystickDisconnectedEvent struct {
+	EventHeader
+	Joystick *Joystick
+}
+
+func (JoystickDisconnectedEvent) Kind() EventKind { return EventKindJoystickDisconnected }
+
+// A joystick button was pressed
+type JoystickButtonPressedEvent struct {
+	EventHeader
+	Joystick *Joystick
+	Button   int // Index of the button that has been pressed
+}
+
+func (JoystickButtonPressedEvent) Kind() EventKind { return EventKindJoystickButtonPressed }
+
+// A joystick button was released
+type JoystickButtonReleasedEvent struct {
+	EventHeader
+	Joystick *Joystick
+	Button   int // Index of the button that has been released
+}
+
+func (JoystickButtonReleasedEvent) Kind() EventKind { return EventKindJoystickButtonReleased }
+
+// A joystick axis moved
+type JoystickAxisMovedEvent struct {
+	EventHeader
+	Joystick *Joystick
+	Axis     int     // Index of the axis that moved
+	Position float64 // New position of the axis, from -1 to 1
+}
+
+func (JoystickAxisMovedEvent) Kind() EventKind { return EventKindJoystickAxisMoved }
+
+// A joystick hat moved
+type JoystickHatMovedEvent struct {
+	EventHeader
+	Joystick *Joystick
+	Hat      int // Index of the hat that moved
+	Position Hat // New direction of the hat
+}
+
+func (JoystickHatMovedEvent) Kind() EventKind { return EventKindJoystickHatMoved }
@@ -0,0 +1,83 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvent_Kind(t *testing.T) {
+	events := []Event{
+		WindowClosedEvent{},
+		WindowResizeEvent{},
+		WindowLostFocusEvent{},
+		WindowGainedFocusEvent{},
+		WindowMovedEvent{},
+		WindowMinimizedEvent{},
+		WindowMaximizedEvent{},
+		WindowRestoredEvent{},
+		WindowExposedEvent{},
+		TextEnteredEvent{},
+		TextCompositionEvent{},
+		TextCommitEvent{},
+		KeyPressedEvent{},
+		KeyReleasedEvent{},
+		KeyboardLayoutChangedEvent{},
+		MouseMoveEvent{},
+		MouseRawMotionEvent{},
+		MouseButtonPressedEvent{},
+		MouseButtonReleasedEvent{},
+		MouseWheelEvent{},
+		MouseScrollEvent{},
+		MouseEnteredEvent{},
+		MouseLeftEvent{},
+		MouseDoubleClickEvent{},
+		MouseLongPressEvent{},
+		TouchBeganEvent{},
+		TouchMovedEvent{},
+		TouchEndedEvent{},
+		TouchCancelledEvent{},
+		PenEvent{},
+		DragEnterEvent{},
+		DragOverEvent{},
+		DragLeaveEvent{},
+		FileDropEvent{},
+		TextDropEvent{},
+		JoystickConnectedEvent{},
+		JoystickDisconnectedEvent{},
+		JoystickButtonPressedEvent{},
+		JoystickButtonReleasedEvent{},
+		JoystickAxisMovedEvent{},
+		JoystickHatMovedEvent{},
+	}
+
+	seen := make(map[EventKind]bool)
+	for _, e := range events {
+		kind := e.Kind()
+		if kind < 0 || kind >= EventKindCount {
+			t.Errorf("%T has an invalid kind %d", e, kind)
+		}
+		if seen[kind] {
+			t.Errorf("%T reuses kind %s", e, kind)
+		}
+		if kind.String() == "" {
+			t.Errorf("%T's kind has no name", e)
+		}
+		seen[kind] = true
+	}
+}
+
+func TestEvent_Header(t *testing.T) {
+	w := &Window{}
+	var e Event = KeyPressedEvent{
+		EventHeader: EventHeader{Time: 5 * time.Second, Source: w},
+		Code:        KeyA,
+	}
+
+	if e.Timestamp() != 5*time.Second {
+		t.Errorf("unexpected timestamp %v", e.Timestamp())
+	}
+	if e.Window() != w {
+		t.Error("unexpected window")
+	}
+}
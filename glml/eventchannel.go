@@ -0,0 +1,234 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// What happens when a window's event channel is full
+type EventOverflowPolicy int
+
+const (
+	// Wait for the receiver. This stalls the window's thread, including any
+	// rendering commands queued behind the event pump.
+	EventOverflowBlock EventOverflowPolicy = iota
+
+	// Discard the oldest undelivered event to make room
+	EventOverflowDropOldest
+
+	// Merge a new MouseMoveEvent into an undelivered MouseMoveEvent at the
+	// end of the channel. Other events wait for the receiver, as with
+	// EventOverflowBlock, so only intermediate mouse positions are lost.
+	EventOverflowCoalesceMouseMove
+)
+
+// Options for Window.EnableEventChannel
+type EventChannelOptions struct {
+	BufferSize   int                 // The number of undelivered events the channel holds
+	Overflow     EventOverflowPolicy // What happens when BufferSize events are undelivered
+	PollInterval time.Duration       // How often the window's thread pumps OS events
+}
+
+var EventChannelOptionsDefault = EventChannelOptions{
+	BufferSize:   256,
+	Overflow:     EventOverflowCoalesceMouseMove,
+	PollInterval: 4 * time.Millisecond,
+}
+
+// A bounded queue of events between a window's thread and Window.Events()
+type eventQueue struct {
+	in     chan Event
+	out    chan Event
+	stop   chan bool
+	size   int
+	policy EventOverflowPolicy
+
+	stopOnce sync.Once
+}
+
+func newEventQueue(size int, policy EventOverflowPolicy) *eventQueue {
+	if size < 1 {
+		size = 1
+	}
+
+	q := &eventQueue{
+		in:     make(chan Event),
+		out:    make(chan Event),
+		stop:   make(chan bool),
+		size:   size,
+		policy: policy,
+	}
+	go q.run()
+	return q
+}
+
+// Add an event to the queue, waiting if the policy requires it. Returns
+// false if the queue was closed.
+func (q *eventQueue) push(e Event) bool {
+	select {
+	case <-q.stop:
+		return false
+	default:
+	}
+
+	select {
+	case q.in <- e:
+		return true
+	case <-q.stop:
+		return false
+	}
+}
+
+// Stop the queue and close the output channel. Undelivered events are lost.
+func (q *eventQueue) close() {
+	q.stopOnce.Do(func() { close(q.stop) })
+}
+
+func (q *eventQueue) run() {
+	defer close(q.out)
+
+	var queue []Event
+	var pending Event // An event waiting for room in the queue
+	for {
+		in := q.in
+		if pending != nil || (len(queue) >= q.size && q.policy == EventOverflowBlock) {
+			in = nil
+		}
+
+		var out chan Event
+		var head Event
+		if len(queue) > 0 {
+			out, head = q.out, queue[0]
+		}
+
+		select {
+		case e := <-in:
+			queue, pending = q.add(queue, e)
+
+		case out <- head:
+			queue = queue[1:]
+			if pending != nil {
+				queue, pending = append(queue, pending), nil
+			}
+
+		case <-q.stop:
+			return
+		}
+	}
+}
+
+// Add an event according to the overflow policy. Returns the new queue and
+// the event which must wait for room, if any.
+func (q *eventQueue) add(queue []Event, e Event) ([]Event, Event) {
+	if len(queue) < q.size {
+		return append(queue, e), nil
+	}
+
+	switch q.policy {
+	case EventOverflowDropOldest:
+		return append(queue[1:], e), nil
+
+	case EventOverflowCoalesceMouseMove:
+		if _, ok := e.(MouseMoveEvent); ok {
+			if _, ok := queue[len(queue)-1].(MouseMoveEvent); ok {
+				queue[len(queue)-1] = e
+				return queue, nil
+			}
+		}
+	}
+	return queue, e
+}
+
+// Opt in to receiving the window's events on Window.Events() instead of
+// calling ThreadPollEvents.
+//
+// While the window is active on its InitialThread, the thread pumps OS
+// events every options.PollInterval and publishes them on the channel.
+// Errors from pumping are sent to Window.Errors(). Calling ThreadPollEvents
+// as well will split the events between the two.
+//
+// The channel is closed when the window is closed. This may be called from
+// any goroutine, but only the first call enables the channel.
+func (w *Window) EnableEventChannel(options EventChannelOptions) error {
+	w.eventsMutex.Lock()
+	defer w.eventsMutex.Unlock()
+
+	if w.events != nil {
+		return errors.New("event channel already enabled")
+	}
+	if w.IsClosed() {
+		return errors.New("window is closed")
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = EventChannelOptionsDefault.PollInterval
+	}
+
+	w.events = newEventQueue(options.BufferSize, options.Overflow)
+	go w.pumpEvents(w.events, options.PollInterval)
+	return nil
+}
+
+// The channel of events enabled by EnableEventChannel, or nil. This may be
+// called from any goroutine.
+func (w *Window) Events() <-chan Event {
+	if q := w.eventQueue(); q != nil {
+		return q.out
+	}
+	return nil
+}
+
+// Get the queue enabled by EnableEventChannel, or nil
+func (w *Window) eventQueue() *eventQueue {
+	w.eventsMutex.Lock()
+	defer w.eventsMutex.Unlock()
+	return w.events
+}
+
+// Periodically queue a command which polls the OS events into the queue
+func (w *Window) pumpEvents(q *eventQueue, interval time.Duration) {
+	pump := func(thread *Thread, t Threadable) ThreadError {
+		w := t.(*Window)
+
+		// Events can only be polled on the thread the window was created on
+		if w.InitialThread() != thread {
+			return nil
+		}
+
+		events, errors := w.ThreadPollEvents(thread, false)
+		for _, e := range events {
+			if !q.push(e) {
+				break
+			}
+		}
+
+		// Report the errors, returning the first fatal one to the thread
+		var fatal ThreadError
+		for _, err := range errors {
+			if err.Fatal() && fatal == nil {
+				fatal = err
+			} else {
+				w.ThreadReportError(err)
+			}
+		}
+		return fatal
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-q.stop:
+			return
+		}
+
+		select {
+		case w.Commands() <- pump:
+		case <-q.stop:
+			return
+		}
+	}
+}
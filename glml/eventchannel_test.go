@@ -0,0 +1,104 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+	"time"
+)
+
+// Receive n events from the queue, failing if they don't arrive
+func receiveEvents(t *testing.T, q *eventQueue, n int) []Event {
+	events := make([]Event, 0, n)
+	for len(events) < n {
+		select {
+		case e := <-q.out:
+			events = append(events, e)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d of %d events", len(events), n)
+		}
+	}
+	return events
+}
+
+func TestEventQueue_DropOldest(t *testing.T) {
+	q := newEventQueue(2, EventOverflowDropOldest)
+	defer q.close()
+
+	for i := 0; i < 4; i++ {
+		q.push(MouseMoveEvent{X: i})
+	}
+
+	events := receiveEvents(t, q, 2)
+	if events[0].(MouseMoveEvent).X != 2 || events[1].(MouseMoveEvent).X != 3 {
+		t.Errorf("expected the newest events, got %v", events)
+	}
+}
+
+func TestEventQueue_CoalesceMouseMove(t *testing.T) {
+	q := newEventQueue(2, EventOverflowCoalesceMouseMove)
+	defer q.close()
+
+	q.push(KeyPressedEvent{Code: KeyA})
+	q.push(MouseMoveEvent{X: 1})
+	q.push(MouseMoveEvent{X: 2})
+	q.push(MouseMoveEvent{X: 3})
+
+	// A key press can't be coalesced, so it waits for room
+	done := make(chan bool)
+	go func() {
+		q.push(KeyReleasedEvent{Code: KeyA})
+		close(done)
+	}()
+
+	events := receiveEvents(t, q, 3)
+	if _, ok := events[0].(KeyPressedEvent); !ok {
+		t.Errorf("expected KeyPressedEvent, got %T", events[0])
+	}
+	if e, ok := events[1].(MouseMoveEvent); !ok || e.X != 3 {
+		t.Errorf("expected the last mouse position, got %v", events[1])
+	}
+	if _, ok := events[2].(KeyReleasedEvent); !ok {
+		t.Errorf("expected KeyReleasedEvent, got %T", events[2])
+	}
+	<-done
+}
+
+func TestEventQueue_Block(t *testing.T) {
+	q := newEventQueue(1, EventOverflowBlock)
+	defer q.close()
+
+	q.push(MouseMoveEvent{X: 1})
+
+	done := make(chan bool)
+	go func() {
+		q.push(MouseMoveEvent{X: 2})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("push did not block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	events := receiveEvents(t, q, 2)
+	if events[0].(MouseMoveEvent).X != 1 || events[1].(MouseMoveEvent).X != 2 {
+		t.Errorf("expected events in order, got %v", events)
+	}
+	<-done
+}
+
+func TestEventQueue_Close(t *testing.T) {
+	q := newEventQueue(1, EventOverflowBlock)
+	q.push(MouseMoveEvent{})
+	q.close()
+	q.close()
+
+	if q.push(MouseMoveEvent{}) {
+		t.Error("push succeeded on a closed queue")
+	}
+
+	// The output is closed, possibly after the undelivered event
+	for _ = range q.out {
+	}
+}
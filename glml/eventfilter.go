@@ -0,0 +1,277 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"time"
+)
+
+// Transforms the events polled from a window before they are returned by
+// ThreadPollEvents or published on Window.Events(). Filters may drop,
+// modify, reorder or add events, and may keep state between calls.
+//
+// A window's filter is called once per poll, even when no events were
+// polled, so time based filters can fire.
+type EventFilter interface {
+	FilterEvents(events []Event) []Event
+}
+
+// Adapts an ordinary function to an EventFilter
+type EventFilterFunc func(events []Event) []Event
+
+func (f EventFilterFunc) FilterEvents(events []Event) []Event {
+	return f(events)
+}
+
+// Run the events through each filter in order
+func ChainEventFilters(filters ...EventFilter) EventFilter {
+	return EventFilterFunc(func(events []Event) []Event {
+		for _, f := range filters {
+			events = f.FilterEvents(events)
+		}
+		return events
+	})
+}
+
+// Replace each run of consecutive events of the given kind from the same
+// window with the last event of the run
+func coalesceEvents(events []Event, kind EventKind) []Event {
+	result := events[:0]
+	for _, e := range events {
+		if n := len(result); n > 0 && e.Kind() == kind {
+			if last := result[n-1]; last.Kind() == kind && last.Window() == e.Window() {
+				result[n-1] = e
+				continue
+			}
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// Keep only the last of each run of consecutive MouseMoveEvents
+func CoalesceMouseMoves() EventFilter {
+	return EventFilterFunc(func(events []Event) []Event {
+		return coalesceEvents(events, EventKindMouseMove)
+	})
+}
+
+// Keep only the last of each run of consecutive WindowResizeEvents
+func CoalesceResizes() EventFilter {
+	return EventFilterFunc(func(events []Event) []Event {
+		return coalesceEvents(events, EventKindWindowResize)
+	})
+}
+
+// Drop all events of the given kinds
+func DropEvents(kinds ...EventKind) EventFilter {
+	var drop [EventKindCount]bool
+	for _, k := range kinds {
+		if k >= 0 && k < EventKindCount {
+			drop[k] = true
+		}
+	}
+
+	return EventFilterFunc(func(events []Event) []Event {
+		result := events[:0]
+		for _, e := range events {
+			if k := e.Kind(); k < 0 || k >= EventKindCount || !drop[k] {
+				result = append(result, e)
+			}
+		}
+		return result
+	})
+}
+
+// Replace the Code of KeyPressedEvents and KeyReleasedEvents found in keys
+func RemapKeys(keys map[Key]Key) EventFilter {
+	// Copy the map so later changes by the caller don't race with the thread
+	remap := make(map[Key]Key, len(keys))
+	for from, to := range keys {
+		remap[from] = to
+	}
+
+	return EventFilterFunc(func(events []Event) []Event {
+		for i, e := range events {
+			switch e := e.(type) {
+			case KeyPressedEvent:
+				if to, ok := remap[e.Code]; ok {
+					e.Code = to
+					events[i] = e
+				}
+			case KeyReleasedEvent:
+				if to, ok := remap[e.Code]; ok {
+					e.Code = to
+					events[i] = e
+				}
+			}
+		}
+		return events
+	})
+}
+
+// Returns true if the two positions are no more than distance apart on
+// either axis
+func withinDistance(x0, y0, x1, y1, distance int) bool {
+	dx, dy := x1-x0, y1-y0
+	return -distance <= dx && dx <= distance && -distance <= dy && dy <= distance
+}
+
+// Adds a MouseDoubleClickEvent after the second of two
+// MouseButtonPressedEvents for the same button that are close in time and
+// position. A third press starts a new double click.
+type DoubleClickFilter struct {
+	Interval time.Duration // Maximum time between the presses
+	Distance int           // Maximum distance between the presses, in pixels on either axis
+
+	last    MouseButtonPressedEvent
+	hasLast bool
+}
+
+// Construct a DoubleClickFilter with common desktop defaults
+func NewDoubleClickFilter() *DoubleClickFilter {
+	return &DoubleClickFilter{
+		Interval: 500 * time.Millisecond,
+		Distance: 4,
+	}
+}
+
+func (f *DoubleClickFilter) FilterEvents(events []Event) []Event {
+	var result []Event
+	for _, e := range events {
+		result = append(result, e)
+
+		press, ok := e.(MouseButtonPressedEvent)
+		if !ok {
+			continue
+		}
+
+		last := f.last
+		if f.hasLast &&
+			last.Button == press.Button &&
+			last.Source == press.Source &&
+			press.Time-last.Time <= f.Interval &&
+			withinDistance(last.X, last.Y, press.X, press.Y, f.Distance) {
+
+			result = append(result, MouseDoubleClickEvent{
+				EventHeader: press.EventHeader,
+				Button:      press.Button,
+				X:           press.X,
+				Y:           press.Y,
+				Modifiers:   press.Modifiers,
+			})
+			f.hasLast = false
+			continue
+		}
+
+		f.last, f.hasLast = press, true
+	}
+	return result
+}
+
+// Adds a MouseLongPressEvent when a mouse button is held for Duration
+// without the mouse moving more than Distance. The event is added when a
+// later event, or the clock, shows the duration has passed.
+type LongPressFilter struct {
+	Duration time.Duration // How long the button must be held
+	Distance int           // How far the mouse may move, in pixels on either axis
+
+	// The current time, on the same clock as event timestamps. Defaults to
+	// the platform's event clock.
+	Now func() time.Duration
+
+	pressed []MouseButtonPressedEvent // Presses which may still become long presses
+}
+
+// Construct a LongPressFilter with common desktop defaults
+func NewLongPressFilter() *LongPressFilter {
+	return &LongPressFilter{
+		Duration: 800 * time.Millisecond,
+		Distance: 4,
+		Now:      currentTimestamp,
+	}
+}
+
+// Append a MouseLongPressEvent for each press held until now
+func (f *LongPressFilter) fire(result []Event, now time.Duration) []Event {
+	pressed := f.pressed[:0]
+	for _, press := range f.pressed {
+		if now-press.Time < f.Duration {
+			pressed = append(pressed, press)
+			continue
+		}
+
+		header := press.EventHeader
+		header.Time += f.Duration
+		result = append(result, MouseLongPressEvent{
+			EventHeader: header,
+			Button:      press.Button,
+			X:           press.X,
+			Y:           press.Y,
+		})
+	}
+	f.pressed = pressed
+	return result
+}
+
+// Forget the presses matching cancel
+func (f *LongPressFilter) cancel(cancel func(press MouseButtonPressedEvent) bool) {
+	pressed := f.pressed[:0]
+	for _, press := range f.pressed {
+		if !cancel(press) {
+			pressed = append(pressed, press)
+		}
+	}
+	f.pressed = pressed
+}
+
+func (f *LongPressFilter) FilterEvents(events []Event) []Event {
+	var result []Event
+	for _, e := range events {
+		result = f.fire(result, e.Timestamp())
+		result = append(result, e)
+
+		switch e := e.(type) {
+		case MouseButtonPressedEvent:
+			f.cancel(func(press MouseButtonPressedEvent) bool {
+				return press.Button == e.Button && press.Source == e.Source
+			})
+			f.pressed = append(f.pressed, e)
+
+		case MouseButtonReleasedEvent:
+			f.cancel(func(press MouseButtonPressedEvent) bool {
+				return press.Button == e.Button && press.Source == e.Source
+			})
+
+		case MouseMoveEvent:
+			f.cancel(func(press MouseButtonPressedEvent) bool {
+				return press.Source == e.Source && !withinDistance(press.X, press.Y, e.X, e.Y, f.Distance)
+			})
+
+		case WindowLostFocusEvent, MouseLeftEvent:
+			f.cancel(func(press MouseButtonPressedEvent) bool {
+				return press.Source == e.Window()
+			})
+		}
+	}
+
+	if f.Now != nil {
+		result = f.fire(result, f.Now())
+	}
+	return result
+}
+
+// Expects to be called on a Thread
+// Install a filter on the events returned by ThreadPollEvents and
+// published on Events(). Pass nil to remove the filter. Use
+// ChainEventFilters to install several.
+func (w *Window) ThreadSetEventFilter(filter EventFilter) {
+	w.filter = filter
+}
+
+// A thread command helper for Window.ThreadSetEventFilter
+func WindowThreadSetEventFilter(filter EventFilter) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		t.(*Window).ThreadSetEventFilter(filter)
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func at(ms int) EventHeader {
+	return EventHeader{Time: time.Duration(ms) * time.Millisecond}
+}
+
+func TestCoalesceMouseMoves(t *testing.T) {
+	events := []Event{
+		MouseMoveEvent{X: 1},
+		MouseMoveEvent{X: 2},
+		KeyPressedEvent{Code: KeyA},
+		MouseMoveEvent{X: 3},
+		MouseMoveEvent{X: 4},
+		MouseMoveEvent{X: 5},
+	}
+	expected := []Event{
+		MouseMoveEvent{X: 2},
+		KeyPressedEvent{Code: KeyA},
+		MouseMoveEvent{X: 5},
+	}
+
+	if result := CoalesceMouseMoves().FilterEvents(events); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestCoalesceResizes(t *testing.T) {
+	a, b := &Window{}, &Window{}
+	events := []Event{
+		WindowResizeEvent{EventHeader{Source: a}, 1, 1},
+		WindowResizeEvent{EventHeader{Source: a}, 2, 2},
+		WindowResizeEvent{EventHeader{Source: b}, 3, 3},
+	}
+	expected := []Event{
+		WindowResizeEvent{EventHeader{Source: a}, 2, 2},
+		WindowResizeEvent{EventHeader{Source: b}, 3, 3},
+	}
+
+	if result := CoalesceResizes().FilterEvents(events); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestDropEvents(t *testing.T) {
+	events := []Event{
+		MouseMoveEvent{},
+		MouseEnteredEvent{},
+		KeyPressedEvent{},
+		MouseLeftEvent{},
+	}
+	expected := []Event{
+		MouseMoveEvent{},
+		KeyPressedEvent{},
+	}
+
+	filter := DropEvents(EventKindMouseEntered, EventKindMouseLeft)
+	if result := filter.FilterEvents(events); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestRemapKeys(t *testing.T) {
+	keys := map[Key]Key{KeyA: KeyB}
+	filter := RemapKeys(keys)
+	keys[KeyC] = KeyD // Must not affect the filter
+
+	events := []Event{
+		KeyPressedEvent{Code: KeyA, Modifiers: ModLShift},
+		KeyReleasedEvent{Code: KeyA},
+		KeyPressedEvent{Code: KeyC},
+	}
+	expected := []Event{
+		KeyPressedEvent{Code: KeyB, Modifiers: ModLShift},
+		KeyReleasedEvent{Code: KeyB},
+		KeyPressedEvent{Code: KeyC},
+	}
+
+	if result := filter.FilterEvents(events); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestDoubleClickFilter(t *testing.T) {
+	filter := NewDoubleClickFilter()
+
+	// The double click spans two polls, and the third press doesn't repeat it
+	first := filter.FilterEvents([]Event{
+		MouseButtonPressedEvent{at(0), MouseLeftRH, 10, 10, 0},
+		MouseButtonReleasedEvent{at(50), MouseLeftRH, 10, 10, 0},
+	})
+	if len(first) != 2 {
+		t.Fatalf("unexpected events %v", first)
+	}
+
+	second := filter.FilterEvents([]Event{
+		MouseButtonPressedEvent{at(200), MouseLeftRH, 12, 11, 0},
+		MouseButtonPressedEvent{at(300), MouseLeftRH, 12, 11, 0},
+	})
+	expected := []Event{
+		MouseButtonPressedEvent{at(200), MouseLeftRH, 12, 11, 0},
+		MouseDoubleClickEvent{at(200), MouseLeftRH, 12, 11, 0},
+		MouseButtonPressedEvent{at(300), MouseLeftRH, 12, 11, 0},
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Errorf("expected %v, got %v", expected, second)
+	}
+
+	// Too slow, too far and a different button
+	for _, events := range [][]Event{
+		{MouseButtonPressedEvent{at(0), MouseLeftRH, 0, 0, 0}, MouseButtonPressedEvent{at(600), MouseLeftRH, 0, 0, 0}},
+		{MouseButtonPressedEvent{at(0), MouseLeftRH, 0, 0, 0}, MouseButtonPressedEvent{at(100), MouseLeftRH, 10, 0, 0}},
+		{MouseButtonPressedEvent{at(0), MouseLeftRH, 0, 0, 0}, MouseButtonPressedEvent{at(100), MouseRightRH, 0, 0, 0}},
+	} {
+		if result := NewDoubleClickFilter().FilterEvents(events); len(result) != 2 {
+			t.Errorf("unexpected double click in %v", result)
+		}
+	}
+}
+
+func TestLongPressFilter(t *testing.T) {
+	var now time.Duration
+	filter := NewLongPressFilter()
+	filter.Now = func() time.Duration { return now }
+
+	// Held past the duration across polls, with a small move
+	result := filter.FilterEvents([]Event{
+		MouseButtonPressedEvent{at(0), MouseLeftRH, 10, 10, 0},
+		MouseMoveEvent{at(100), 12, 12},
+	})
+	if len(result) != 2 {
+		t.Fatalf("unexpected events %v", result)
+	}
+
+	now = 900 * time.Millisecond
+	result = filter.FilterEvents(nil)
+	expected := []Event{MouseLongPressEvent{at(800), MouseLeftRH, 10, 10}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Fired only once
+	if result = filter.FilterEvents([]Event{MouseButtonReleasedEvent{at(1000), MouseLeftRH, 10, 10, 0}}); len(result) != 1 {
+		t.Errorf("unexpected events %v", result)
+	}
+
+	// Detected from the release, ahead of it
+	now = 3000 * time.Millisecond
+	result = filter.FilterEvents([]Event{
+		MouseButtonPressedEvent{at(2000), MouseRightRH, 0, 0, 0},
+		MouseButtonReleasedEvent{at(2900), MouseRightRH, 0, 0, 0},
+	})
+	expected = []Event{
+		MouseButtonPressedEvent{at(2000), MouseRightRH, 0, 0, 0},
+		MouseLongPressEvent{at(2800), MouseRightRH, 0, 0},
+		MouseButtonReleasedEvent{at(2900), MouseRightRH, 0, 0, 0},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Cancelled by moving too far
+	now = 5000 * time.Millisecond
+	result = filter.FilterEvents([]Event{
+		MouseButtonPressedEvent{at(4000), MouseLeftRH, 0, 0, 0},
+		MouseMoveEvent{at(4100), 20, 0},
+	})
+	if len(result) != 2 {
+		t.Errorf("unexpected events %v", result)
+	}
+}
+
+func TestChainEventFilters(t *testing.T) {
+	filter := ChainEventFilters(
+		DropEvents(EventKindKeyReleased),
+		CoalesceMouseMoves(),
+	)
+
+	events := []Event{
+		MouseMoveEvent{X: 1},
+		KeyReleasedEvent{},
+		MouseMoveEvent{X: 2},
+	}
+	expected := []Event{MouseMoveEvent{X: 2}}
+
+	if result := filter.FilterEvents(events); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "glproc.h"
+import "C"
+import (
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// The set of GL and platform (WGL, GLX, EGL) extensions supported by a context
+type Extensions map[string]bool
+
+// Returns true if the named extension is supported
+func (e Extensions) Has(name string) bool {
+	return e[name]
+}
+
+// Information about the OpenGL implementation behind a context
+type ContextInfo struct {
+	Vendor                 string // GL_VENDOR
+	Renderer               string // GL_RENDERER
+	Version                string // GL_VERSION
+	ShadingLanguageVersion string // GL_SHADING_LANGUAGE_VERSION, empty before OpenGL 2.0
+
+	MajorVersion, MinorVersion                               int // Parsed from Version
+	ShadingLanguageMajorVersion, ShadingLanguageMinorVersion int // Parsed from ShadingLanguageVersion
+}
+
+// Parse the leading "major.minor" of a GL_VERSION or
+// GL_SHADING_LANGUAGE_VERSION string. The rest of the string is vendor
+// specific and ignored, as is an "OpenGL ES" style prefix.
+func parseVersion(s string) (major, minor int, ok bool) {
+	// Skip any prefix before the first digit
+	if i := strings.IndexAny(s, "0123456789"); i >= 0 {
+		s = s[i:]
+	} else {
+		return 0, 0, false
+	}
+
+	// Cut at the end of the version number
+	if i := strings.IndexAny(s, " -"); i >= 0 {
+		s = s[:i]
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	var err error
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, false
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// Expects the context to be current
+func getString(name C.GLenum) string {
+	s := C.glGetString(name)
+	if s == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(unsafe.Pointer(s)))
+}
+
+// Expects the context to be current
+func queryContextInfo() ContextInfo {
+	info := ContextInfo{
+		Vendor:                 getString(C.GL_VENDOR),
+		Renderer:               getString(C.GL_RENDERER),
+		Version:                getString(C.GL_VERSION),
+		ShadingLanguageVersion: getString(GL_SHADING_LANGUAGE_VERSION),
+	}
+
+	info.MajorVersion, info.MinorVersion, _ = parseVersion(info.Version)
+	info.ShadingLanguageMajorVersion, info.ShadingLanguageMinorVersion, _ = parseVersion(info.ShadingLanguageVersion)
+
+	// Clear the GL_INVALID_ENUM from GL_SHADING_LANGUAGE_VERSION on old drivers
+	C.glGetError()
+	return info
+}
+
+// Expects the context to be current
+func queryExtensions(gl *GL, platform []string) Extensions {
+	extensions := make(Extensions)
+
+	// OpenGL 3.0 indexes extensions, and core profiles no longer support
+	// GL_EXTENSIONS in glGetString
+	var count C.GLint
+	if gl.procs.p_glGetStringi != nil {
+		C.glGetIntegerv(GL_NUM_EXTENSIONS, &count)
+		C.glGetError()
+	}
+
+	if count > 0 {
+		for i := 0; i < int(count); i++ {
+			if s := gl.GetStringi(C.GL_EXTENSIONS, uint32(i)); s != nil {
+				extensions[C.GoString((*C.char)(s))] = true
+			}
+		}
+	} else {
+		for _, name := range strings.Fields(getString(C.GL_EXTENSIONS)) {
+			extensions[name] = true
+		}
+	}
+
+	for _, name := range platform {
+		extensions[name] = true
+	}
+	return extensions
+}
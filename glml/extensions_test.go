@@ -0,0 +1,41 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		s            string
+		major, minor int
+		ok           bool
+	}{
+		{"2.1", 2, 1, true},
+		{"3.3.0 NVIDIA 310.90", 3, 3, true},
+		{"4.2.11762 Compatibility Profile Context", 4, 2, true},
+		{"1.20 NVIDIA via Cg compiler", 1, 20, true},
+		{"OpenGL ES 3.0 Mesa 10.1.3", 3, 0, true},
+		{"3.0 Mesa 9.2-devel", 3, 0, true},
+		{"", 0, 0, false},
+		{"bogus", 0, 0, false},
+		{"4", 0, 0, false},
+	}
+
+	for _, test := range tests {
+		major, minor, ok := parseVersion(test.s)
+		if major != test.major || minor != test.minor || ok != test.ok {
+			t.Errorf("parseVersion(%q) = %d, %d, %v", test.s, major, minor, ok)
+		}
+	}
+}
+
+func TestExtensions_Has(t *testing.T) {
+	e := Extensions{"GL_ARB_framebuffer_object": true}
+	if !e.Has("GL_ARB_framebuffer_object") {
+		t.Error("missing extension")
+	}
+	if e.Has("WGL_EXT_swap_control") {
+		t.Error("unexpected extension")
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The buttons of a standard gamepad, laid out like an Xbox controller
+type GamepadButton int
+
+const (
+	GamepadA             GamepadButton = iota // The bottom face button
+	GamepadB                                  // The right face button
+	GamepadX                                  // The left face button
+	GamepadY                                  // The top face button
+	GamepadBack                               // Back, Select or Share
+	GamepadGuide                              // The logo button
+	GamepadStart                              // Start or Options
+	GamepadLeftStick                          // Pressing the left stick
+	GamepadRightStick                         // Pressing the right stick
+	GamepadLeftShoulder                       // The left bumper
+	GamepadRightShoulder                      // The right bumper
+	GamepadDPadUp                             // Up on the directional pad
+	GamepadDPadDown                           // Down on the directional pad
+	GamepadDPadLeft                           // Left on the directional pad
+	GamepadDPadRight                          // Right on the directional pad
+	GamepadMisc1                              // e.g. Share on Xbox Series controllers, or Capture on Switch controllers
+	GamepadPaddle1                            // The upper right paddle on the back
+	GamepadPaddle2                            // The upper left paddle on the back
+	GamepadPaddle3                            // The lower right paddle on the back
+	GamepadPaddle4                            // The lower left paddle on the back
+	GamepadTouchpad                           // Pressing the touchpad
+
+	GamepadButtonCount // Keep last -- the total number of gamepad buttons
+)
+
+// The names of the buttons in SDL_GameControllerDB mappings
+var gamepadButtonNames = [GamepadButtonCount]string{
+	GamepadA:             "a",
+	GamepadB:             "b",
+	GamepadX:             "x",
+	GamepadY:             "y",
+	GamepadBack:          "back",
+	GamepadGuide:         "guide",
+	GamepadStart:         "start",
+	GamepadLeftStick:     "leftstick",
+	GamepadRightStick:    "rightstick",
+	GamepadLeftShoulder:  "leftshoulder",
+	GamepadRightShoulder: "rightshoulder",
+	GamepadDPadUp:        "dpup",
+	GamepadDPadDown:      "dpdown",
+	GamepadDPadLeft:      "dpleft",
+	GamepadDPadRight:     "dpright",
+	GamepadMisc1:         "misc1",
+	GamepadPaddle1:       "paddle1",
+	GamepadPaddle2:       "paddle2",
+	GamepadPaddle3:       "paddle3",
+	GamepadPaddle4:       "paddle4",
+	GamepadTouchpad:      "touchpad",
+}
+
+func (b GamepadButton) String() string {
+	if b < 0 || b >= GamepadButtonCount {
+		return "GamepadButton(" + strconv.Itoa(int(b)) + ")"
+	}
+	return gamepadButtonNames[b]
+}
+
+// The axes of a standard gamepad
+type GamepadAxis int
+
+const (
+	GamepadLeftX        GamepadAxis = iota // The left stick, -1 (left) to 1 (right)
+	GamepadLeftY                           // The left stick, -1 (up) to 1 (down)
+	GamepadRightX                          // The right stick, -1 (left) to 1 (right)
+	GamepadRightY                          // The right stick, -1 (up) to 1 (down)
+	GamepadLeftTrigger                     // The left trigger, 0 (released) to 1 (pulled)
+	GamepadRightTrigger                    // The right trigger, 0 (released) to 1 (pulled)
+
+	GamepadAxisCount // Keep last -- the total number of gamepad axes
+)
+
+// The names of the axes in SDL_GameControllerDB mappings
+var gamepadAxisNames = [GamepadAxisCount]string{
+	GamepadLeftX:        "leftx",
+	GamepadLeftY:        "lefty",
+	GamepadRightX:       "rightx",
+	GamepadRightY:       "righty",
+	GamepadLeftTrigger:  "lefttrigger",
+	GamepadRightTrigger: "righttrigger",
+}
+
+func (a GamepadAxis) String() string {
+	if a < 0 || a >= GamepadAxisCount {
+		return "GamepadAxis(" + strconv.Itoa(int(a)) + ")"
+	}
+	return gamepadAxisNames[a]
+}
+
+// Is the axis a trigger, which rests at 0 rather than in the middle?
+func (a GamepadAxis) isTrigger() bool {
+	return a == GamepadLeftTrigger || a == GamepadRightTrigger
+}
+
+// The state of a joystick seen as a standard gamepad, see
+// Joystick.Gamepad
+type GamepadState struct {
+	Buttons [GamepadButtonCount]bool
+	Axes    [GamepadAxisCount]float64
+}
+
+// How a joystick's buttons, axes and hats make up a standard gamepad, in
+// the SDL_GameControllerDB format, e.g.
+//
+//	030000005e0400008e02000014010000,Xbox 360 Controller,a:b0,b:b1,leftx:a0,dpup:h0.1,platform:Linux,
+type GamepadMapping struct {
+	GUID string // The GUID of the joysticks the mapping applies to, see Joystick.GUID
+	Name string // The name of the gamepad
+
+	platform string // The platform the mapping applies to, or empty for all
+	bindings []gamepadBinding
+}
+
+// One entry of a mapping, e.g. "+leftx:-a3~"
+type gamepadBinding struct {
+	button GamepadButton // The output button, or -1 if the output is an axis
+	axis   GamepadAxis   // The output axis
+	half   int           // Only output to the positive (1) or negative (-1) half of the axis
+
+	input     byte // The type of input: 'b'utton, 'a'xis or 'h'at
+	index     int  // The joystick's button, axis or hat index
+	hatMask   Hat  // The direction of the hat
+	inputHalf int  // Only read the positive (1) or negative (-1) half of the axis
+	invert    bool // Invert the axis
+}
+
+// Parse a mapping in the SDL_GameControllerDB format. Unknown fields are
+// ignored.
+func ParseGamepadMapping(line string) (*GamepadMapping, error) {
+	fields := strings.Split(strings.TrimSpace(line), ",")
+	if len(fields) < 2 {
+		return nil, errors.New("gamepad mapping has no name")
+	}
+
+	guid, err := hex.DecodeString(fields[0])
+	if err != nil || len(guid) != 16 {
+		return nil, fmt.Errorf("invalid gamepad GUID %q", fields[0])
+	}
+
+	m := &GamepadMapping{GUID: strings.ToLower(fields[0]), Name: fields[1]}
+	for _, field := range fields[2:] {
+		if field == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(field, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid gamepad mapping field %q", field)
+		}
+		if key == "platform" {
+			m.platform = value
+			continue
+		}
+
+		binding, ok, err := parseGamepadBinding(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gamepad mapping field %q: %v", field, err)
+		}
+		if ok {
+			m.bindings = append(m.bindings, binding)
+		}
+	}
+
+	return m, nil
+}
+
+// Parse a key:value field of a mapping. Returns false for fields which
+// aren't bindings.
+func parseGamepadBinding(key, value string) (b gamepadBinding, ok bool, err error) {
+	// The output: a button, an axis or half of an axis
+	switch key[0] {
+	case '+':
+		b.half, key = 1, key[1:]
+	case '-':
+		b.half, key = -1, key[1:]
+	}
+
+	b.button = -1
+	for button, name := range gamepadButtonNames {
+		if key == name && b.half == 0 {
+			b.button = GamepadButton(button)
+		}
+	}
+	if b.button < 0 {
+		b.axis = -1
+		for axis, name := range gamepadAxisNames {
+			if key == name {
+				b.axis = GamepadAxis(axis)
+			}
+		}
+		if b.axis < 0 {
+			return b, false, nil
+		}
+	}
+
+	// The input: a button, an axis, half of an axis or a hat direction
+	if value == "" {
+		return b, false, errors.New("no input")
+	}
+	switch value[0] {
+	case '+':
+		b.inputHalf, value = 1, value[1:]
+	case '-':
+		b.inputHalf, value = -1, value[1:]
+	}
+	if strings.HasSuffix(value, "~") {
+		b.invert, value = true, value[:len(value)-1]
+	}
+	if value == "" {
+		return b, false, errors.New("no input")
+	}
+
+	b.input, value = value[0], value[1:]
+	if (b.inputHalf != 0 || b.invert) && b.input != 'a' {
+		return b, false, errors.New("only axes can be halved or inverted")
+	}
+
+	switch b.input {
+	case 'b', 'a':
+		b.index, err = strconv.Atoi(value)
+	case 'h':
+		hat, mask, found := strings.Cut(value, ".")
+		if !found {
+			return b, false, errors.New("hat has no direction")
+		}
+		if b.index, err = strconv.Atoi(hat); err == nil {
+			var m int
+			m, err = strconv.Atoi(mask)
+			b.hatMask = Hat(m)
+		}
+	default:
+		return b, false, fmt.Errorf("unknown input type %q", b.input)
+	}
+	if err == nil && b.index < 0 {
+		err = errors.New("negative index")
+	}
+
+	return b, err == nil, err
+}
+
+// Read the input of a binding from a joystick. Buttons, hats and half
+// axes read from 0 to 1, full axes from -1 to 1.
+func (b *gamepadBinding) read(j *Joystick) (value float64, full bool) {
+	switch b.input {
+	case 'b':
+		if j.Button(b.index) {
+			return 1, false
+		}
+	case 'h':
+		if j.Hat(b.index)&b.hatMask != 0 {
+			return 1, false
+		}
+	case 'a':
+		value = j.Axis(b.index)
+		if b.invert {
+			value = -value
+		}
+		if b.inputHalf == 0 {
+			return value, true
+		}
+		return math.Max(value*float64(b.inputHalf), 0), false
+	}
+	return 0, false
+}
+
+// Evaluate the mapping for a joystick
+func (m *GamepadMapping) state(j *Joystick) GamepadState {
+	var state GamepadState
+	for i := range m.bindings {
+		b := &m.bindings[i]
+		value, full := b.read(j)
+
+		if b.button >= 0 {
+			state.Buttons[b.button] = state.Buttons[b.button] || value > 0.5
+			continue
+		}
+
+		// Convert between full and half axes
+		switch {
+		case full && (b.half != 0 || b.axis.isTrigger()):
+			value = (value + 1) / 2
+		case !full && b.half == 0 && !b.axis.isTrigger() && b.input == 'a':
+			value = value*2 - 1
+		}
+		if b.half < 0 {
+			value = -value
+		}
+
+		// Several bindings may drive an axis, e.g. a button for each half
+		if math.Abs(value) > math.Abs(state.Axes[b.axis]) {
+			state.Axes[b.axis] = value
+		}
+	}
+	return state
+}
+
+// Compute the SDL-compatible GUID of a joystick: its bus, vendor, product
+// and version, or its bus and name if it has no vendor or product
+func joystickGUID(info EvdevInfo) string {
+	var guid [16]byte
+	put := func(i int, v uint16) {
+		guid[i], guid[i+1] = byte(v), byte(v>>8)
+	}
+
+	put(0, info.Bus)
+	if info.Vendor != 0 && info.Product != 0 {
+		put(4, info.Vendor)
+		put(8, info.Product)
+		put(12, info.Version)
+	} else {
+		copy(guid[4:], info.Name)
+	}
+	return hex.EncodeToString(guid[:])
+}
+
+// Compare two GUIDs, ignoring the CRC of the name which newer versions of
+// SDL add. Optionally ignore the version, for mappings made with older
+// firmware.
+func gamepadGUIDsMatch(a, b string, ignoreVersion bool) bool {
+	if len(a) != 32 || len(b) != 32 {
+		return false
+	}
+	if a[:4] != b[:4] || a[8:24] != b[8:24] {
+		return false
+	}
+	return ignoreVersion || a[24:] == b[24:]
+}
+
+// Read mappings in the SDL_GameControllerDB text format, one per line.
+// Blank lines, comments (starting with #) and mappings for other
+// platforms are skipped.
+func readGamepadMappings(r io.Reader) ([]*GamepadMapping, error) {
+	var mappings []*GamepadMapping
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		m, err := ParseGamepadMapping(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if m.platform == "" || m.platform == gamepadPlatform {
+			mappings = append(mappings, m)
+		}
+	}
+	return mappings, scanner.Err()
+}
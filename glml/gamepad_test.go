@@ -0,0 +1,107 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestParseGamepadMapping(t *testing.T) {
+	m, err := ParseGamepadMapping("03000000de2800000112000001000000,Steam Controller,a:b0,+leftx:h0.2,-leftx:h0.8,lefttrigger:+a2,righttrigger:-a2,righty:a3~,hint:!SDL_FOO:=1,platform:Linux,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "Steam Controller" || m.platform != "Linux" || len(m.bindings) != 6 {
+		t.Fatalf("unexpected mapping %+v", m)
+	}
+
+	expected := []gamepadBinding{
+		{button: GamepadA, input: 'b'},
+		{button: -1, axis: GamepadLeftX, half: 1, input: 'h', hatMask: HatRight},
+		{button: -1, axis: GamepadLeftX, half: -1, input: 'h', hatMask: HatLeft},
+		{button: -1, axis: GamepadLeftTrigger, input: 'a', index: 2, inputHalf: 1},
+		{button: -1, axis: GamepadRightTrigger, input: 'a', index: 2, inputHalf: -1},
+		{button: -1, axis: GamepadRightY, input: 'a', index: 3, invert: true},
+	}
+	for i, b := range expected {
+		if m.bindings[i] != b {
+			t.Errorf("binding %d: expected %+v, got %+v", i, b, m.bindings[i])
+		}
+	}
+
+	for _, line := range []string{
+		"030000005e0400008e020000,Short GUID,a:b0",
+		"030000005e0400008e02000014010000",
+		"030000005e0400008e02000014010000,Bad,a",
+		"030000005e0400008e02000014010000,Bad,a:",
+		"030000005e0400008e02000014010000,Bad,a:x0",
+		"030000005e0400008e02000014010000,Bad,a:b",
+		"030000005e0400008e02000014010000,Bad,a:+b0",
+		"030000005e0400008e02000014010000,Bad,dpup:h0",
+	} {
+		if _, err := ParseGamepadMapping(line); err == nil {
+			t.Errorf("expected an error for %q", line)
+		}
+	}
+}
+
+func TestGamepadMapping_State(t *testing.T) {
+	m, err := ParseGamepadMapping("00000000000000000000000000000000,Test,a:b0,b:a0,dpup:h0.1,leftx:a0,-lefty:b1,+lefty:b2,lefttrigger:a1,righttrigger:+a1,rightx:-a2,righty:a2~,")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	axis := func(code uint16) EvdevAxis { return EvdevAxis{Code: code, Min: -100, Max: 100} }
+	j := newJoystick(0, "", nil, EvdevInfo{
+		Keys: []uint16{0x120, 0x121, 0x122},
+		Axes: []EvdevAxis{axis(0x00), axis(0x01), axis(0x02), axis(0x10), axis(0x11)},
+	})
+	j.apply(EventHeader{}, []evdevEvent{
+		{evdevKey, 0x120, 1},
+		{evdevKey, 0x121, 1},
+		{evdevAbs, 0x00, 80},
+		{evdevAbs, 0x01, -50},
+		{evdevAbs, 0x02, -60},
+		{evdevAbs, 0x11, -1},
+	})
+
+	state := m.state(j)
+	if !state.Buttons[GamepadA] || !state.Buttons[GamepadB] || !state.Buttons[GamepadDPadUp] || state.Buttons[GamepadX] {
+		t.Errorf("unexpected buttons %v", state.Buttons)
+	}
+
+	expected := [GamepadAxisCount]float64{
+		GamepadLeftX:        0.8,
+		GamepadLeftY:        -1,   // The negative half's button
+		GamepadRightX:       0.2,  // Half of -0.6, scaled to the full axis
+		GamepadRightY:       0.6,  // Inverted
+		GamepadLeftTrigger:  0.25, // Scaled from the full axis
+		GamepadRightTrigger: 0,    // Only the positive half
+	}
+	for a, value := range expected {
+		if diff := state.Axes[a] - value; diff < -1e-9 || diff > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", GamepadAxis(a), value, state.Axes[a])
+		}
+	}
+}
+
+func TestJoystickGUID(t *testing.T) {
+	if guid := joystickGUID(EvdevInfo{Bus: 0x05, Name: "Wireless Controller"}); guid != "05000000576972656c65737320436f6e" {
+		t.Errorf("unexpected GUID from name %s", guid)
+	}
+
+	tests := []struct {
+		a, b                 string
+		exact, ignoreVersion bool
+	}{
+		{"030000005e0400008e02000014010000", "030000005e0400008e02000014010000", true, true},
+		{"03008fe45e0400008e02000014010000", "030000005e0400008e02000014010000", true, true}, // Name CRC
+		{"030000005e0400008e02000010010000", "030000005e0400008e02000014010000", false, true},
+		{"030000005e0400008f02000014010000", "030000005e0400008e02000014010000", false, false},
+		{"050000005e0400008e02000014010000", "030000005e0400008e02000014010000", false, false},
+	}
+	for _, test := range tests {
+		if gamepadGUIDsMatch(test.a, test.b, false) != test.exact || gamepadGUIDsMatch(test.a, test.b, true) != test.ignoreVersion {
+			t.Errorf("unexpected match of %s and %s", test.a, test.b)
+		}
+	}
+}
@@ -0,0 +1,5126 @@
+// Code generated by glgen; DO NOT EDIT.
+// glgen -header glext.h -version 3.0 -ext GL_ARB_framebuffer_object,GL_EXT_framebuffer_object -o gl.go
+
+package glml
+
+// #include "glproc.h"
+//
+// typedef struct
+// {
+// 	PFNGLBLENDCOLORPROC p_glBlendColor;
+// 	PFNGLBLENDEQUATIONPROC p_glBlendEquation;
+// 	PFNGLDRAWRANGEELEMENTSPROC p_glDrawRangeElements;
+// 	PFNGLTEXIMAGE3DPROC p_glTexImage3D;
+// 	PFNGLTEXSUBIMAGE3DPROC p_glTexSubImage3D;
+// 	PFNGLCOPYTEXSUBIMAGE3DPROC p_glCopyTexSubImage3D;
+// 	PFNGLCOLORTABLEPROC p_glColorTable;
+// 	PFNGLCOLORTABLEPARAMETERFVPROC p_glColorTableParameterfv;
+// 	PFNGLCOLORTABLEPARAMETERIVPROC p_glColorTableParameteriv;
+// 	PFNGLCOPYCOLORTABLEPROC p_glCopyColorTable;
+// 	PFNGLGETCOLORTABLEPROC p_glGetColorTable;
+// 	PFNGLGETCOLORTABLEPARAMETERFVPROC p_glGetColorTableParameterfv;
+// 	PFNGLGETCOLORTABLEPARAMETERIVPROC p_glGetColorTableParameteriv;
+// 	PFNGLCOLORSUBTABLEPROC p_glColorSubTable;
+// 	PFNGLCOPYCOLORSUBTABLEPROC p_glCopyColorSubTable;
+// 	PFNGLCONVOLUTIONFILTER1DPROC p_glConvolutionFilter1D;
+// 	PFNGLCONVOLUTIONFILTER2DPROC p_glConvolutionFilter2D;
+// 	PFNGLCONVOLUTIONPARAMETERFPROC p_glConvolutionParameterf;
+// 	PFNGLCONVOLUTIONPARAMETERFVPROC p_glConvolutionParameterfv;
+// 	PFNGLCONVOLUTIONPARAMETERIPROC p_glConvolutionParameteri;
+// 	PFNGLCONVOLUTIONPARAMETERIVPROC p_glConvolutionParameteriv;
+// 	PFNGLCOPYCONVOLUTIONFILTER1DPROC p_glCopyConvolutionFilter1D;
+// 	PFNGLCOPYCONVOLUTIONFILTER2DPROC p_glCopyConvolutionFilter2D;
+// 	PFNGLGETCONVOLUTIONFILTERPROC p_glGetConvolutionFilter;
+// 	PFNGLGETCONVOLUTIONPARAMETERFVPROC p_glGetConvolutionParameterfv;
+// 	PFNGLGETCONVOLUTIONPARAMETERIVPROC p_glGetConvolutionParameteriv;
+// 	PFNGLGETSEPARABLEFILTERPROC p_glGetSeparableFilter;
+// 	PFNGLSEPARABLEFILTER2DPROC p_glSeparableFilter2D;
+// 	PFNGLGETHISTOGRAMPROC p_glGetHistogram;
+// 	PFNGLGETHISTOGRAMPARAMETERFVPROC p_glGetHistogramParameterfv;
+// 	PFNGLGETHISTOGRAMPARAMETERIVPROC p_glGetHistogramParameteriv;
+// 	PFNGLGETMINMAXPROC p_glGetMinmax;
+// 	PFNGLGETMINMAXPARAMETERFVPROC p_glGetMinmaxParameterfv;
+// 	PFNGLGETMINMAXPARAMETERIVPROC p_glGetMinmaxParameteriv;
+// 	PFNGLHISTOGRAMPROC p_glHistogram;
+// 	PFNGLMINMAXPROC p_glMinmax;
+// 	PFNGLRESETHISTOGRAMPROC p_glResetHistogram;
+// 	PFNGLRESETMINMAXPROC p_glResetMinmax;
+// 	PFNGLACTIVETEXTUREPROC p_glActiveTexture;
+// 	PFNGLSAMPLECOVERAGEPROC p_glSampleCoverage;
+// 	PFNGLCOMPRESSEDTEXIMAGE3DPROC p_glCompressedTexImage3D;
+// 	PFNGLCOMPRESSEDTEXIMAGE2DPROC p_glCompressedTexImage2D;
+// 	PFNGLCOMPRESSEDTEXIMAGE1DPROC p_glCompressedTexImage1D;
+// 	PFNGLCOMPRESSEDTEXSUBIMAGE3DPROC p_glCompressedTexSubImage3D;
+// 	PFNGLCOMPRESSEDTEXSUBIMAGE2DPROC p_glCompressedTexSubImage2D;
+// 	PFNGLCOMPRESSEDTEXSUBIMAGE1DPROC p_glCompressedTexSubImage1D;
+// 	PFNGLGETCOMPRESSEDTEXIMAGEPROC p_glGetCompressedTexImage;
+// 	PFNGLCLIENTACTIVETEXTUREPROC p_glClientActiveTexture;
+// 	PFNGLMULTITEXCOORD1DPROC p_glMultiTexCoord1d;
+// 	PFNGLMULTITEXCOORD1DVPROC p_glMultiTexCoord1dv;
+// 	PFNGLMULTITEXCOORD1FPROC p_glMultiTexCoord1f;
+// 	PFNGLMULTITEXCOORD1FVPROC p_glMultiTexCoord1fv;
+// 	PFNGLMULTITEXCOORD1IPROC p_glMultiTexCoord1i;
+// 	PFNGLMULTITEXCOORD1IVPROC p_glMultiTexCoord1iv;
+// 	PFNGLMULTITEXCOORD1SPROC p_glMultiTexCoord1s;
+// 	PFNGLMULTITEXCOORD1SVPROC p_glMultiTexCoord1sv;
+// 	PFNGLMULTITEXCOORD2DPROC p_glMultiTexCoord2d;
+// 	PFNGLMULTITEXCOORD2DVPROC p_glMultiTexCoord2dv;
+// 	PFNGLMULTITEXCOORD2FPROC p_glMultiTexCoord2f;
+// 	PFNGLMULTITEXCOORD2FVPROC p_glMultiTexCoord2fv;
+// 	PFNGLMULTITEXCOORD2IPROC p_glMultiTexCoord2i;
+// 	PFNGLMULTITEXCOORD2IVPROC p_glMultiTexCoord2iv;
+// 	PFNGLMULTITEXCOORD2SPROC p_glMultiTexCoord2s;
+// 	PFNGLMULTITEXCOORD2SVPROC p_glMultiTexCoord2sv;
+// 	PFNGLMULTITEXCOORD3DPROC p_glMultiTexCoord3d;
+// 	PFNGLMULTITEXCOORD3DVPROC p_glMultiTexCoord3dv;
+// 	PFNGLMULTITEXCOORD3FPROC p_glMultiTexCoord3f;
+// 	PFNGLMULTITEXCOORD3FVPROC p_glMultiTexCoord3fv;
+// 	PFNGLMULTITEXCOORD3IPROC p_glMultiTexCoord3i;
+// 	PFNGLMULTITEXCOORD3IVPROC p_glMultiTexCoord3iv;
+// 	PFNGLMULTITEXCOORD3SPROC p_glMultiTexCoord3s;
+// 	PFNGLMULTITEXCOORD3SVPROC p_glMultiTexCoord3sv;
+// 	PFNGLMULTITEXCOORD4DPROC p_glMultiTexCoord4d;
+// 	PFNGLMULTITEXCOORD4DVPROC p_glMultiTexCoord4dv;
+// 	PFNGLMULTITEXCOORD4FPROC p_glMultiTexCoord4f;
+// 	PFNGLMULTITEXCOORD4FVPROC p_glMultiTexCoord4fv;
+// 	PFNGLMULTITEXCOORD4IPROC p_glMultiTexCoord4i;
+// 	PFNGLMULTITEXCOORD4IVPROC p_glMultiTexCoord4iv;
+// 	PFNGLMULTITEXCOORD4SPROC p_glMultiTexCoord4s;
+// 	PFNGLMULTITEXCOORD4SVPROC p_glMultiTexCoord4sv;
+// 	PFNGLLOADTRANSPOSEMATRIXFPROC p_glLoadTransposeMatrixf;
+// 	PFNGLLOADTRANSPOSEMATRIXDPROC p_glLoadTransposeMatrixd;
+// 	PFNGLMULTTRANSPOSEMATRIXFPROC p_glMultTransposeMatrixf;
+// 	PFNGLMULTTRANSPOSEMATRIXDPROC p_glMultTransposeMatrixd;
+// 	PFNGLBLENDFUNCSEPARATEPROC p_glBlendFuncSeparate;
+// 	PFNGLMULTIDRAWARRAYSPROC p_glMultiDrawArrays;
+// 	PFNGLMULTIDRAWELEMENTSPROC p_glMultiDrawElements;
+// 	PFNGLPOINTPARAMETERFPROC p_glPointParameterf;
+// 	PFNGLPOINTPARAMETERFVPROC p_glPointParameterfv;
+// 	PFNGLPOINTPARAMETERIPROC p_glPointParameteri;
+// 	PFNGLPOINTPARAMETERIVPROC p_glPointParameteriv;
+// 	PFNGLFOGCOORDFPROC p_glFogCoordf;
+// 	PFNGLFOGCOORDFVPROC p_glFogCoordfv;
+// 	PFNGLFOGCOORDDPROC p_glFogCoordd;
+// 	PFNGLFOGCOORDDVPROC p_glFogCoorddv;
+// 	PFNGLFOGCOORDPOINTERPROC p_glFogCoordPointer;
+// 	PFNGLSECONDARYCOLOR3BPROC p_glSecondaryColor3b;
+// 	PFNGLSECONDARYCOLOR3BVPROC p_glSecondaryColor3bv;
+// 	PFNGLSECONDARYCOLOR3DPROC p_glSecondaryColor3d;
+// 	PFNGLSECONDARYCOLOR3DVPROC p_glSecondaryColor3dv;
+// 	PFNGLSECONDARYCOLOR3FPROC p_glSecondaryColor3f;
+// 	PFNGLSECONDARYCOLOR3FVPROC p_glSecondaryColor3fv;
+// 	PFNGLSECONDARYCOLOR3IPROC p_glSecondaryColor3i;
+// 	PFNGLSECONDARYCOLOR3IVPROC p_glSecondaryColor3iv;
+// 	PFNGLSECONDARYCOLOR3SPROC p_glSecondaryColor3s;
+// 	PFNGLSECONDARYCOLOR3SVPROC p_glSecondaryColor3sv;
+// 	PFNGLSECONDARYCOLOR3UBPROC p_glSecondaryColor3ub;
+// 	PFNGLSECONDARYCOLOR3UBVPROC p_glSecondaryColor3ubv;
+// 	PFNGLSECONDARYCOLOR3UIPROC p_glSecondaryColor3ui;
+// 	PFNGLSECONDARYCOLOR3UIVPROC p_glSecondaryColor3uiv;
+// 	PFNGLSECONDARYCOLOR3USPROC p_glSecondaryColor3us;
+// 	PFNGLSECONDARYCOLOR3USVPROC p_glSecondaryColor3usv;
+// 	PFNGLSECONDARYCOLORPOINTERPROC p_glSecondaryColorPointer;
+// 	PFNGLWINDOWPOS2DPROC p_glWindowPos2d;
+// 	PFNGLWINDOWPOS2DVPROC p_glWindowPos2dv;
+// 	PFNGLWINDOWPOS2FPROC p_glWindowPos2f;
+// 	PFNGLWINDOWPOS2FVPROC p_glWindowPos2fv;
+// 	PFNGLWINDOWPOS2IPROC p_glWindowPos2i;
+// 	PFNGLWINDOWPOS2IVPROC p_glWindowPos2iv;
+// 	PFNGLWINDOWPOS2SPROC p_glWindowPos2s;
+// 	PFNGLWINDOWPOS2SVPROC p_glWindowPos2sv;
+// 	PFNGLWINDOWPOS3DPROC p_glWindowPos3d;
+// 	PFNGLWINDOWPOS3DVPROC p_glWindowPos3dv;
+// 	PFNGLWINDOWPOS3FPROC p_glWindowPos3f;
+// 	PFNGLWINDOWPOS3FVPROC p_glWindowPos3fv;
+// 	PFNGLWINDOWPOS3IPROC p_glWindowPos3i;
+// 	PFNGLWINDOWPOS3IVPROC p_glWindowPos3iv;
+// 	PFNGLWINDOWPOS3SPROC p_glWindowPos3s;
+// 	PFNGLWINDOWPOS3SVPROC p_glWindowPos3sv;
+// 	PFNGLGENQUERIESPROC p_glGenQueries;
+// 	PFNGLDELETEQUERIESPROC p_glDeleteQueries;
+// 	PFNGLISQUERYPROC p_glIsQuery;
+// 	PFNGLBEGINQUERYPROC p_glBeginQuery;
+// 	PFNGLENDQUERYPROC p_glEndQuery;
+// 	PFNGLGETQUERYIVPROC p_glGetQueryiv;
+// 	PFNGLGETQUERYOBJECTIVPROC p_glGetQueryObjectiv;
+// 	PFNGLGETQUERYOBJECTUIVPROC p_glGetQueryObjectuiv;
+// 	PFNGLBINDBUFFERPROC p_glBindBuffer;
+// 	PFNGLDELETEBUFFERSPROC p_glDeleteBuffers;
+// 	PFNGLGENBUFFERSPROC p_glGenBuffers;
+// 	PFNGLISBUFFERPROC p_glIsBuffer;
+// 	PFNGLBUFFERDATAPROC p_glBufferData;
+// 	PFNGLBUFFERSUBDATAPROC p_glBufferSubData;
+// 	PFNGLGETBUFFERSUBDATAPROC p_glGetBufferSubData;
+// 	PFNGLMAPBUFFERPROC p_glMapBuffer;
+// 	PFNGLUNMAPBUFFERPROC p_glUnmapBuffer;
+// 	PFNGLGETBUFFERPARAMETERIVPROC p_glGetBufferParameteriv;
+// 	PFNGLGETBUFFERPOINTERVPROC p_glGetBufferPointerv;
+// 	PFNGLBLENDEQUATIONSEPARATEPROC p_glBlendEquationSeparate;
+// 	PFNGLDRAWBUFFERSPROC p_glDrawBuffers;
+// 	PFNGLSTENCILOPSEPARATEPROC p_glStencilOpSeparate;
+// 	PFNGLSTENCILFUNCSEPARATEPROC p_glStencilFuncSeparate;
+// 	PFNGLSTENCILMASKSEPARATEPROC p_glStencilMaskSeparate;
+// 	PFNGLATTACHSHADERPROC p_glAttachShader;
+// 	PFNGLBINDATTRIBLOCATIONPROC p_glBindAttribLocation;
+// 	PFNGLCOMPILESHADERPROC p_glCompileShader;
+// 	PFNGLCREATEPROGRAMPROC p_glCreateProgram;
+// 	PFNGLCREATESHADERPROC p_glCreateShader;
+// 	PFNGLDELETEPROGRAMPROC p_glDeleteProgram;
+// 	PFNGLDELETESHADERPROC p_glDeleteShader;
+// 	PFNGLDETACHSHADERPROC p_glDetachShader;
+// 	PFNGLDISABLEVERTEXATTRIBARRAYPROC p_glDisableVertexAttribArray;
+// 	PFNGLENABLEVERTEXATTRIBARRAYPROC p_glEnableVertexAttribArray;
+// 	PFNGLGETACTIVEATTRIBPROC p_glGetActiveAttrib;
+// 	PFNGLGETACTIVEUNIFORMPROC p_glGetActiveUniform;
+// 	PFNGLGETATTACHEDSHADERSPROC p_glGetAttachedShaders;
+// 	PFNGLGETATTRIBLOCATIONPROC p_glGetAttribLocation;
+// 	PFNGLGETPROGRAMIVPROC p_glGetProgramiv;
+// 	PFNGLGETPROGRAMINFOLOGPROC p_glGetProgramInfoLog;
+// 	PFNGLGETSHADERIVPROC p_glGetShaderiv;
+// 	PFNGLGETSHADERINFOLOGPROC p_glGetShaderInfoLog;
+// 	PFNGLGETSHADERSOURCEPROC p_glGetShaderSource;
+// 	PFNGLGETUNIFORMLOCATIONPROC p_glGetUniformLocation;
+// 	PFNGLGETUNIFORMFVPROC p_glGetUniformfv;
+// 	PFNGLGETUNIFORMIVPROC p_glGetUniformiv;
+// 	PFNGLGETVERTEXATTRIBDVPROC p_glGetVertexAttribdv;
+// 	PFNGLGETVERTEXATTRIBFVPROC p_glGetVertexAttribfv;
+// 	PFNGLGETVERTEXATTRIBIVPROC p_glGetVertexAttribiv;
+// 	PFNGLGETVERTEXATTRIBPOINTERVPROC p_glGetVertexAttribPointerv;
+// 	PFNGLISPROGRAMPROC p_glIsProgram;
+// 	PFNGLISSHADERPROC p_glIsShader;
+// 	PFNGLLINKPROGRAMPROC p_glLinkProgram;
+// 	PFNGLSHADERSOURCEPROC p_glShaderSource;
+// 	PFNGLUSEPROGRAMPROC p_glUseProgram;
+// 	PFNGLUNIFORM1FPROC p_glUniform1f;
+// 	PFNGLUNIFORM2FPROC p_glUniform2f;
+// 	PFNGLUNIFORM3FPROC p_glUniform3f;
+// 	PFNGLUNIFORM4FPROC p_glUniform4f;
+// 	PFNGLUNIFORM1IPROC p_glUniform1i;
+// 	PFNGLUNIFORM2IPROC p_glUniform2i;
+// 	PFNGLUNIFORM3IPROC p_glUniform3i;
+// 	PFNGLUNIFORM4IPROC p_glUniform4i;
+// 	PFNGLUNIFORM1FVPROC p_glUniform1fv;
+// 	PFNGLUNIFORM2FVPROC p_glUniform2fv;
+// 	PFNGLUNIFORM3FVPROC p_glUniform3fv;
+// 	PFNGLUNIFORM4FVPROC p_glUniform4fv;
+// 	PFNGLUNIFORM1IVPROC p_glUniform1iv;
+// 	PFNGLUNIFORM2IVPROC p_glUniform2iv;
+// 	PFNGLUNIFORM3IVPROC p_glUniform3iv;
+// 	PFNGLUNIFORM4IVPROC p_glUniform4iv;
+// 	PFNGLUNIFORMMATRIX2FVPROC p_glUniformMatrix2fv;
+// 	PFNGLUNIFORMMATRIX3FVPROC p_glUniformMatrix3fv;
+// 	PFNGLUNIFORMMATRIX4FVPROC p_glUniformMatrix4fv;
+// 	PFNGLVALIDATEPROGRAMPROC p_glValidateProgram;
+// 	PFNGLVERTEXATTRIB1DPROC p_glVertexAttrib1d;
+// 	PFNGLVERTEXATTRIB1DVPROC p_glVertexAttrib1dv;
+// 	PFNGLVERTEXATTRIB1FPROC p_glVertexAttrib1f;
+// 	PFNGLVERTEXATTRIB1FVPROC p_glVertexAttrib1fv;
+// 	PFNGLVERTEXATTRIB1SPROC p_glVertexAttrib1s;
+// 	PFNGLVERTEXATTRIB1SVPROC p_glVertexAttrib1sv;
+// 	PFNGLVERTEXATTRIB2DPROC p_glVertexAttrib2d;
+// 	PFNGLVERTEXATTRIB2DVPROC p_glVertexAttrib2dv;
+// 	PFNGLVERTEXATTRIB2FPROC p_glVertexAttrib2f;
+// 	PFNGLVERTEXATTRIB2FVPROC p_glVertexAttrib2fv;
+// 	PFNGLVERTEXATTRIB2SPROC p_glVertexAttrib2s;
+// 	PFNGLVERTEXATTRIB2SVPROC p_glVertexAttrib2sv;
+// 	PFNGLVERTEXATTRIB3DPROC p_glVertexAttrib3d;
+// 	PFNGLVERTEXATTRIB3DVPROC p_glVertexAttrib3dv;
+// 	PFNGLVERTEXATTRIB3FPROC p_glVertexAttrib3f;
+// 	PFNGLVERTEXATTRIB3FVPROC p_glVertexAttrib3fv;
+// 	PFNGLVERTEXATTRIB3SPROC p_glVertexAttrib3s;
+// 	PFNGLVERTEXATTRIB3SVPROC p_glVertexAttrib3sv;
+// 	PFNGLVERTEXATTRIB4NBVPROC p_glVertexAttrib4Nbv;
+// 	PFNGLVERTEXATTRIB4NIVPROC p_glVertexAttrib4Niv;
+// 	PFNGLVERTEXATTRIB4NSVPROC p_glVertexAttrib4Nsv;
+// 	PFNGLVERTEXATTRIB4NUBPROC p_glVertexAttrib4Nub;
+// 	PFNGLVERTEXATTRIB4NUBVPROC p_glVertexAttrib4Nubv;
+// 	PFNGLVERTEXATTRIB4NUIVPROC p_glVertexAttrib4Nuiv;
+// 	PFNGLVERTEXATTRIB4NUSVPROC p_glVertexAttrib4Nusv;
+// 	PFNGLVERTEXATTRIB4BVPROC p_glVertexAttrib4bv;
+// 	PFNGLVERTEXATTRIB4DPROC p_glVertexAttrib4d;
+// 	PFNGLVERTEXATTRIB4DVPROC p_glVertexAttrib4dv;
+// 	PFNGLVERTEXATTRIB4FPROC p_glVertexAttrib4f;
+// 	PFNGLVERTEXATTRIB4FVPROC p_glVertexAttrib4fv;
+// 	PFNGLVERTEXATTRIB4IVPROC p_glVertexAttrib4iv;
+// 	PFNGLVERTEXATTRIB4SPROC p_glVertexAttrib4s;
+// 	PFNGLVERTEXATTRIB4SVPROC p_glVertexAttrib4sv;
+// 	PFNGLVERTEXATTRIB4UBVPROC p_glVertexAttrib4ubv;
+// 	PFNGLVERTEXATTRIB4UIVPROC p_glVertexAttrib4uiv;
+// 	PFNGLVERTEXATTRIB4USVPROC p_glVertexAttrib4usv;
+// 	PFNGLVERTEXATTRIBPOINTERPROC p_glVertexAttribPointer;
+// 	PFNGLUNIFORMMATRIX2X3FVPROC p_glUniformMatrix2x3fv;
+// 	PFNGLUNIFORMMATRIX3X2FVPROC p_glUniformMatrix3x2fv;
+// 	PFNGLUNIFORMMATRIX2X4FVPROC p_glUniformMatrix2x4fv;
+// 	PFNGLUNIFORMMATRIX4X2FVPROC p_glUniformMatrix4x2fv;
+// 	PFNGLUNIFORMMATRIX3X4FVPROC p_glUniformMatrix3x4fv;
+// 	PFNGLUNIFORMMATRIX4X3FVPROC p_glUniformMatrix4x3fv;
+// 	PFNGLCOLORMASKIPROC p_glColorMaski;
+// 	PFNGLGETBOOLEANI_VPROC p_glGetBooleani_v;
+// 	PFNGLGETINTEGERI_VPROC p_glGetIntegeri_v;
+// 	PFNGLENABLEIPROC p_glEnablei;
+// 	PFNGLDISABLEIPROC p_glDisablei;
+// 	PFNGLISENABLEDIPROC p_glIsEnabledi;
+// 	PFNGLBEGINTRANSFORMFEEDBACKPROC p_glBeginTransformFeedback;
+// 	PFNGLENDTRANSFORMFEEDBACKPROC p_glEndTransformFeedback;
+// 	PFNGLBINDBUFFERRANGEPROC p_glBindBufferRange;
+// 	PFNGLBINDBUFFERBASEPROC p_glBindBufferBase;
+// 	PFNGLTRANSFORMFEEDBACKVARYINGSPROC p_glTransformFeedbackVaryings;
+// 	PFNGLGETTRANSFORMFEEDBACKVARYINGPROC p_glGetTransformFeedbackVarying;
+// 	PFNGLCLAMPCOLORPROC p_glClampColor;
+// 	PFNGLBEGINCONDITIONALRENDERPROC p_glBeginConditionalRender;
+// 	PFNGLENDCONDITIONALRENDERPROC p_glEndConditionalRender;
+// 	PFNGLVERTEXATTRIBIPOINTERPROC p_glVertexAttribIPointer;
+// 	PFNGLGETVERTEXATTRIBIIVPROC p_glGetVertexAttribIiv;
+// 	PFNGLGETVERTEXATTRIBIUIVPROC p_glGetVertexAttribIuiv;
+// 	PFNGLVERTEXATTRIBI1IPROC p_glVertexAttribI1i;
+// 	PFNGLVERTEXATTRIBI2IPROC p_glVertexAttribI2i;
+// 	PFNGLVERTEXATTRIBI3IPROC p_glVertexAttribI3i;
+// 	PFNGLVERTEXATTRIBI4IPROC p_glVertexAttribI4i;
+// 	PFNGLVERTEXATTRIBI1UIPROC p_glVertexAttribI1ui;
+// 	PFNGLVERTEXATTRIBI2UIPROC p_glVertexAttribI2ui;
+// 	PFNGLVERTEXATTRIBI3UIPROC p_glVertexAttribI3ui;
+// 	PFNGLVERTEXATTRIBI4UIPROC p_glVertexAttribI4ui;
+// 	PFNGLVERTEXATTRIBI1IVPROC p_glVertexAttribI1iv;
+// 	PFNGLVERTEXATTRIBI2IVPROC p_glVertexAttribI2iv;
+// 	PFNGLVERTEXATTRIBI3IVPROC p_glVertexAttribI3iv;
+// 	PFNGLVERTEXATTRIBI4IVPROC p_glVertexAttribI4iv;
+// 	PFNGLVERTEXATTRIBI1UIVPROC p_glVertexAttribI1uiv;
+// 	PFNGLVERTEXATTRIBI2UIVPROC p_glVertexAttribI2uiv;
+// 	PFNGLVERTEXATTRIBI3UIVPROC p_glVertexAttribI3uiv;
+// 	PFNGLVERTEXATTRIBI4UIVPROC p_glVertexAttribI4uiv;
+// 	PFNGLVERTEXATTRIBI4BVPROC p_glVertexAttribI4bv;
+// 	PFNGLVERTEXATTRIBI4SVPROC p_glVertexAttribI4sv;
+// 	PFNGLVERTEXATTRIBI4UBVPROC p_glVertexAttribI4ubv;
+// 	PFNGLVERTEXATTRIBI4USVPROC p_glVertexAttribI4usv;
+// 	PFNGLGETUNIFORMUIVPROC p_glGetUniformuiv;
+// 	PFNGLBINDFRAGDATALOCATIONPROC p_glBindFragDataLocation;
+// 	PFNGLGETFRAGDATALOCATIONPROC p_glGetFragDataLocation;
+// 	PFNGLUNIFORM1UIPROC p_glUniform1ui;
+// 	PFNGLUNIFORM2UIPROC p_glUniform2ui;
+// 	PFNGLUNIFORM3UIPROC p_glUniform3ui;
+// 	PFNGLUNIFORM4UIPROC p_glUniform4ui;
+// 	PFNGLUNIFORM1UIVPROC p_glUniform1uiv;
+// 	PFNGLUNIFORM2UIVPROC p_glUniform2uiv;
+// 	PFNGLUNIFORM3UIVPROC p_glUniform3uiv;
+// 	PFNGLUNIFORM4UIVPROC p_glUniform4uiv;
+// 	PFNGLTEXPARAMETERIIVPROC p_glTexParameterIiv;
+// 	PFNGLTEXPARAMETERIUIVPROC p_glTexParameterIuiv;
+// 	PFNGLGETTEXPARAMETERIIVPROC p_glGetTexParameterIiv;
+// 	PFNGLGETTEXPARAMETERIUIVPROC p_glGetTexParameterIuiv;
+// 	PFNGLCLEARBUFFERIVPROC p_glClearBufferiv;
+// 	PFNGLCLEARBUFFERUIVPROC p_glClearBufferuiv;
+// 	PFNGLCLEARBUFFERFVPROC p_glClearBufferfv;
+// 	PFNGLCLEARBUFFERFIPROC p_glClearBufferfi;
+// 	PFNGLGETSTRINGIPROC p_glGetStringi;
+// 	PFNGLISRENDERBUFFERPROC p_glIsRenderbuffer;
+// 	PFNGLBINDRENDERBUFFERPROC p_glBindRenderbuffer;
+// 	PFNGLDELETERENDERBUFFERSPROC p_glDeleteRenderbuffers;
+// 	PFNGLGENRENDERBUFFERSPROC p_glGenRenderbuffers;
+// 	PFNGLRENDERBUFFERSTORAGEPROC p_glRenderbufferStorage;
+// 	PFNGLGETRENDERBUFFERPARAMETERIVPROC p_glGetRenderbufferParameteriv;
+// 	PFNGLISFRAMEBUFFERPROC p_glIsFramebuffer;
+// 	PFNGLBINDFRAMEBUFFERPROC p_glBindFramebuffer;
+// 	PFNGLDELETEFRAMEBUFFERSPROC p_glDeleteFramebuffers;
+// 	PFNGLGENFRAMEBUFFERSPROC p_glGenFramebuffers;
+// 	PFNGLCHECKFRAMEBUFFERSTATUSPROC p_glCheckFramebufferStatus;
+// 	PFNGLFRAMEBUFFERTEXTURE1DPROC p_glFramebufferTexture1D;
+// 	PFNGLFRAMEBUFFERTEXTURE2DPROC p_glFramebufferTexture2D;
+// 	PFNGLFRAMEBUFFERTEXTURE3DPROC p_glFramebufferTexture3D;
+// 	PFNGLFRAMEBUFFERRENDERBUFFERPROC p_glFramebufferRenderbuffer;
+// 	PFNGLGETFRAMEBUFFERATTACHMENTPARAMETERIVPROC p_glGetFramebufferAttachmentParameteriv;
+// 	PFNGLGENERATEMIPMAPPROC p_glGenerateMipmap;
+// 	PFNGLBLITFRAMEBUFFERPROC p_glBlitFramebuffer;
+// 	PFNGLRENDERBUFFERSTORAGEMULTISAMPLEPROC p_glRenderbufferStorageMultisample;
+// 	PFNGLFRAMEBUFFERTEXTURELAYERPROC p_glFramebufferTextureLayer;
+// 	PFNGLISRENDERBUFFEREXTPROC p_glIsRenderbufferEXT;
+// 	PFNGLBINDRENDERBUFFEREXTPROC p_glBindRenderbufferEXT;
+// 	PFNGLDELETERENDERBUFFERSEXTPROC p_glDeleteRenderbuffersEXT;
+// 	PFNGLGENRENDERBUFFERSEXTPROC p_glGenRenderbuffersEXT;
+// 	PFNGLRENDERBUFFERSTORAGEEXTPROC p_glRenderbufferStorageEXT;
+// 	PFNGLGETRENDERBUFFERPARAMETERIVEXTPROC p_glGetRenderbufferParameterivEXT;
+// 	PFNGLISFRAMEBUFFEREXTPROC p_glIsFramebufferEXT;
+// 	PFNGLBINDFRAMEBUFFEREXTPROC p_glBindFramebufferEXT;
+// 	PFNGLDELETEFRAMEBUFFERSEXTPROC p_glDeleteFramebuffersEXT;
+// 	PFNGLGENFRAMEBUFFERSEXTPROC p_glGenFramebuffersEXT;
+// 	PFNGLCHECKFRAMEBUFFERSTATUSEXTPROC p_glCheckFramebufferStatusEXT;
+// 	PFNGLFRAMEBUFFERTEXTURE1DEXTPROC p_glFramebufferTexture1DEXT;
+// 	PFNGLFRAMEBUFFERTEXTURE2DEXTPROC p_glFramebufferTexture2DEXT;
+// 	PFNGLFRAMEBUFFERTEXTURE3DEXTPROC p_glFramebufferTexture3DEXT;
+// 	PFNGLFRAMEBUFFERRENDERBUFFEREXTPROC p_glFramebufferRenderbufferEXT;
+// 	PFNGLGETFRAMEBUFFERATTACHMENTPARAMETERIVEXTPROC p_glGetFramebufferAttachmentParameterivEXT;
+// 	PFNGLGENERATEMIPMAPEXTPROC p_glGenerateMipmapEXT;
+// } glProcs;
+//
+// void glLoadProcs(glProcs * procs)
+// {
+// 	procs->p_glBlendColor = (PFNGLBLENDCOLORPROC)glmlGetProcAddress("glBlendColor");
+// 	procs->p_glBlendEquation = (PFNGLBLENDEQUATIONPROC)glmlGetProcAddress("glBlendEquation");
+// 	procs->p_glDrawRangeElements = (PFNGLDRAWRANGEELEMENTSPROC)glmlGetProcAddress("glDrawRangeElements");
+// 	procs->p_glTexImage3D = (PFNGLTEXIMAGE3DPROC)glmlGetProcAddress("glTexImage3D");
+// 	procs->p_glTexSubImage3D = (PFNGLTEXSUBIMAGE3DPROC)glmlGetProcAddress("glTexSubImage3D");
+// 	procs->p_glCopyTexSubImage3D = (PFNGLCOPYTEXSUBIMAGE3DPROC)glmlGetProcAddress("glCopyTexSubImage3D");
+// 	procs->p_glColorTable = (PFNGLCOLORTABLEPROC)glmlGetProcAddress("glColorTable");
+// 	procs->p_glColorTableParameterfv = (PFNGLCOLORTABLEPARAMETERFVPROC)glmlGetProcAddress("glColorTableParameterfv");
+// 	procs->p_glColorTableParameteriv = (PFNGLCOLORTABLEPARAMETERIVPROC)glmlGetProcAddress("glColorTableParameteriv");
+// 	procs->p_glCopyColorTable = (PFNGLCOPYCOLORTABLEPROC)glmlGetProcAddress("glCopyColorTable");
+// 	procs->p_glGetColorTable = (PFNGLGETCOLORTABLEPROC)glmlGetProcAddress("glGetColorTable");
+// 	procs->p_glGetColorTableParameterfv = (PFNGLGETCOLORTABLEPARAMETERFVPROC)glmlGetProcAddress("glGetColorTableParameterfv");
+// 	procs->p_glGetColorTableParameteriv = (PFNGLGETCOLORTABLEPARAMETERIVPROC)glmlGetProcAddress("glGetColorTableParameteriv");
+// 	procs->p_glColorSubTable = (PFNGLCOLORSUBTABLEPROC)glmlGetProcAddress("glColorSubTable");
+// 	procs->p_glCopyColorSubTable = (PFNGLCOPYCOLORSUBTABLEPROC)glmlGetProcAddress("glCopyColorSubTable");
+// 	procs->p_glConvolutionFilter1D = (PFNGLCONVOLUTIONFILTER1DPROC)glmlGetProcAddress("glConvolutionFilter1D");
+// 	procs->p_glConvolutionFilter2D = (PFNGLCONVOLUTIONFILTER2DPROC)glmlGetProcAddress("glConvolutionFilter2D");
+// 	procs->p_glConvolutionParameterf = (PFNGLCONVOLUTIONPARAMETERFPROC)glmlGetProcAddress("glConvolutionParameterf");
+// 	procs->p_glConvolutionParameterfv = (PFNGLCONVOLUTIONPARAMETERFVPROC)glmlGetProcAddress("glConvolutionParameterfv");
+// 	procs->p_glConvolutionParameteri = (PFNGLCONVOLUTIONPARAMETERIPROC)glmlGetProcAddress("glConvolutionParameteri");
+// 	procs->p_glConvolutionParameteriv = (PFNGLCONVOLUTIONPARAMETERIVPROC)glmlGetProcAddress("glConvolutionParameteriv");
+// 	procs->p_glCopyConvolutionFilter1D = (PFNGLCOPYCONVOLUTIONFILTER1DPROC)glmlGetProcAddress("glCopyConvolutionFilter1D");
+// 	procs->p_glCopyConvolutionFilter2D = (PFNGLCOPYCONVOLUTIONFILTER2DPROC)glmlGetProcAddress("glCopyConvolutionFilter2D");
+// 	procs->p_glGetConvolutionFilter = (PFNGLGETCONVOLUTIONFILTERPROC)glmlGetProcAddress("glGetConvolutionFilter");
+// 	procs->p_glGetConvolutionParameterfv = (PFNGLGETCONVOLUTIONPARAMETERFVPROC)glmlGetProcAddress("glGetConvolutionParameterfv");
+// 	procs->p_glGetConvolutionParameteriv = (PFNGLGETCONVOLUTIONPARAMETERIVPROC)glmlGetProcAddress("glGetConvolutionParameteriv");
+// 	procs->p_glGetSeparableFilter = (PFNGLGETSEPARABLEFILTERPROC)glmlGetProcAddress("glGetSeparableFilter");
+// 	procs->p_glSeparableFilter2D = (PFNGLSEPARABLEFILTER2DPROC)glmlGetProcAddress("glSeparableFilter2D");
+// 	procs->p_glGetHistogram = (PFNGLGETHISTOGRAMPROC)glmlGetProcAddress("glGetHistogram");
+// 	procs->p_glGetHistogramParameterfv = (PFNGLGETHISTOGRAMPARAMETERFVPROC)glmlGetProcAddress("glGetHistogramParameterfv");
+// 	procs->p_glGetHistogramParameteriv = (PFNGLGETHISTOGRAMPARAMETERIVPROC)glmlGetProcAddress("glGetHistogramParameteriv");
+// 	procs->p_glGetMinmax = (PFNGLGETMINMAXPROC)glmlGetProcAddress("glGetMinmax");
+// 	procs->p_glGetMinmaxParameterfv = (PFNGLGETMINMAXPARAMETERFVPROC)glmlGetProcAddress("glGetMinmaxParameterfv");
+// 	procs->p_glGetMinmaxParameteriv = (PFNGLGETMINMAXPARAMETERIVPROC)glmlGetProcAddress("glGetMinmaxParameteriv");
+// 	procs->p_glHistogram = (PFNGLHISTOGRAMPROC)glmlGetProcAddress("glHistogram");
+// 	procs->p_glMinmax = (PFNGLMINMAXPROC)glmlGetProcAddress("glMinmax");
+// 	procs->p_glResetHistogram = (PFNGLRESETHISTOGRAMPROC)glmlGetProcAddress("glResetHistogram");
+// 	procs->p_glResetMinmax = (PFNGLRESETMINMAXPROC)glmlGetProcAddress("glResetMinmax");
+// 	procs->p_glActiveTexture = (PFNGLACTIVETEXTUREPROC)glmlGetProcAddress("glActiveTexture");
+// 	procs->p_glSampleCoverage = (PFNGLSAMPLECOVERAGEPROC)glmlGetProcAddress("glSampleCoverage");
+// 	procs->p_glCompressedTexImage3D = (PFNGLCOMPRESSEDTEXIMAGE3DPROC)glmlGetProcAddress("glCompressedTexImage3D");
+// 	procs->p_glCompressedTexImage2D = (PFNGLCOMPRESSEDTEXIMAGE2DPROC)glmlGetProcAddress("glCompressedTexImage2D");
+// 	procs->p_glCompressedTexImage1D = (PFNGLCOMPRESSEDTEXIMAGE1DPROC)glmlGetProcAddress("glCompressedTexImage1D");
+// 	procs->p_glCompressedTexSubImage3D = (PFNGLCOMPRESSEDTEXSUBIMAGE3DPROC)glmlGetProcAddress("glCompressedTexSubImage3D");
+// 	procs->p_glCompressedTexSubImage2D = (PFNGLCOMPRESSEDTEXSUBIMAGE2DPROC)glmlGetProcAddress("glCompressedTexSubImage2D");
+// 	procs->p_glCompressedTexSubImage1D = (PFNGLCOMPRESSEDTEXSUBIMAGE1DPROC)glmlGetProcAddress("glCompressedTexSubImage1D");
+// 	procs->p_glGetCompressedTexImage = (PFNGLGETCOMPRESSEDTEXIMAGEPROC)glmlGetProcAddress("glGetCompressedTexImage");
+// 	procs->p_glClientActiveTexture = (PFNGLCLIENTACTIVETEXTUREPROC)glmlGetProcAddress("glClientActiveTexture");
+// 	procs->p_glMultiTexCoord1d = (PFNGLMULTITEXCOORD1DPROC)glmlGetProcAddress("glMultiTexCoord1d");
+// 	procs->p_glMultiTexCoord1dv = (PFNGLMULTITEXCOORD1DVPROC)glmlGetProcAddress("glMultiTexCoord1dv");
+// 	procs->p_glMultiTexCoord1f = (PFNGLMULTITEXCOORD1FPROC)glmlGetProcAddress("glMultiTexCoord1f");
+// 	procs->p_glMultiTexCoord1fv = (PFNGLMULTITEXCOORD1FVPROC)glmlGetProcAddress("glMultiTexCoord1fv");
+// 	procs->p_glMultiTexCoord1i = (PFNGLMULTITEXCOORD1IPROC)glmlGetProcAddress("glMultiTexCoord1i");
+// 	procs->p_glMultiTexCoord1iv = (PFNGLMULTITEXCOORD1IVPROC)glmlGetProcAddress("glMultiTexCoord1iv");
+// 	procs->p_glMultiTexCoord1s = (PFNGLMULTITEXCOORD1SPROC)glmlGetProcAddress("glMultiTexCoord1s");
+// 	procs->p_glMultiTexCoord1sv = (PFNGLMULTITEXCOORD1SVPROC)glmlGetProcAddress("glMultiTexCoord1sv");
+// 	procs->p_glMultiTexCoord2d = (PFNGLMULTITEXCOORD2DPROC)glmlGetProcAddress("glMultiTexCoord2d");
+// 	procs->p_glMultiTexCoord2dv = (PFNGLMULTITEXCOORD2DVPROC)glmlGetProcAddress("glMultiTexCoord2dv");
+// 	procs->p_glMultiTexCoord2f = (PFNGLMULTITEXCOORD2FPROC)glmlGetProcAddress("glMultiTexCoord2f");
+// 	procs->p_glMultiTexCoord2fv = (PFNGLMULTITEXCOORD2FVPROC)glmlGetProcAddress("glMultiTexCoord2fv");
+// 	procs->p_glMultiTexCoord2i = (PFNGLMULTITEXCOORD2IPROC)glmlGetProcAddress("glMultiTexCoord2i");
+// 	procs->p_glMultiTexCoord2iv = (PFNGLMULTITEXCOORD2IVPROC)glmlGetProcAddress("glMultiTexCoord2iv");
+// 	procs->p_glMultiTexCoord2s = (PFNGLMULTITEXCOORD2SPROC)glmlGetProcAddress("glMultiTexCoord2s");
+// 	procs->p_glMultiTexCoord2sv = (PFNGLMULTITEXCOORD2SVPROC)glmlGetProcAddress("glMultiTexCoord2sv");
+// 	procs->p_glMultiTexCoord3d = (PFNGLMULTITEXCOORD3DPROC)glmlGetProcAddress("glMultiTexCoord3d");
+// 	procs->p_glMultiTexCoord3dv = (PFNGLMULTITEXCOORD3DVPROC)glmlGetProcAddress("glMultiTexCoord3dv");
+// 	procs->p_glMultiTexCoord3f = (PFNGLMULTITEXCOORD3FPROC)glmlGetProcAddress("glMultiTexCoord3f");
+// 	procs->p_glMultiTexCoord3fv = (PFNGLMULTITEXCOORD3FVPROC)glmlGetProcAddress("glMultiTexCoord3fv");
+// 	procs->p_glMultiTexCoord3i = (PFNGLMULTITEXCOORD3IPROC)glmlGetProcAddress("glMultiTexCoord3i");
+// 	procs->p_glMultiTexCoord3iv = (PFNGLMULTITEXCOORD3IVPROC)glmlGetProcAddress("glMultiTexCoord3iv");
+// 	procs->p_glMultiTexCoord3s = (PFNGLMULTITEXCOORD3SPROC)glmlGetProcAddress("glMultiTexCoord3s");
+// 	procs->p_glMultiTexCoord3sv = (PFNGLMULTITEXCOORD3SVPROC)glmlGetProcAddress("glMultiTexCoord3sv");
+// 	procs->p_glMultiTexCoord4d = (PFNGLMULTITEXCOORD4DPROC)glmlGetProcAddress("glMultiTexCoord4d");
+// 	procs->p_glMultiTexCoord4dv = (PFNGLMULTITEXCOORD4DVPROC)glmlGetProcAddress("glMultiTexCoord4dv");
+// 	procs->p_glMultiTexCoord4f = (PFNGLMULTITEXCOORD4FPROC)glmlGetProcAddress("glMultiTexCoord4f");
+// 	procs->p_glMultiTexCoord4fv = (PFNGLMULTITEXCOORD4FVPROC)glmlGetProcAddress("glMultiTexCoord4fv");
+// 	procs->p_glMultiTexCoord4i = (PFNGLMULTITEXCOORD4IPROC)glmlGetProcAddress("glMultiTexCoord4i");
+// 	procs->p_glMultiTexCoord4iv = (PFNGLMULTITEXCOORD4IVPROC)glmlGetProcAddress("glMultiTexCoord4iv");
+// 	procs->p_glMultiTexCoord4s = (PFNGLMULTITEXCOORD4SPROC)glmlGetProcAddress("glMultiTexCoord4s");
+// 	procs->p_glMultiTexCoord4sv = (PFNGLMULTITEXCOORD4SVPROC)glmlGetProcAddress("glMultiTexCoord4sv");
+// 	procs->p_glLoadTransposeMatrixf = (PFNGLLOADTRANSPOSEMATRIXFPROC)glmlGetProcAddress("glLoadTransposeMatrixf");
+// 	procs->p_glLoadTransposeMatrixd = (PFNGLLOADTRANSPOSEMATRIXDPROC)glmlGetProcAddress("glLoadTransposeMatrixd");
+// 	procs->p_glMultTransposeMatrixf = (PFNGLMULTTRANSPOSEMATRIXFPROC)glmlGetProcAddress("glMultTransposeMatrixf");
+// 	procs->p_glMultTransposeMatrixd = (PFNGLMULTTRANSPOSEMATRIXDPROC)glmlGetProcAddress("glMultTransposeMatrixd");
+// 	procs->p_glBlendFuncSeparate = (PFNGLBLENDFUNCSEPARATEPROC)glmlGetProcAddress("glBlendFuncSeparate");
+// 	procs->p_glMultiDrawArrays = (PFNGLMULTIDRAWARRAYSPROC)glmlGetProcAddress("glMultiDrawArrays");
+// 	procs->p_glMultiDrawElements = (PFNGLMULTIDRAWELEMENTSPROC)glmlGetProcAddress("glMultiDrawElements");
+// 	procs->p_glPointParameterf = (PFNGLPOINTPARAMETERFPROC)glmlGetProcAddress("glPointParameterf");
+// 	procs->p_glPointParameterfv = (PFNGLPOINTPARAMETERFVPROC)glmlGetProcAddress("glPointParameterfv");
+// 	procs->p_glPointParameteri = (PFNGLPOINTPARAMETERIPROC)glmlGetProcAddress("glPointParameteri");
+// 	procs->p_glPointParameteriv = (PFNGLPOINTPARAMETERIVPROC)glmlGetProcAddress("glPointParameteriv");
+// 	procs->p_glFogCoordf = (PFNGLFOGCOORDFPROC)glmlGetProcAddress("glFogCoordf");
+// 	procs->p_glFogCoordfv = (PFNGLFOGCOORDFVPROC)glmlGetProcAddress("glFogCoordfv");
+// 	procs->p_glFogCoordd = (PFNGLFOGCOORDDPROC)glmlGetProcAddress("glFogCoordd");
+// 	procs->p_glFogCoorddv = (PFNGLFOGCOORDDVPROC)glmlGetProcAddress("glFogCoorddv");
+// 	procs->p_glFogCoordPointer = (PFNGLFOGCOORDPOINTERPROC)glmlGetProcAddress("glFogCoordPointer");
+// 	procs->p_glSecondaryColor3b = (PFNGLSECONDARYCOLOR3BPROC)glmlGetProcAddress("glSecondaryColor3b");
+// 	procs->p_glSecondaryColor3bv = (PFNGLSECONDARYCOLOR3BVPROC)glmlGetProcAddress("glSecondaryColor3bv");
+// 	procs->p_glSecondaryColor3d = (PFNGLSECONDARYCOLOR3DPROC)glmlGetProcAddress("glSecondaryColor3d");
+// 	procs->p_glSecondaryColor3dv = (PFNGLSECONDARYCOLOR3DVPROC)glmlGetProcAddress("glSecondaryColor3dv");
+// 	procs->p_glSecondaryColor3f = (PFNGLSECONDARYCOLOR3FPROC)glmlGetProcAddress("glSecondaryColor3f");
+// 	procs->p_glSecondaryColor3fv = (PFNGLSECONDARYCOLOR3FVPROC)glmlGetProcAddress("glSecondaryColor3fv");
+// 	procs->p_glSecondaryColor3i = (PFNGLSECONDARYCOLOR3IPROC)glmlGetProcAddress("glSecondaryColor3i");
+// 	procs->p_glSecondaryColor3iv = (PFNGLSECONDARYCOLOR3IVPROC)glmlGetProcAddress("glSecondaryColor3iv");
+// 	procs->p_glSecondaryColor3s = (PFNGLSECONDARYCOLOR3SPROC)glmlGetProcAddress("glSecondaryColor3s");
+// 	procs->p_glSecondaryColor3sv = (PFNGLSECONDARYCOLOR3SVPROC)glmlGetProcAddress("glSecondaryColor3sv");
+// 	procs->p_glSecondaryColor3ub = (PFNGLSECONDARYCOLOR3UBPROC)glmlGetProcAddress("glSecondaryColor3ub");
+// 	procs->p_glSecondaryColor3ubv = (PFNGLSECONDARYCOLOR3UBVPROC)glmlGetProcAddress("glSecondaryColor3ubv");
+// 	procs->p_glSecondaryColor3ui = (PFNGLSECONDARYCOLOR3UIPROC)glmlGetProcAddress("glSecondaryColor3ui");
+// 	procs->p_glSecondaryColor3uiv = (PFNGLSECONDARYCOLOR3UIVPROC)glmlGetProcAddress("glSecondaryColor3uiv");
+// 	procs->p_glSecondaryColor3us = (PFNGLSECONDARYCOLOR3USPROC)glmlGetProcAddress("glSecondaryColor3us");
+// 	procs->p_glSecondaryColor3usv = (PFNGLSECONDARYCOLOR3USVPROC)glmlGetProcAddress("glSecondaryColor3usv");
+// 	procs->p_glSecondaryColorPointer = (PFNGLSECONDARYCOLORPOINTERPROC)glmlGetProcAddress("glSecondaryColorPointer");
+// 	procs->p_glWindowPos2d = (PFNGLWINDOWPOS2DPROC)glmlGetProcAddress("glWindowPos2d");
+// 	procs->p_glWindowPos2dv = (PFNGLWINDOWPOS2DVPROC)glmlGetProcAddress("glWindowPos2dv");
+// 	procs->p_glWindowPos2f = (PFNGLWINDOWPOS2FPROC)glmlGetProcAddress("glWindowPos2f");
+// 	procs->p_glWindowPos2fv = (PFNGLWINDOWPOS2FVPROC)glmlGetProcAddress("glWindowPos2fv");
+// 	procs->p_glWindowPos2i = (PFNGLWINDOWPOS2IPROC)glmlGetProcAddress("glWindowPos2i");
+// 	procs->p_glWindowPos2iv = (PFNGLWINDOWPOS2IVPROC)glmlGetProcAddress("glWindowPos2iv");
+// 	procs->p_glWindowPos2s = (PFNGLWINDOWPOS2SPROC)glmlGetProcAddress("glWindowPos2s");
+// 	procs->p_glWindowPos2sv = (PFNGLWINDOWPOS2SVPROC)glmlGetProcAddress("glWindowPos2sv");
+// 	procs->p_glWindowPos3d = (PFNGLWINDOWPOS3DPROC)glmlGetProcAddress("glWindowPos3d");
+// 	procs->p_glWindowPos3dv = (PFNGLWINDOWPOS3DVPROC)glmlGetProcAddress("glWindowPos3dv");
+// 	procs->p_glWindowPos3f = (PFNGLWINDOWPOS3FPROC)glmlGetProcAddress("glWindowPos3f");
+// 	procs->p_glWindowPos3fv = (PFNGLWINDOWPOS3FVPROC)glmlGetProcAddress("glWindowPos3fv");
+// 	procs->p_glWindowPos3i = (PFNGLWINDOWPOS3IPROC)glmlGetProcAddress("glWindowPos3i");
+// 	procs->p_glWindowPos3iv = (PFNGLWINDOWPOS3IVPROC)glmlGetProcAddress("glWindowPos3iv");
+// 	procs->p_glWindowPos3s = (PFNGLWINDOWPOS3SPROC)glmlGetProcAddress("glWindowPos3s");
+// 	procs->p_glWindowPos3sv = (PFNGLWINDOWPOS3SVPROC)glmlGetProcAddress("glWindowPos3sv");
+// 	procs->p_glGenQueries = (PFNGLGENQUERIESPROC)glmlGetProcAddress("glGenQueries");
+// 	procs->p_glDeleteQueries = (PFNGLDELETEQUERIESPROC)glmlGetProcAddress("glDeleteQueries");
+// 	procs->p_glIsQuery = (PFNGLISQUERYPROC)glmlGetProcAddress("glIsQuery");
+// 	procs->p_glBeginQuery = (PFNGLBEGINQUERYPROC)glmlGetProcAddress("glBeginQuery");
+// 	procs->p_glEndQuery = (PFNGLENDQUERYPROC)glmlGetProcAddress("glEndQuery");
+// 	procs->p_glGetQueryiv = (PFNGLGETQUERYIVPROC)glmlGetProcAddress("glGetQueryiv");
+// 	procs->p_glGetQueryObjectiv = (PFNGLGETQUERYOBJECTIVPROC)glmlGetProcAddress("glGetQueryObjectiv");
+// 	procs->p_glGetQueryObjectuiv = (PFNGLGETQUERYOBJECTUIVPROC)glmlGetProcAddress("glGetQueryObjectuiv");
+// 	procs->p_glBindBuffer = (PFNGLBINDBUFFERPROC)glmlGetProcAddress("glBindBuffer");
+// 	procs->p_glDeleteBuffers = (PFNGLDELETEBUFFERSPROC)glmlGetProcAddress("glDeleteBuffers");
+// 	procs->p_glGenBuffers = (PFNGLGENBUFFERSPROC)glmlGetProcAddress("glGenBuffers");
+// 	procs->p_glIsBuffer = (PFNGLISBUFFERPROC)glmlGetProcAddress("glIsBuffer");
+// 	procs->p_glBufferData = (PFNGLBUFFERDATAPROC)glmlGetProcAddress("glBufferData");
+// 	procs->p_glBufferSubData = (PFNGLBUFFERSUBDATAPROC)glmlGetProcAddress("glBufferSubData");
+// 	procs->p_glGetBufferSubData = (PFNGLGETBUFFERSUBDATAPROC)glmlGetProcAddress("glGetBufferSubData");
+// 	procs->p_glMapBuffer = (PFNGLMAPBUFFERPROC)glmlGetProcAddress("glMapBuffer");
+// 	procs->p_glUnmapBuffer = (PFNGLUNMAPBUFFERPROC)glmlGetProcAddress("glUnmapBuffer");
+// 	procs->p_glGetBufferParameteriv = (PFNGLGETBUFFERPARAMETERIVPROC)glmlGetProcAddress("glGetBufferParameteriv");
+// 	procs->p_glGetBufferPointerv = (PFNGLGETBUFFERPOINTERVPROC)glmlGetProcAddress("glGetBufferPointerv");
+// 	procs->p_glBlendEquationSeparate = (PFNGLBLENDEQUATIONSEPARATEPROC)glmlGetProcAddress("glBlendEquationSeparate");
+// 	procs->p_glDrawBuffers = (PFNGLDRAWBUFFERSPROC)glmlGetProcAddress("glDrawBuffers");
+// 	procs->p_glStencilOpSeparate = (PFNGLSTENCILOPSEPARATEPROC)glmlGetProcAddress("glStencilOpSeparate");
+// 	procs->p_glStencilFuncSeparate = (PFNGLSTENCILFUNCSEPARATEPROC)glmlGetProcAddress("glStencilFuncSeparate");
+// 	procs->p_glStencilMaskSeparate = (PFNGLSTENCILMASKSEPARATEPROC)glmlGetProcAddress("glStencilMaskSeparate");
+// 	procs->p_glAttachShader = (PFNGLATTACHSHADERPROC)glmlGetProcAddress("glAttachShader");
+// 	procs->p_glBindAttribLocation = (PFNGLBINDATTRIBLOCATIONPROC)glmlGetProcAddress("glBindAttribLocation");
+// 	procs->p_glCompileShader = (PFNGLCOMPILESHADERPROC)glmlGetProcAddress("glCompileShader");
+// 	procs->p_glCreateProgram = (PFNGLCREATEPROGRAMPROC)glmlGetProcAddress("glCreateProgram");
+// 	procs->p_glCreateShader = (PFNGLCREATESHADERPROC)glmlGetProcAddress("glCreateShader");
+// 	procs->p_glDeleteProgram = (PFNGLDELETEPROGRAMPROC)glmlGetProcAddress("glDeleteProgram");
+// 	procs->p_glDeleteShader = (PFNGLDELETESHADERPROC)glmlGetProcAddress("glDeleteShader");
+// 	procs->p_glDetachShader = (PFNGLDETACHSHADERPROC)glmlGetProcAddress("glDetachShader");
+// 	procs->p_glDisableVertexAttribArray = (PFNGLDISABLEVERTEXATTRIBARRAYPROC)glmlGetProcAddress("glDisableVertexAttribArray");
+// 	procs->p_glEnableVertexAttribArray = (PFNGLENABLEVERTEXATTRIBARRAYPROC)glmlGetProcAddress("glEnableVertexAttribArray");
+// 	procs->p_glGetActiveAttrib = (PFNGLGETACTIVEATTRIBPROC)glmlGetProcAddress("glGetActiveAttrib");
+// 	procs->p_glGetActiveUniform = (PFNGLGETACTIVEUNIFORMPROC)glmlGetProcAddress("glGetActiveUniform");
+// 	procs->p_glGetAttachedShaders = (PFNGLGETATTACHEDSHADERSPROC)glmlGetProcAddress("glGetAttachedShaders");
+// 	procs->p_glGetAttribLocation = (PFNGLGETATTRIBLOCATIONPROC)glmlGetProcAddress("glGetAttribLocation");
+// 	procs->p_glGetProgramiv = (PFNGLGETPROGRAMIVPROC)glmlGetProcAddress("glGetProgramiv");
+// 	procs->p_glGetProgramInfoLog = (PFNGLGETPROGRAMINFOLOGPROC)glmlGetProcAddress("glGetProgramInfoLog");
+// 	procs->p_glGetShaderiv = (PFNGLGETSHADERIVPROC)glmlGetProcAddress("glGetShaderiv");
+// 	procs->p_glGetShaderInfoLog = (PFNGLGETSHADERINFOLOGPROC)glmlGetProcAddress("glGetShaderInfoLog");
+// 	procs->p_glGetShaderSource = (PFNGLGETSHADERSOURCEPROC)glmlGetProcAddress("glGetShaderSource");
+// 	procs->p_glGetUniformLocation = (PFNGLGETUNIFORMLOCATIONPROC)glmlGetProcAddress("glGetUniformLocation");
+// 	procs->p_glGetUniformfv = (PFNGLGETUNIFORMFVPROC)glmlGetProcAddress("glGetUniformfv");
+// 	procs->p_glGetUniformiv = (PFNGLGETUNIFORMIVPROC)glmlGetProcAddress("glGetUniformiv");
+// 	procs->p_glGetVertexAttribdv = (PFNGLGETVERTEXATTRIBDVPROC)glmlGetProcAddress("glGetVertexAttribdv");
+// 	procs->p_glGetVertexAttribfv = (PFNGLGETVERTEXATTRIBFVPROC)glmlGetProcAddress("glGetVertexAttribfv");
+// 	procs->p_glGetVertexAttribiv = (PFNGLGETVERTEXATTRIBIVPROC)glmlGetProcAddress("glGetVertexAttribiv");
+// 	procs->p_glGetVertexAttribPointerv = (PFNGLGETVERTEXATTRIBPOINTERVPROC)glmlGetProcAddress("glGetVertexAttribPointerv");
+// 	procs->p_glIsProgram = (PFNGLISPROGRAMPROC)glmlGetProcAddress("glIsProgram");
+// 	procs->p_glIsShader = (PFNGLISSHADERPROC)glmlGetProcAddress("glIsShader");
+// 	procs->p_glLinkProgram = (PFNGLLINKPROGRAMPROC)glmlGetProcAddress("glLinkProgram");
+// 	procs->p_glShaderSource = (PFNGLSHADERSOURCEPROC)glmlGetProcAddress("glShaderSource");
+// 	procs->p_glUseProgram = (PFNGLUSEPROGRAMPROC)glmlGetProcAddress("glUseProgram");
+// 	procs->p_glUniform1f = (PFNGLUNIFORM1FPROC)glmlGetProcAddress("glUniform1f");
+// 	procs->p_glUniform2f = (PFNGLUNIFORM2FPROC)glmlGetProcAddress("glUniform2f");
+// 	procs->p_glUniform3f = (PFNGLUNIFORM3FPROC)glmlGetProcAddress("glUniform3f");
+// 	procs->p_glUniform4f = (PFNGLUNIFORM4FPROC)glmlGetProcAddress("glUniform4f");
+// 	procs->p_glUniform1i = (PFNGLUNIFORM1IPROC)glmlGetProcAddress("glUniform1i");
+// 	procs->p_glUniform2i = (PFNGLUNIFORM2IPROC)glmlGetProcAddress("glUniform2i");
+// 	procs->p_glUniform3i = (PFNGLUNIFORM3IPROC)glmlGetProcAddress("glUniform3i");
+// 	procs->p_glUniform4i = (PFNGLUNIFORM4IPROC)glmlGetProcAddress("glUniform4i");
+// 	procs->p_glUniform1fv = (PFNGLUNIFORM1FVPROC)glmlGetProcAddress("glUniform1fv");
+// 	procs->p_glUniform2fv = (PFNGLUNIFORM2FVPROC)glmlGetProcAddress("glUniform2fv");
+// 	procs->p_glUniform3fv = (PFNGLUNIFORM3FVPROC)glmlGetProcAddress("glUniform3fv");
+// 	procs->p_glUniform4fv = (PFNGLUNIFORM4FVPROC)glmlGetProcAddress("glUniform4fv");
+// 	procs->p_glUniform1iv = (PFNGLUNIFORM1IVPROC)glmlGetProcAddress("glUniform1iv");
+// 	procs->p_glUniform2iv = (PFNGLUNIFORM2IVPROC)glmlGetProcAddress("glUniform2iv");
+// 	procs->p_glUniform3iv = (PFNGLUNIFORM3IVPROC)glmlGetProcAddress("glUniform3iv");
+// 	procs->p_glUniform4iv = (PFNGLUNIFORM4IVPROC)glmlGetProcAddress("glUniform4iv");
+// 	procs->p_glUniformMatrix2fv = (PFNGLUNIFORMMATRIX2FVPROC)glmlGetProcAddress("glUniformMatrix2fv");
+// 	procs->p_glUniformMatrix3fv = (PFNGLUNIFORMMATRIX3FVPROC)glmlGetProcAddress("glUniformMatrix3fv");
+// 	procs->p_glUniformMatrix4fv = (PFNGLUNIFORMMATRIX4FVPROC)glmlGetProcAddress("glUniformMatrix4fv");
+// 	procs->p_glValidateProgram = (PFNGLVALIDATEPROGRAMPROC)glmlGetProcAddress("glValidateProgram");
+// 	procs->p_glVertexAttrib1d = (PFNGLVERTEXATTRIB1DPROC)glmlGetProcAddress("glVertexAttrib1d");
+// 	procs->p_glVertexAttrib1dv = (PFNGLVERTEXATTRIB1DVPROC)glmlGetProcAddress("glVertexAttrib1dv");
+// 	procs->p_glVertexAttrib1f = (PFNGLVERTEXATTRIB1FPROC)glmlGetProcAddress("glVertexAttrib1f");
+// 	procs->p_glVertexAttrib1fv = (PFNGLVERTEXATTRIB1FVPROC)glmlGetProcAddress("glVertexAttrib1fv");
+// 	procs->p_glVertexAttrib1s = (PFNGLVERTEXATTRIB1SPROC)glmlGetProcAddress("glVertexAttrib1s");
+// 	procs->p_glVertexAttrib1sv = (PFNGLVERTEXATTRIB1SVPROC)glmlGetProcAddress("glVertexAttrib1sv");
+// 	procs->p_glVertexAttrib2d = (PFNGLVERTEXATTRIB2DPROC)glmlGetProcAddress("glVertexAttrib2d");
+// 	procs->p_glVertexAttrib2dv = (PFNGLVERTEXATTRIB2DVPROC)glmlGetProcAddress("glVertexAttrib2dv");
+// 	procs->p_glVertexAttrib2f = (PFNGLVERTEXATTRIB2FPROC)glmlGetProcAddress("glVertexAttrib2f");
+// 	procs->p_glVertexAttrib2fv = (PFNGLVERTEXATTRIB2FVPROC)glmlGetProcAddress("glVertexAttrib2fv");
+// 	procs->p_glVertexAttrib2s = (PFNGLVERTEXATTRIB2SPROC)glmlGetProcAddress("glVertexAttrib2s");
+// 	procs->p_glVertexAttrib2sv = (PFNGLVERTEXATTRIB2SVPROC)glmlGetProcAddress("glVertexAttrib2sv");
+// 	procs->p_glVertexAttrib3d = (PFNGLVERTEXATTRIB3DPROC)glmlGetProcAddress("glVertexAttrib3d");
+// 	procs->p_glVertexAttrib3dv = (PFNGLVERTEXATTRIB3DVPROC)glmlGetProcAddress("glVertexAttrib3dv");
+// 	procs->p_glVertexAttrib3f = (PFNGLVERTEXATTRIB3FPROC)glmlGetProcAddress("glVertexAttrib3f");
+// 	procs->p_glVertexAttrib3fv = (PFNGLVERTEXATTRIB3FVPROC)glmlGetProcAddress("glVertexAttrib3fv");
+// 	procs->p_glVertexAttrib3s = (PFNGLVERTEXATTRIB3SPROC)glmlGetProcAddress("glVertexAttrib3s");
+// 	procs->p_glVertexAttrib3sv = (PFNGLVERTEXATTRIB3SVPROC)glmlGetProcAddress("glVertexAttrib3sv");
+// 	procs->p_glVertexAttrib4Nbv = (PFNGLVERTEXATTRIB4NBVPROC)glmlGetProcAddress("glVertexAttrib4Nbv");
+// 	procs->p_glVertexAttrib4Niv = (PFNGLVERTEXATTRIB4NIVPROC)glmlGetProcAddress("glVertexAttrib4Niv");
+// 	procs->p_glVertexAttrib4Nsv = (PFNGLVERTEXATTRIB4NSVPROC)glmlGetProcAddress("glVertexAttrib4Nsv");
+// 	procs->p_glVertexAttrib4Nub = (PFNGLVERTEXATTRIB4NUBPROC)glmlGetProcAddress("glVertexAttrib4Nub");
+// 	procs->p_glVertexAttrib4Nubv = (PFNGLVERTEXATTRIB4NUBVPROC)glmlGetProcAddress("glVertexAttrib4Nubv");
+// 	procs->p_glVertexAttrib4Nuiv = (PFNGLVERTEXATTRIB4NUIVPROC)glmlGetProcAddress("glVertexAttrib4Nuiv");
+// 	procs->p_glVertexAttrib4Nusv = (PFNGLVERTEXATTRIB4NUSVPROC)glmlGetProcAddress("glVertexAttrib4Nusv");
+// 	procs->p_glVertexAttrib4bv = (PFNGLVERTEXATTRIB4BVPROC)glmlGetProcAddress("glVertexAttrib4bv");
+// 	procs->p_glVertexAttrib4d = (PFNGLVERTEXATTRIB4DPROC)glmlGetProcAddress("glVertexAttrib4d");
+// 	procs->p_glVertexAttrib4dv = (PFNGLVERTEXATTRIB4DVPROC)glmlGetProcAddress("glVertexAttrib4dv");
+// 	procs->p_glVertexAttrib4f = (PFNGLVERTEXATTRIB4FPROC)glmlGetProcAddress("glVertexAttrib4f");
+// 	procs->p_glVertexAttrib4fv = (PFNGLVERTEXATTRIB4FVPROC)glmlGetProcAddress("glVertexAttrib4fv");
+// 	procs->p_glVertexAttrib4iv = (PFNGLVERTEXATTRIB4IVPROC)glmlGetProcAddress("glVertexAttrib4iv");
+// 	procs->p_glVertexAttrib4s = (PFNGLVERTEXATTRIB4SPROC)glmlGetProcAddress("glVertexAttrib4s");
+// 	procs->p_glVertexAttrib4sv = (PFNGLVERTEXATTRIB4SVPROC)glmlGetProcAddress("glVertexAttrib4sv");
+// 	procs->p_glVertexAttrib4ubv = (PFNGLVERTEXATTRIB4UBVPROC)glmlGetProcAddress("glVertexAttrib4ubv");
+// 	procs->p_glVertexAttrib4uiv = (PFNGLVERTEXATTRIB4UIVPROC)glmlGetProcAddress("glVertexAttrib4uiv");
+// 	procs->p_glVertexAttrib4usv = (PFNGLVERTEXATTRIB4USVPROC)glmlGetProcAddress("glVertexAttrib4usv");
+// 	procs->p_glVertexAttribPointer = (PFNGLVERTEXATTRIBPOINTERPROC)glmlGetProcAddress("glVertexAttribPointer");
+// 	procs->p_glUniformMatrix2x3fv = (PFNGLUNIFORMMATRIX2X3FVPROC)glmlGetProcAddress("glUniformMatrix2x3fv");
+// 	procs->p_glUniformMatrix3x2fv = (PFNGLUNIFORMMATRIX3X2FVPROC)glmlGetProcAddress("glUniformMatrix3x2fv");
+// 	procs->p_glUniformMatrix2x4fv = (PFNGLUNIFORMMATRIX2X4FVPROC)glmlGetProcAddress("glUniformMatrix2x4fv");
+// 	procs->p_glUniformMatrix4x2fv = (PFNGLUNIFORMMATRIX4X2FVPROC)glmlGetProcAddress("glUniformMatrix4x2fv");
+// 	procs->p_glUniformMatrix3x4fv = (PFNGLUNIFORMMATRIX3X4FVPROC)glmlGetProcAddress("glUniformMatrix3x4fv");
+// 	procs->p_glUniformMatrix4x3fv = (PFNGLUNIFORMMATRIX4X3FVPROC)glmlGetProcAddress("glUniformMatrix4x3fv");
+// 	procs->p_glColorMaski = (PFNGLCOLORMASKIPROC)glmlGetProcAddress("glColorMaski");
+// 	procs->p_glGetBooleani_v = (PFNGLGETBOOLEANI_VPROC)glmlGetProcAddress("glGetBooleani_v");
+// 	procs->p_glGetIntegeri_v = (PFNGLGETINTEGERI_VPROC)glmlGetProcAddress("glGetIntegeri_v");
+// 	procs->p_glEnablei = (PFNGLENABLEIPROC)glmlGetProcAddress("glEnablei");
+// 	procs->p_glDisablei = (PFNGLDISABLEIPROC)glmlGetProcAddress("glDisablei");
+// 	procs->p_glIsEnabledi = (PFNGLISENABLEDIPROC)glmlGetProcAddress("glIsEnabledi");
+// 	procs->p_glBeginTransformFeedback = (PFNGLBEGINTRANSFORMFEEDBACKPROC)glmlGetProcAddress("glBeginTransformFeedback");
+// 	procs->p_glEndTransformFeedback = (PFNGLENDTRANSFORMFEEDBACKPROC)glmlGetProcAddress("glEndTransformFeedback");
+// 	procs->p_glBindBufferRange = (PFNGLBINDBUFFERRANGEPROC)glmlGetProcAddress("glBindBufferRange");
+// 	procs->p_glBindBufferBase = (PFNGLBINDBUFFERBASEPROC)glmlGetProcAddress("glBindBufferBase");
+// 	procs->p_glTransformFeedbackVaryings = (PFNGLTRANSFORMFEEDBACKVARYINGSPROC)glmlGetProcAddress("glTransformFeedbackVaryings");
+// 	procs->p_glGetTransformFeedbackVarying = (PFNGLGETTRANSFORMFEEDBACKVARYINGPROC)glmlGetProcAddress("glGetTransformFeedbackVarying");
+// 	procs->p_glClampColor = (PFNGLCLAMPCOLORPROC)glmlGetProcAddress("glClampColor");
+// 	procs->p_glBeginConditionalRender = (PFNGLBEGINCONDITIONALRENDERPROC)glmlGetProcAddress("glBeginConditionalRender");
+// 	procs->p_glEndConditionalRender = (PFNGLENDCONDITIONALRENDERPROC)glmlGetProcAddress("glEndConditionalRender");
+// 	procs->p_glVertexAttribIPointer = (PFNGLVERTEXATTRIBIPOINTERPROC)glmlGetProcAddress("glVertexAttribIPointer");
+// 	procs->p_glGetVertexAttribIiv = (PFNGLGETVERTEXATTRIBIIVPROC)glmlGetProcAddress("glGetVertexAttribIiv");
+// 	procs->p_glGetVertexAttribIuiv = (PFNGLGETVERTEXATTRIBIUIVPROC)glmlGetProcAddress("glGetVertexAttribIuiv");
+// 	procs->p_glVertexAttribI1i = (PFNGLVERTEXATTRIBI1IPROC)glmlGetProcAddress("glVertexAttribI1i");
+// 	procs->p_glVertexAttribI2i = (PFNGLVERTEXATTRIBI2IPROC)glmlGetProcAddress("glVertexAttribI2i");
+// 	procs->p_glVertexAttribI3i = (PFNGLVERTEXATTRIBI3IPROC)glmlGetProcAddress("glVertexAttribI3i");
+// 	procs->p_glVertexAttribI4i = (PFNGLVERTEXATTRIBI4IPROC)glmlGetProcAddress("glVertexAttribI4i");
+// 	procs->p_glVertexAttribI1ui = (PFNGLVERTEXATTRIBI1UIPROC)glmlGetProcAddress("glVertexAttribI1ui");
+// 	procs->p_glVertexAttribI2ui = (PFNGLVERTEXATTRIBI2UIPROC)glmlGetProcAddress("glVertexAttribI2ui");
+// 	procs->p_glVertexAttribI3ui = (PFNGLVERTEXATTRIBI3UIPROC)glmlGetProcAddress("glVertexAttribI3ui");
+// 	procs->p_glVertexAttribI4ui = (PFNGLVERTEXATTRIBI4UIPROC)glmlGetProcAddress("glVertexAttribI4ui");
+// 	procs->p_glVertexAttribI1iv = (PFNGLVERTEXATTRIBI1IVPROC)glmlGetProcAddress("glVertexAttribI1iv");
+// 	procs->p_glVertexAttribI2iv = (PFNGLVERTEXATTRIBI2IVPROC)glmlGetProcAddress("glVertexAttribI2iv");
+// 	procs->p_glVertexAttribI3iv = (PFNGLVERTEXATTRIBI3IVPROC)glmlGetProcAddress("glVertexAttribI3iv");
+// 	procs->p_glVertexAttribI4iv = (PFNGLVERTEXATTRIBI4IVPROC)glmlGetProcAddress("glVertexAttribI4iv");
+// 	procs->p_glVertexAttribI1uiv = (PFNGLVERTEXATTRIBI1UIVPROC)glmlGetProcAddress("glVertexAttribI1uiv");
+// 	procs->p_glVertexAttribI2uiv = (PFNGLVERTEXATTRIBI2UIVPROC)glmlGetProcAddress("glVertexAttribI2uiv");
+// 	procs->p_glVertexAttribI3uiv = (PFNGLVERTEXATTRIBI3UIVPROC)glmlGetProcAddress("glVertexAttribI3uiv");
+// 	procs->p_glVertexAttribI4uiv = (PFNGLVERTEXATTRIBI4UIVPROC)glmlGetProcAddress("glVertexAttribI4uiv");
+// 	procs->p_glVertexAttribI4bv = (PFNGLVERTEXATTRIBI4BVPROC)glmlGetProcAddress("glVertexAttribI4bv");
+// 	procs->p_glVertexAttribI4sv = (PFNGLVERTEXATTRIBI4SVPROC)glmlGetProcAddress("glVertexAttribI4sv");
+// 	procs->p_glVertexAttribI4ubv = (PFNGLVERTEXATTRIBI4UBVPROC)glmlGetProcAddress("glVertexAttribI4ubv");
+// 	procs->p_glVertexAttribI4usv = (PFNGLVERTEXATTRIBI4USVPROC)glmlGetProcAddress("glVertexAttribI4usv");
+// 	procs->p_glGetUniformuiv = (PFNGLGETUNIFORMUIVPROC)glmlGetProcAddress("glGetUniformuiv");
+// 	procs->p_glBindFragDataLocation = (PFNGLBINDFRAGDATALOCATIONPROC)glmlGetProcAddress("glBindFragDataLocation");
+// 	procs->p_glGetFragDataLocation = (PFNGLGETFRAGDATALOCATIONPROC)glmlGetProcAddress("glGetFragDataLocation");
+// 	procs->p_glUniform1ui = (PFNGLUNIFORM1UIPROC)glmlGetProcAddress("glUniform1ui");
+// 	procs->p_glUniform2ui = (PFNGLUNIFORM2UIPROC)glmlGetProcAddress("glUniform2ui");
+// 	procs->p_glUniform3ui = (PFNGLUNIFORM3UIPROC)glmlGetProcAddress("glUniform3ui");
+// 	procs->p_glUniform4ui = (PFNGLUNIFORM4UIPROC)glmlGetProcAddress("glUniform4ui");
+// 	procs->p_glUniform1uiv = (PFNGLUNIFORM1UIVPROC)glmlGetProcAddress("glUniform1uiv");
+// 	procs->p_glUniform2uiv = (PFNGLUNIFORM2UIVPROC)glmlGetProcAddress("glUniform2uiv");
+// 	procs->p_glUniform3uiv = (PFNGLUNIFORM3UIVPROC)glmlGetProcAddress("glUniform3uiv");
+// 	procs->p_glUniform4uiv = (PFNGLUNIFORM4UIVPROC)glmlGetProcAddress("glUniform4uiv");
+// 	procs->p_glTexParameterIiv = (PFNGLTEXPARAMETERIIVPROC)glmlGetProcAddress("glTexParameterIiv");
+// 	procs->p_glTexParameterIuiv = (PFNGLTEXPARAMETERIUIVPROC)glmlGetProcAddress("glTexParameterIuiv");
+// 	procs->p_glGetTexParameterIiv = (PFNGLGETTEXPARAMETERIIVPROC)glmlGetProcAddress("glGetTexParameterIiv");
+// 	procs->p_glGetTexParameterIuiv = (PFNGLGETTEXPARAMETERIUIVPROC)glmlGetProcAddress("glGetTexParameterIuiv");
+// 	procs->p_glClearBufferiv = (PFNGLCLEARBUFFERIVPROC)glmlGetProcAddress("glClearBufferiv");
+// 	procs->p_glClearBufferuiv = (PFNGLCLEARBUFFERUIVPROC)glmlGetProcAddress("glClearBufferuiv");
+// 	procs->p_glClearBufferfv = (PFNGLCLEARBUFFERFVPROC)glmlGetProcAddress("glClearBufferfv");
+// 	procs->p_glClearBufferfi = (PFNGLCLEARBUFFERFIPROC)glmlGetProcAddress("glClearBufferfi");
+// 	procs->p_glGetStringi = (PFNGLGETSTRINGIPROC)glmlGetProcAddress("glGetStringi");
+// 	procs->p_glIsRenderbuffer = (PFNGLISRENDERBUFFERPROC)glmlGetProcAddress("glIsRenderbuffer");
+// 	procs->p_glBindRenderbuffer = (PFNGLBINDRENDERBUFFERPROC)glmlGetProcAddress("glBindRenderbuffer");
+// 	procs->p_glDeleteRenderbuffers = (PFNGLDELETERENDERBUFFERSPROC)glmlGetProcAddress("glDeleteRenderbuffers");
+// 	procs->p_glGenRenderbuffers = (PFNGLGENRENDERBUFFERSPROC)glmlGetProcAddress("glGenRenderbuffers");
+// 	procs->p_glRenderbufferStorage = (PFNGLRENDERBUFFERSTORAGEPROC)glmlGetProcAddress("glRenderbufferStorage");
+// 	procs->p_glGetRenderbufferParameteriv = (PFNGLGETRENDERBUFFERPARAMETERIVPROC)glmlGetProcAddress("glGetRenderbufferParameteriv");
+// 	procs->p_glIsFramebuffer = (PFNGLISFRAMEBUFFERPROC)glmlGetProcAddress("glIsFramebuffer");
+// 	procs->p_glBindFramebuffer = (PFNGLBINDFRAMEBUFFERPROC)glmlGetProcAddress("glBindFramebuffer");
+// 	procs->p_glDeleteFramebuffers = (PFNGLDELETEFRAMEBUFFERSPROC)glmlGetProcAddress("glDeleteFramebuffers");
+// 	procs->p_glGenFramebuffers = (PFNGLGENFRAMEBUFFERSPROC)glmlGetProcAddress("glGenFramebuffers");
+// 	procs->p_glCheckFramebufferStatus = (PFNGLCHECKFRAMEBUFFERSTATUSPROC)glmlGetProcAddress("glCheckFramebufferStatus");
+// 	procs->p_glFramebufferTexture1D = (PFNGLFRAMEBUFFERTEXTURE1DPROC)glmlGetProcAddress("glFramebufferTexture1D");
+// 	procs->p_glFramebufferTexture2D = (PFNGLFRAMEBUFFERTEXTURE2DPROC)glmlGetProcAddress("glFramebufferTexture2D");
+// 	procs->p_glFramebufferTexture3D = (PFNGLFRAMEBUFFERTEXTURE3DPROC)glmlGetProcAddress("glFramebufferTexture3D");
+// 	procs->p_glFramebufferRenderbuffer = (PFNGLFRAMEBUFFERRENDERBUFFERPROC)glmlGetProcAddress("glFramebufferRenderbuffer");
+// 	procs->p_glGetFramebufferAttachmentParameteriv = (PFNGLGETFRAMEBUFFERATTACHMENTPARAMETERIVPROC)glmlGetProcAddress("glGetFramebufferAttachmentParameteriv");
+// 	procs->p_glGenerateMipmap = (PFNGLGENERATEMIPMAPPROC)glmlGetProcAddress("glGenerateMipmap");
+// 	procs->p_glBlitFramebuffer = (PFNGLBLITFRAMEBUFFERPROC)glmlGetProcAddress("glBlitFramebuffer");
+// 	procs->p_glRenderbufferStorageMultisample = (PFNGLRENDERBUFFERSTORAGEMULTISAMPLEPROC)glmlGetProcAddress("glRenderbufferStorageMultisample");
+// 	procs->p_glFramebufferTextureLayer = (PFNGLFRAMEBUFFERTEXTURELAYERPROC)glmlGetProcAddress("glFramebufferTextureLayer");
+// 	procs->p_glIsRenderbufferEXT = (PFNGLISRENDERBUFFEREXTPROC)glmlGetProcAddress("glIsRenderbufferEXT");
+// 	procs->p_glBindRenderbufferEXT = (PFNGLBINDRENDERBUFFEREXTPROC)glmlGetProcAddress("glBindRenderbufferEXT");
+// 	procs->p_glDeleteRenderbuffersEXT = (PFNGLDELETERENDERBUFFERSEXTPROC)glmlGetProcAddress("glDeleteRenderbuffersEXT");
+// 	procs->p_glGenRenderbuffersEXT = (PFNGLGENRENDERBUFFERSEXTPROC)glmlGetProcAddress("glGenRenderbuffersEXT");
+// 	procs->p_glRenderbufferStorageEXT = (PFNGLRENDERBUFFERSTORAGEEXTPROC)glmlGetProcAddress("glRenderbufferStorageEXT");
+// 	procs->p_glGetRenderbufferParameterivEXT = (PFNGLGETRENDERBUFFERPARAMETERIVEXTPROC)glmlGetProcAddress("glGetRenderbufferParameterivEXT");
+// 	procs->p_glIsFramebufferEXT = (PFNGLISFRAMEBUFFEREXTPROC)glmlGetProcAddress("glIsFramebufferEXT");
+// 	procs->p_glBindFramebufferEXT = (PFNGLBINDFRAMEBUFFEREXTPROC)glmlGetProcAddress("glBindFramebufferEXT");
+// 	procs->p_glDeleteFramebuffersEXT = (PFNGLDELETEFRAMEBUFFERSEXTPROC)glmlGetProcAddress("glDeleteFramebuffersEXT");
+// 	procs->p_glGenFramebuffersEXT = (PFNGLGENFRAMEBUFFERSEXTPROC)glmlGetProcAddress("glGenFramebuffersEXT");
+// 	procs->p_glCheckFramebufferStatusEXT = (PFNGLCHECKFRAMEBUFFERSTATUSEXTPROC)glmlGetProcAddress("glCheckFramebufferStatusEXT");
+// 	procs->p_glFramebufferTexture1DEXT = (PFNGLFRAMEBUFFERTEXTURE1DEXTPROC)glmlGetProcAddress("glFramebufferTexture1DEXT");
+// 	procs->p_glFramebufferTexture2DEXT = (PFNGLFRAMEBUFFERTEXTURE2DEXTPROC)glmlGetProcAddress("glFramebufferTexture2DEXT");
+// 	procs->p_glFramebufferTexture3DEXT = (PFNGLFRAMEBUFFERTEXTURE3DEXTPROC)glmlGetProcAddress("glFramebufferTexture3DEXT");
+// 	procs->p_glFramebufferRenderbufferEXT = (PFNGLFRAMEBUFFERRENDERBUFFEREXTPROC)glmlGetProcAddress("glFramebufferRenderbufferEXT");
+// 	procs->p_glGetFramebufferAttachmentParameterivEXT = (PFNGLGETFRAMEBUFFERATTACHMENTPARAMETERIVEXTPROC)glmlGetProcAddress("glGetFramebufferAttachmentParameterivEXT");
+// 	procs->p_glGenerateMipmapEXT = (PFNGLGENERATEMIPMAPEXTPROC)glmlGetProcAddress("glGenerateMipmapEXT");
+// }
+//
+// void __glBlendColor(glProcs const * procs, GLclampf red, GLclampf green, GLclampf blue, GLclampf alpha)
+// { procs->p_glBlendColor(red, green, blue, alpha); }
+//
+// void __glBlendEquation(glProcs const * procs, GLenum mode)
+// { procs->p_glBlendEquation(mode); }
+//
+// void __glDrawRangeElements(glProcs const * procs, GLenum mode, GLuint start, GLuint end, GLsizei count, GLenum type, const GLvoid * indices)
+// { procs->p_glDrawRangeElements(mode, start, end, count, type, indices); }
+//
+// void __glTexImage3D(glProcs const * procs, GLenum target, GLint level, GLint internalformat, GLsizei width, GLsizei height, GLsizei depth, GLint border, GLenum format, GLenum type, const GLvoid * pixels)
+// { procs->p_glTexImage3D(target, level, internalformat, width, height, depth, border, format, type, pixels); }
+//
+// void __glTexSubImage3D(glProcs const * procs, GLenum target, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLsizei width, GLsizei height, GLsizei depth, GLenum format, GLenum type, const GLvoid * pixels)
+// { procs->p_glTexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, type, pixels); }
+//
+// void __glCopyTexSubImage3D(glProcs const * procs, GLenum target, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLint x, GLint y, GLsizei width, GLsizei height)
+// { procs->p_glCopyTexSubImage3D(target, level, xoffset, yoffset, zoffset, x, y, width, height); }
+//
+// void __glColorTable(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLenum format, GLenum type, const GLvoid * table)
+// { procs->p_glColorTable(target, internalformat, width, format, type, table); }
+//
+// void __glColorTableParameterfv(glProcs const * procs, GLenum target, GLenum pname, const GLfloat * params)
+// { procs->p_glColorTableParameterfv(target, pname, params); }
+//
+// void __glColorTableParameteriv(glProcs const * procs, GLenum target, GLenum pname, const GLint * params)
+// { procs->p_glColorTableParameteriv(target, pname, params); }
+//
+// void __glCopyColorTable(glProcs const * procs, GLenum target, GLenum internalformat, GLint x, GLint y, GLsizei width)
+// { procs->p_glCopyColorTable(target, internalformat, x, y, width); }
+//
+// void __glGetColorTable(glProcs const * procs, GLenum target, GLenum format, GLenum type, GLvoid * table)
+// { procs->p_glGetColorTable(target, format, type, table); }
+//
+// void __glGetColorTableParameterfv(glProcs const * procs, GLenum target, GLenum pname, GLfloat * params)
+// { procs->p_glGetColorTableParameterfv(target, pname, params); }
+//
+// void __glGetColorTableParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetColorTableParameteriv(target, pname, params); }
+//
+// void __glColorSubTable(glProcs const * procs, GLenum target, GLsizei start, GLsizei count, GLenum format, GLenum type, const GLvoid * data)
+// { procs->p_glColorSubTable(target, start, count, format, type, data); }
+//
+// void __glCopyColorSubTable(glProcs const * procs, GLenum target, GLsizei start, GLint x, GLint y, GLsizei width)
+// { procs->p_glCopyColorSubTable(target, start, x, y, width); }
+//
+// void __glConvolutionFilter1D(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLenum format, GLenum type, const GLvoid * image)
+// { procs->p_glConvolutionFilter1D(target, internalformat, width, format, type, image); }
+//
+// void __glConvolutionFilter2D(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLsizei height, GLenum format, GLenum type, const GLvoid * image)
+// { procs->p_glConvolutionFilter2D(target, internalformat, width, height, format, type, image); }
+//
+// void __glConvolutionParameterf(glProcs const * procs, GLenum target, GLenum pname, GLfloat params)
+// { procs->p_glConvolutionParameterf(target, pname, params); }
+//
+// void __glConvolutionParameterfv(glProcs const * procs, GLenum target, GLenum pname, const GLfloat * params)
+// { procs->p_glConvolutionParameterfv(target, pname, params); }
+//
+// void __glConvolutionParameteri(glProcs const * procs, GLenum target, GLenum pname, GLint params)
+// { procs->p_glConvolutionParameteri(target, pname, params); }
+//
+// void __glConvolutionParameteriv(glProcs const * procs, GLenum target, GLenum pname, const GLint * params)
+// { procs->p_glConvolutionParameteriv(target, pname, params); }
+//
+// void __glCopyConvolutionFilter1D(glProcs const * procs, GLenum target, GLenum internalformat, GLint x, GLint y, GLsizei width)
+// { procs->p_glCopyConvolutionFilter1D(target, internalformat, x, y, width); }
+//
+// void __glCopyConvolutionFilter2D(glProcs const * procs, GLenum target, GLenum internalformat, GLint x, GLint y, GLsizei width, GLsizei height)
+// { procs->p_glCopyConvolutionFilter2D(target, internalformat, x, y, width, height); }
+//
+// void __glGetConvolutionFilter(glProcs const * procs, GLenum target, GLenum format, GLenum type, GLvoid * image)
+// { procs->p_glGetConvolutionFilter(target, format, type, image); }
+//
+// void __glGetConvolutionParameterfv(glProcs const * procs, GLenum target, GLenum pname, GLfloat * params)
+// { procs->p_glGetConvolutionParameterfv(target, pname, params); }
+//
+// void __glGetConvolutionParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetConvolutionParameteriv(target, pname, params); }
+//
+// void __glGetSeparableFilter(glProcs const * procs, GLenum target, GLenum format, GLenum type, GLvoid * row, GLvoid * column, GLvoid * span)
+// { procs->p_glGetSeparableFilter(target, format, type, row, column, span); }
+//
+// void __glSeparableFilter2D(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLsizei height, GLenum format, GLenum type, const GLvoid * row, const GLvoid * column)
+// { procs->p_glSeparableFilter2D(target, internalformat, width, height, format, type, row, column); }
+//
+// void __glGetHistogram(glProcs const * procs, GLenum target, GLboolean reset, GLenum format, GLenum type, GLvoid * values)
+// { procs->p_glGetHistogram(target, reset, format, type, values); }
+//
+// void __glGetHistogramParameterfv(glProcs const * procs, GLenum target, GLenum pname, GLfloat * params)
+// { procs->p_glGetHistogramParameterfv(target, pname, params); }
+//
+// void __glGetHistogramParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetHistogramParameteriv(target, pname, params); }
+//
+// void __glGetMinmax(glProcs const * procs, GLenum target, GLboolean reset, GLenum format, GLenum type, GLvoid * values)
+// { procs->p_glGetMinmax(target, reset, format, type, values); }
+//
+// void __glGetMinmaxParameterfv(glProcs const * procs, GLenum target, GLenum pname, GLfloat * params)
+// { procs->p_glGetMinmaxParameterfv(target, pname, params); }
+//
+// void __glGetMinmaxParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetMinmaxParameteriv(target, pname, params); }
+//
+// void __glHistogram(glProcs const * procs, GLenum target, GLsizei width, GLenum internalformat, GLboolean sink)
+// { procs->p_glHistogram(target, width, internalformat, sink); }
+//
+// void __glMinmax(glProcs const * procs, GLenum target, GLenum internalformat, GLboolean sink)
+// { procs->p_glMinmax(target, internalformat, sink); }
+//
+// void __glResetHistogram(glProcs const * procs, GLenum target)
+// { procs->p_glResetHistogram(target); }
+//
+// void __glResetMinmax(glProcs const * procs, GLenum target)
+// { procs->p_glResetMinmax(target); }
+//
+// void __glActiveTexture(glProcs const * procs, GLenum texture)
+// { procs->p_glActiveTexture(texture); }
+//
+// void __glSampleCoverage(glProcs const * procs, GLclampf value, GLboolean invert)
+// { procs->p_glSampleCoverage(value, invert); }
+//
+// void __glCompressedTexImage3D(glProcs const * procs, GLenum target, GLint level, GLenum internalformat, GLsizei width, GLsizei height, GLsizei depth, GLint border, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexImage3D(target, level, internalformat, width, height, depth, border, imageSize, data); }
+//
+// void __glCompressedTexImage2D(glProcs const * procs, GLenum target, GLint level, GLenum internalformat, GLsizei width, GLsizei height, GLint border, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexImage2D(target, level, internalformat, width, height, border, imageSize, data); }
+//
+// void __glCompressedTexImage1D(glProcs const * procs, GLenum target, GLint level, GLenum internalformat, GLsizei width, GLint border, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexImage1D(target, level, internalformat, width, border, imageSize, data); }
+//
+// void __glCompressedTexSubImage3D(glProcs const * procs, GLenum target, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLsizei width, GLsizei height, GLsizei depth, GLenum format, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data); }
+//
+// void __glCompressedTexSubImage2D(glProcs const * procs, GLenum target, GLint level, GLint xoffset, GLint yoffset, GLsizei width, GLsizei height, GLenum format, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexSubImage2D(target, level, xoffset, yoffset, width, height, format, imageSize, data); }
+//
+// void __glCompressedTexSubImage1D(glProcs const * procs, GLenum target, GLint level, GLint xoffset, GLsizei width, GLenum format, GLsizei imageSize, const GLvoid * data)
+// { procs->p_glCompressedTexSubImage1D(target, level, xoffset, width, format, imageSize, data); }
+//
+// void __glGetCompressedTexImage(glProcs const * procs, GLenum target, GLint level, GLvoid * img)
+// { procs->p_glGetCompressedTexImage(target, level, img); }
+//
+// void __glClientActiveTexture(glProcs const * procs, GLenum texture)
+// { procs->p_glClientActiveTexture(texture); }
+//
+// void __glMultiTexCoord1d(glProcs const * procs, GLenum target, GLdouble s)
+// { procs->p_glMultiTexCoord1d(target, s); }
+//
+// void __glMultiTexCoord1dv(glProcs const * procs, GLenum target, const GLdouble * v)
+// { procs->p_glMultiTexCoord1dv(target, v); }
+//
+// void __glMultiTexCoord1f(glProcs const * procs, GLenum target, GLfloat s)
+// { procs->p_glMultiTexCoord1f(target, s); }
+//
+// void __glMultiTexCoord1fv(glProcs const * procs, GLenum target, const GLfloat * v)
+// { procs->p_glMultiTexCoord1fv(target, v); }
+//
+// void __glMultiTexCoord1i(glProcs const * procs, GLenum target, GLint s)
+// { procs->p_glMultiTexCoord1i(target, s); }
+//
+// void __glMultiTexCoord1iv(glProcs const * procs, GLenum target, const GLint * v)
+// { procs->p_glMultiTexCoord1iv(target, v); }
+//
+// void __glMultiTexCoord1s(glProcs const * procs, GLenum target, GLshort s)
+// { procs->p_glMultiTexCoord1s(target, s); }
+//
+// void __glMultiTexCoord1sv(glProcs const * procs, GLenum target, const GLshort * v)
+// { procs->p_glMultiTexCoord1sv(target, v); }
+//
+// void __glMultiTexCoord2d(glProcs const * procs, GLenum target, GLdouble s, GLdouble t)
+// { procs->p_glMultiTexCoord2d(target, s, t); }
+//
+// void __glMultiTexCoord2dv(glProcs const * procs, GLenum target, const GLdouble * v)
+// { procs->p_glMultiTexCoord2dv(target, v); }
+//
+// void __glMultiTexCoord2f(glProcs const * procs, GLenum target, GLfloat s, GLfloat t)
+// { procs->p_glMultiTexCoord2f(target, s, t); }
+//
+// void __glMultiTexCoord2fv(glProcs const * procs, GLenum target, const GLfloat * v)
+// { procs->p_glMultiTexCoord2fv(target, v); }
+//
+// void __glMultiTexCoord2i(glProcs const * procs, GLenum target, GLint s, GLint t)
+// { procs->p_glMultiTexCoord2i(target, s, t); }
+//
+// void __glMultiTexCoord2iv(glProcs const * procs, GLenum target, const GLint * v)
+// { procs->p_glMultiTexCoord2iv(target, v); }
+//
+// void __glMultiTexCoord2s(glProcs const * procs, GLenum target, GLshort s, GLshort t)
+// { procs->p_glMultiTexCoord2s(target, s, t); }
+//
+// void __glMultiTexCoord2sv(glProcs const * procs, GLenum target, const GLshort * v)
+// { procs->p_glMultiTexCoord2sv(target, v); }
+//
+// void __glMultiTexCoord3d(glProcs const * procs, GLenum target, GLdouble s, GLdouble t, GLdouble r)
+// { procs->p_glMultiTexCoord3d(target, s, t, r); }
+//
+// void __glMultiTexCoord3dv(glProcs const * procs, GLenum target, const GLdouble * v)
+// { procs->p_glMultiTexCoord3dv(target, v); }
+//
+// void __glMultiTexCoord3f(glProcs const * procs, GLenum target, GLfloat s, GLfloat t, GLfloat r)
+// { procs->p_glMultiTexCoord3f(target, s, t, r); }
+//
+// void __glMultiTexCoord3fv(glProcs const * procs, GLenum target, const GLfloat * v)
+// { procs->p_glMultiTexCoord3fv(target, v); }
+//
+// void __glMultiTexCoord3i(glProcs const * procs, GLenum target, GLint s, GLint t, GLint r)
+// { procs->p_glMultiTexCoord3i(target, s, t, r); }
+//
+// void __glMultiTexCoord3iv(glProcs const * procs, GLenum target, const GLint * v)
+// { procs->p_glMultiTexCoord3iv(target, v); }
+//
+// void __glMultiTexCoord3s(glProcs const * procs, GLenum target, GLshort s, GLshort t, GLshort r)
+// { procs->p_glMultiTexCoord3s(target, s, t, r); }
+//
+// void __glMultiTexCoord3sv(glProcs const * procs, GLenum target, const GLshort * v)
+// { procs->p_glMultiTexCoord3sv(target, v); }
+//
+// void __glMultiTexCoord4d(glProcs const * procs, GLenum target, GLdouble s, GLdouble t, GLdouble r, GLdouble q)
+// { procs->p_glMultiTexCoord4d(target, s, t, r, q); }
+//
+// void __glMultiTexCoord4dv(glProcs const * procs, GLenum target, const GLdouble * v)
+// { procs->p_glMultiTexCoord4dv(target, v); }
+//
+// void __glMultiTexCoord4f(glProcs const * procs, GLenum target, GLfloat s, GLfloat t, GLfloat r, GLfloat q)
+// { procs->p_glMultiTexCoord4f(target, s, t, r, q); }
+//
+// void __glMultiTexCoord4fv(glProcs const * procs, GLenum target, const GLfloat * v)
+// { procs->p_glMultiTexCoord4fv(target, v); }
+//
+// void __glMultiTexCoord4i(glProcs const * procs, GLenum target, GLint s, GLint t, GLint r, GLint q)
+// { procs->p_glMultiTexCoord4i(target, s, t, r, q); }
+//
+// void __glMultiTexCoord4iv(glProcs const * procs, GLenum target, const GLint * v)
+// { procs->p_glMultiTexCoord4iv(target, v); }
+//
+// void __glMultiTexCoord4s(glProcs const * procs, GLenum target, GLshort s, GLshort t, GLshort r, GLshort q)
+// { procs->p_glMultiTexCoord4s(target, s, t, r, q); }
+//
+// void __glMultiTexCoord4sv(glProcs const * procs, GLenum target, const GLshort * v)
+// { procs->p_glMultiTexCoord4sv(target, v); }
+//
+// void __glLoadTransposeMatrixf(glProcs const * procs, const GLfloat * m)
+// { procs->p_glLoadTransposeMatrixf(m); }
+//
+// void __glLoadTransposeMatrixd(glProcs const * procs, const GLdouble * m)
+// { procs->p_glLoadTransposeMatrixd(m); }
+//
+// void __glMultTransposeMatrixf(glProcs const * procs, const GLfloat * m)
+// { procs->p_glMultTransposeMatrixf(m); }
+//
+// void __glMultTransposeMatrixd(glProcs const * procs, const GLdouble * m)
+// { procs->p_glMultTransposeMatrixd(m); }
+//
+// void __glBlendFuncSeparate(glProcs const * procs, GLenum sfactorRGB, GLenum dfactorRGB, GLenum sfactorAlpha, GLenum dfactorAlpha)
+// { procs->p_glBlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha); }
+//
+// void __glMultiDrawArrays(glProcs const * procs, GLenum mode, const GLint * first, const GLsizei * count, GLsizei primcount)
+// { procs->p_glMultiDrawArrays(mode, first, count, primcount); }
+//
+// void __glMultiDrawElements(glProcs const * procs, GLenum mode, const GLsizei * count, GLenum type, const GLvoid* * indices, GLsizei primcount)
+// { procs->p_glMultiDrawElements(mode, count, type, indices, primcount); }
+//
+// void __glPointParameterf(glProcs const * procs, GLenum pname, GLfloat param)
+// { procs->p_glPointParameterf(pname, param); }
+//
+// void __glPointParameterfv(glProcs const * procs, GLenum pname, const GLfloat * params)
+// { procs->p_glPointParameterfv(pname, params); }
+//
+// void __glPointParameteri(glProcs const * procs, GLenum pname, GLint param)
+// { procs->p_glPointParameteri(pname, param); }
+//
+// void __glPointParameteriv(glProcs const * procs, GLenum pname, const GLint * params)
+// { procs->p_glPointParameteriv(pname, params); }
+//
+// void __glFogCoordf(glProcs const * procs, GLfloat coord)
+// { procs->p_glFogCoordf(coord); }
+//
+// void __glFogCoordfv(glProcs const * procs, const GLfloat * coord)
+// { procs->p_glFogCoordfv(coord); }
+//
+// void __glFogCoordd(glProcs const * procs, GLdouble coord)
+// { procs->p_glFogCoordd(coord); }
+//
+// void __glFogCoorddv(glProcs const * procs, const GLdouble * coord)
+// { procs->p_glFogCoorddv(coord); }
+//
+// void __glFogCoordPointer(glProcs const * procs, GLenum type, GLsizei stride, const GLvoid * pointer)
+// { procs->p_glFogCoordPointer(type, stride, pointer); }
+//
+// void __glSecondaryColor3b(glProcs const * procs, GLbyte red, GLbyte green, GLbyte blue)
+// { procs->p_glSecondaryColor3b(red, green, blue); }
+//
+// void __glSecondaryColor3bv(glProcs const * procs, const GLbyte * v)
+// { procs->p_glSecondaryColor3bv(v); }
+//
+// void __glSecondaryColor3d(glProcs const * procs, GLdouble red, GLdouble green, GLdouble blue)
+// { procs->p_glSecondaryColor3d(red, green, blue); }
+//
+// void __glSecondaryColor3dv(glProcs const * procs, const GLdouble * v)
+// { procs->p_glSecondaryColor3dv(v); }
+//
+// void __glSecondaryColor3f(glProcs const * procs, GLfloat red, GLfloat green, GLfloat blue)
+// { procs->p_glSecondaryColor3f(red, green, blue); }
+//
+// void __glSecondaryColor3fv(glProcs const * procs, const GLfloat * v)
+// { procs->p_glSecondaryColor3fv(v); }
+//
+// void __glSecondaryColor3i(glProcs const * procs, GLint red, GLint green, GLint blue)
+// { procs->p_glSecondaryColor3i(red, green, blue); }
+//
+// void __glSecondaryColor3iv(glProcs const * procs, const GLint * v)
+// { procs->p_glSecondaryColor3iv(v); }
+//
+// void __glSecondaryColor3s(glProcs const * procs, GLshort red, GLshort green, GLshort blue)
+// { procs->p_glSecondaryColor3s(red, green, blue); }
+//
+// void __glSecondaryColor3sv(glProcs const * procs, const GLshort * v)
+// { procs->p_glSecondaryColor3sv(v); }
+//
+// void __glSecondaryColor3ub(glProcs const * procs, GLubyte red, GLubyte green, GLubyte blue)
+// { procs->p_glSecondaryColor3ub(red, green, blue); }
+//
+// void __glSecondaryColor3ubv(glProcs const * procs, const GLubyte * v)
+// { procs->p_glSecondaryColor3ubv(v); }
+//
+// void __glSecondaryColor3ui(glProcs const * procs, GLuint red, GLuint green, GLuint blue)
+// { procs->p_glSecondaryColor3ui(red, green, blue); }
+//
+// void __glSecondaryColor3uiv(glProcs const * procs, const GLuint * v)
+// { procs->p_glSecondaryColor3uiv(v); }
+//
+// void __glSecondaryColor3us(glProcs const * procs, GLushort red, GLushort green, GLushort blue)
+// { procs->p_glSecondaryColor3us(red, green, blue); }
+//
+// void __glSecondaryColor3usv(glProcs const * procs, const GLushort * v)
+// { procs->p_glSecondaryColor3usv(v); }
+//
+// void __glSecondaryColorPointer(glProcs const * procs, GLint size, GLenum type, GLsizei stride, const GLvoid * pointer)
+// { procs->p_glSecondaryColorPointer(size, type, stride, pointer); }
+//
+// void __glWindowPos2d(glProcs const * procs, GLdouble x, GLdouble y)
+// { procs->p_glWindowPos2d(x, y); }
+//
+// void __glWindowPos2dv(glProcs const * procs, const GLdouble * v)
+// { procs->p_glWindowPos2dv(v); }
+//
+// void __glWindowPos2f(glProcs const * procs, GLfloat x, GLfloat y)
+// { procs->p_glWindowPos2f(x, y); }
+//
+// void __glWindowPos2fv(glProcs const * procs, const GLfloat * v)
+// { procs->p_glWindowPos2fv(v); }
+//
+// void __glWindowPos2i(glProcs const * procs, GLint x, GLint y)
+// { procs->p_glWindowPos2i(x, y); }
+//
+// void __glWindowPos2iv(glProcs const * procs, const GLint * v)
+// { procs->p_glWindowPos2iv(v); }
+//
+// void __glWindowPos2s(glProcs const * procs, GLshort x, GLshort y)
+// { procs->p_glWindowPos2s(x, y); }
+//
+// void __glWindowPos2sv(glProcs const * procs, const GLshort * v)
+// { procs->p_glWindowPos2sv(v); }
+//
+// void __glWindowPos3d(glProcs const * procs, GLdouble x, GLdouble y, GLdouble z)
+// { procs->p_glWindowPos3d(x, y, z); }
+//
+// void __glWindowPos3dv(glProcs const * procs, const GLdouble * v)
+// { procs->p_glWindowPos3dv(v); }
+//
+// void __glWindowPos3f(glProcs const * procs, GLfloat x, GLfloat y, GLfloat z)
+// { procs->p_glWindowPos3f(x, y, z); }
+//
+// void __glWindowPos3fv(glProcs const * procs, const GLfloat * v)
+// { procs->p_glWindowPos3fv(v); }
+//
+// void __glWindowPos3i(glProcs const * procs, GLint x, GLint y, GLint z)
+// { procs->p_glWindowPos3i(x, y, z); }
+//
+// void __glWindowPos3iv(glProcs const * procs, const GLint * v)
+// { procs->p_glWindowPos3iv(v); }
+//
+// void __glWindowPos3s(glProcs const * procs, GLshort x, GLshort y, GLshort z)
+// { procs->p_glWindowPos3s(x, y, z); }
+//
+// void __glWindowPos3sv(glProcs const * procs, const GLshort * v)
+// { procs->p_glWindowPos3sv(v); }
+//
+// void __glGenQueries(glProcs const * procs, GLsizei n, GLuint * ids)
+// { procs->p_glGenQueries(n, ids); }
+//
+// void __glDeleteQueries(glProcs const * procs, GLsizei n, const GLuint * ids)
+// { procs->p_glDeleteQueries(n, ids); }
+//
+// GLboolean __glIsQuery(glProcs const * procs, GLuint id)
+// { return procs->p_glIsQuery(id); }
+//
+// void __glBeginQuery(glProcs const * procs, GLenum target, GLuint id)
+// { procs->p_glBeginQuery(target, id); }
+//
+// void __glEndQuery(glProcs const * procs, GLenum target)
+// { procs->p_glEndQuery(target); }
+//
+// void __glGetQueryiv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetQueryiv(target, pname, params); }
+//
+// void __glGetQueryObjectiv(glProcs const * procs, GLuint id, GLenum pname, GLint * params)
+// { procs->p_glGetQueryObjectiv(id, pname, params); }
+//
+// void __glGetQueryObjectuiv(glProcs const * procs, GLuint id, GLenum pname, GLuint * params)
+// { procs->p_glGetQueryObjectuiv(id, pname, params); }
+//
+// void __glBindBuffer(glProcs const * procs, GLenum target, GLuint buffer)
+// { procs->p_glBindBuffer(target, buffer); }
+//
+// void __glDeleteBuffers(glProcs const * procs, GLsizei n, const GLuint * buffers)
+// { procs->p_glDeleteBuffers(n, buffers); }
+//
+// void __glGenBuffers(glProcs const * procs, GLsizei n, GLuint * buffers)
+// { procs->p_glGenBuffers(n, buffers); }
+//
+// GLboolean __glIsBuffer(glProcs const * procs, GLuint buffer)
+// { return procs->p_glIsBuffer(buffer); }
+//
+// void __glBufferData(glProcs const * procs, GLenum target, GLsizeiptr size, const GLvoid * data, GLenum usage)
+// { procs->p_glBufferData(target, size, data, usage); }
+//
+// void __glBufferSubData(glProcs const * procs, GLenum target, GLintptr offset, GLsizeiptr size, const GLvoid * data)
+// { procs->p_glBufferSubData(target, offset, size, data); }
+//
+// void __glGetBufferSubData(glProcs const * procs, GLenum target, GLintptr offset, GLsizeiptr size, GLvoid * data)
+// { procs->p_glGetBufferSubData(target, offset, size, data); }
+//
+// GLvoid* __glMapBuffer(glProcs const * procs, GLenum target, GLenum access)
+// { return procs->p_glMapBuffer(target, access); }
+//
+// GLboolean __glUnmapBuffer(glProcs const * procs, GLenum target)
+// { return procs->p_glUnmapBuffer(target); }
+//
+// void __glGetBufferParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetBufferParameteriv(target, pname, params); }
+//
+// void __glGetBufferPointerv(glProcs const * procs, GLenum target, GLenum pname, GLvoid* * params)
+// { procs->p_glGetBufferPointerv(target, pname, params); }
+//
+// void __glBlendEquationSeparate(glProcs const * procs, GLenum modeRGB, GLenum modeAlpha)
+// { procs->p_glBlendEquationSeparate(modeRGB, modeAlpha); }
+//
+// void __glDrawBuffers(glProcs const * procs, GLsizei n, const GLenum * bufs)
+// { procs->p_glDrawBuffers(n, bufs); }
+//
+// void __glStencilOpSeparate(glProcs const * procs, GLenum face, GLenum sfail, GLenum dpfail, GLenum dppass)
+// { procs->p_glStencilOpSeparate(face, sfail, dpfail, dppass); }
+//
+// void __glStencilFuncSeparate(glProcs const * procs, GLenum face, GLenum func, GLint ref, GLuint mask)
+// { procs->p_glStencilFuncSeparate(face, func, ref, mask); }
+//
+// void __glStencilMaskSeparate(glProcs const * procs, GLenum face, GLuint mask)
+// { procs->p_glStencilMaskSeparate(face, mask); }
+//
+// void __glAttachShader(glProcs const * procs, GLuint program, GLuint shader)
+// { procs->p_glAttachShader(program, shader); }
+//
+// void __glBindAttribLocation(glProcs const * procs, GLuint program, GLuint index, const GLchar * name)
+// { procs->p_glBindAttribLocation(program, index, name); }
+//
+// void __glCompileShader(glProcs const * procs, GLuint shader)
+// { procs->p_glCompileShader(shader); }
+//
+// GLuint __glCreateProgram(glProcs const * procs)
+// { return procs->p_glCreateProgram(); }
+//
+// GLuint __glCreateShader(glProcs const * procs, GLenum type)
+// { return procs->p_glCreateShader(type); }
+//
+// void __glDeleteProgram(glProcs const * procs, GLuint program)
+// { procs->p_glDeleteProgram(program); }
+//
+// void __glDeleteShader(glProcs const * procs, GLuint shader)
+// { procs->p_glDeleteShader(shader); }
+//
+// void __glDetachShader(glProcs const * procs, GLuint program, GLuint shader)
+// { procs->p_glDetachShader(program, shader); }
+//
+// void __glDisableVertexAttribArray(glProcs const * procs, GLuint index)
+// { procs->p_glDisableVertexAttribArray(index); }
+//
+// void __glEnableVertexAttribArray(glProcs const * procs, GLuint index)
+// { procs->p_glEnableVertexAttribArray(index); }
+//
+// void __glGetActiveAttrib(glProcs const * procs, GLuint program, GLuint index, GLsizei bufSize, GLsizei * length, GLint * size, GLenum * type, GLchar * name)
+// { procs->p_glGetActiveAttrib(program, index, bufSize, length, size, type, name); }
+//
+// void __glGetActiveUniform(glProcs const * procs, GLuint program, GLuint index, GLsizei bufSize, GLsizei * length, GLint * size, GLenum * type, GLchar * name)
+// { procs->p_glGetActiveUniform(program, index, bufSize, length, size, type, name); }
+//
+// void __glGetAttachedShaders(glProcs const * procs, GLuint program, GLsizei maxCount, GLsizei * count, GLuint * obj)
+// { procs->p_glGetAttachedShaders(program, maxCount, count, obj); }
+//
+// GLint __glGetAttribLocation(glProcs const * procs, GLuint program, const GLchar * name)
+// { return procs->p_glGetAttribLocation(program, name); }
+//
+// void __glGetProgramiv(glProcs const * procs, GLuint program, GLenum pname, GLint * params)
+// { procs->p_glGetProgramiv(program, pname, params); }
+//
+// void __glGetProgramInfoLog(glProcs const * procs, GLuint program, GLsizei bufSize, GLsizei * length, GLchar * infoLog)
+// { procs->p_glGetProgramInfoLog(program, bufSize, length, infoLog); }
+//
+// void __glGetShaderiv(glProcs const * procs, GLuint shader, GLenum pname, GLint * params)
+// { procs->p_glGetShaderiv(shader, pname, params); }
+//
+// void __glGetShaderInfoLog(glProcs const * procs, GLuint shader, GLsizei bufSize, GLsizei * length, GLchar * infoLog)
+// { procs->p_glGetShaderInfoLog(shader, bufSize, length, infoLog); }
+//
+// void __glGetShaderSource(glProcs const * procs, GLuint shader, GLsizei bufSize, GLsizei * length, GLchar * source)
+// { procs->p_glGetShaderSource(shader, bufSize, length, source); }
+//
+// GLint __glGetUniformLocation(glProcs const * procs, GLuint program, const GLchar * name)
+// { return procs->p_glGetUniformLocation(program, name); }
+//
+// void __glGetUniformfv(glProcs const * procs, GLuint program, GLint location, GLfloat * params)
+// { procs->p_glGetUniformfv(program, location, params); }
+//
+// void __glGetUniformiv(glProcs const * procs, GLuint program, GLint location, GLint * params)
+// { procs->p_glGetUniformiv(program, location, params); }
+//
+// void __glGetVertexAttribdv(glProcs const * procs, GLuint index, GLenum pname, GLdouble * params)
+// { procs->p_glGetVertexAttribdv(index, pname, params); }
+//
+// void __glGetVertexAttribfv(glProcs const * procs, GLuint index, GLenum pname, GLfloat * params)
+// { procs->p_glGetVertexAttribfv(index, pname, params); }
+//
+// void __glGetVertexAttribiv(glProcs const * procs, GLuint index, GLenum pname, GLint * params)
+// { procs->p_glGetVertexAttribiv(index, pname, params); }
+//
+// void __glGetVertexAttribPointerv(glProcs const * procs, GLuint index, GLenum pname, GLvoid* * pointer)
+// { procs->p_glGetVertexAttribPointerv(index, pname, pointer); }
+//
+// GLboolean __glIsProgram(glProcs const * procs, GLuint program)
+// { return procs->p_glIsProgram(program); }
+//
+// GLboolean __glIsShader(glProcs const * procs, GLuint shader)
+// { return procs->p_glIsShader(shader); }
+//
+// void __glLinkProgram(glProcs const * procs, GLuint program)
+// { procs->p_glLinkProgram(program); }
+//
+// void __glShaderSource(glProcs const * procs, GLuint shader, GLsizei count, const GLchar* * string, const GLint * length)
+// { procs->p_glShaderSource(shader, count, string, length); }
+//
+// void __glUseProgram(glProcs const * procs, GLuint program)
+// { procs->p_glUseProgram(program); }
+//
+// void __glUniform1f(glProcs const * procs, GLint location, GLfloat v0)
+// { procs->p_glUniform1f(location, v0); }
+//
+// void __glUniform2f(glProcs const * procs, GLint location, GLfloat v0, GLfloat v1)
+// { procs->p_glUniform2f(location, v0, v1); }
+//
+// void __glUniform3f(glProcs const * procs, GLint location, GLfloat v0, GLfloat v1, GLfloat v2)
+// { procs->p_glUniform3f(location, v0, v1, v2); }
+//
+// void __glUniform4f(glProcs const * procs, GLint location, GLfloat v0, GLfloat v1, GLfloat v2, GLfloat v3)
+// { procs->p_glUniform4f(location, v0, v1, v2, v3); }
+//
+// void __glUniform1i(glProcs const * procs, GLint location, GLint v0)
+// { procs->p_glUniform1i(location, v0); }
+//
+// void __glUniform2i(glProcs const * procs, GLint location, GLint v0, GLint v1)
+// { procs->p_glUniform2i(location, v0, v1); }
+//
+// void __glUniform3i(glProcs const * procs, GLint location, GLint v0, GLint v1, GLint v2)
+// { procs->p_glUniform3i(location, v0, v1, v2); }
+//
+// void __glUniform4i(glProcs const * procs, GLint location, GLint v0, GLint v1, GLint v2, GLint v3)
+// { procs->p_glUniform4i(location, v0, v1, v2, v3); }
+//
+// void __glUniform1fv(glProcs const * procs, GLint location, GLsizei count, const GLfloat * value)
+// { procs->p_glUniform1fv(location, count, value); }
+//
+// void __glUniform2fv(glProcs const * procs, GLint location, GLsizei count, const GLfloat * value)
+// { procs->p_glUniform2fv(location, count, value); }
+//
+// void __glUniform3fv(glProcs const * procs, GLint location, GLsizei count, const GLfloat * value)
+// { procs->p_glUniform3fv(location, count, value); }
+//
+// void __glUniform4fv(glProcs const * procs, GLint location, GLsizei count, const GLfloat * value)
+// { procs->p_glUniform4fv(location, count, value); }
+//
+// void __glUniform1iv(glProcs const * procs, GLint location, GLsizei count, const GLint * value)
+// { procs->p_glUniform1iv(location, count, value); }
+//
+// void __glUniform2iv(glProcs const * procs, GLint location, GLsizei count, const GLint * value)
+// { procs->p_glUniform2iv(location, count, value); }
+//
+// void __glUniform3iv(glProcs const * procs, GLint location, GLsizei count, const GLint * value)
+// { procs->p_glUniform3iv(location, count, value); }
+//
+// void __glUniform4iv(glProcs const * procs, GLint location, GLsizei count, const GLint * value)
+// { procs->p_glUniform4iv(location, count, value); }
+//
+// void __glUniformMatrix2fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix2fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix3fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix3fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix4fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix4fv(location, count, transpose, value); }
+//
+// void __glValidateProgram(glProcs const * procs, GLuint program)
+// { procs->p_glValidateProgram(program); }
+//
+// void __glVertexAttrib1d(glProcs const * procs, GLuint index, GLdouble x)
+// { procs->p_glVertexAttrib1d(index, x); }
+//
+// void __glVertexAttrib1dv(glProcs const * procs, GLuint index, const GLdouble * v)
+// { procs->p_glVertexAttrib1dv(index, v); }
+//
+// void __glVertexAttrib1f(glProcs const * procs, GLuint index, GLfloat x)
+// { procs->p_glVertexAttrib1f(index, x); }
+//
+// void __glVertexAttrib1fv(glProcs const * procs, GLuint index, const GLfloat * v)
+// { procs->p_glVertexAttrib1fv(index, v); }
+//
+// void __glVertexAttrib1s(glProcs const * procs, GLuint index, GLshort x)
+// { procs->p_glVertexAttrib1s(index, x); }
+//
+// void __glVertexAttrib1sv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttrib1sv(index, v); }
+//
+// void __glVertexAttrib2d(glProcs const * procs, GLuint index, GLdouble x, GLdouble y)
+// { procs->p_glVertexAttrib2d(index, x, y); }
+//
+// void __glVertexAttrib2dv(glProcs const * procs, GLuint index, const GLdouble * v)
+// { procs->p_glVertexAttrib2dv(index, v); }
+//
+// void __glVertexAttrib2f(glProcs const * procs, GLuint index, GLfloat x, GLfloat y)
+// { procs->p_glVertexAttrib2f(index, x, y); }
+//
+// void __glVertexAttrib2fv(glProcs const * procs, GLuint index, const GLfloat * v)
+// { procs->p_glVertexAttrib2fv(index, v); }
+//
+// void __glVertexAttrib2s(glProcs const * procs, GLuint index, GLshort x, GLshort y)
+// { procs->p_glVertexAttrib2s(index, x, y); }
+//
+// void __glVertexAttrib2sv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttrib2sv(index, v); }
+//
+// void __glVertexAttrib3d(glProcs const * procs, GLuint index, GLdouble x, GLdouble y, GLdouble z)
+// { procs->p_glVertexAttrib3d(index, x, y, z); }
+//
+// void __glVertexAttrib3dv(glProcs const * procs, GLuint index, const GLdouble * v)
+// { procs->p_glVertexAttrib3dv(index, v); }
+//
+// void __glVertexAttrib3f(glProcs const * procs, GLuint index, GLfloat x, GLfloat y, GLfloat z)
+// { procs->p_glVertexAttrib3f(index, x, y, z); }
+//
+// void __glVertexAttrib3fv(glProcs const * procs, GLuint index, const GLfloat * v)
+// { procs->p_glVertexAttrib3fv(index, v); }
+//
+// void __glVertexAttrib3s(glProcs const * procs, GLuint index, GLshort x, GLshort y, GLshort z)
+// { procs->p_glVertexAttrib3s(index, x, y, z); }
+//
+// void __glVertexAttrib3sv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttrib3sv(index, v); }
+//
+// void __glVertexAttrib4Nbv(glProcs const * procs, GLuint index, const GLbyte * v)
+// { procs->p_glVertexAttrib4Nbv(index, v); }
+//
+// void __glVertexAttrib4Niv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttrib4Niv(index, v); }
+//
+// void __glVertexAttrib4Nsv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttrib4Nsv(index, v); }
+//
+// void __glVertexAttrib4Nub(glProcs const * procs, GLuint index, GLubyte x, GLubyte y, GLubyte z, GLubyte w)
+// { procs->p_glVertexAttrib4Nub(index, x, y, z, w); }
+//
+// void __glVertexAttrib4Nubv(glProcs const * procs, GLuint index, const GLubyte * v)
+// { procs->p_glVertexAttrib4Nubv(index, v); }
+//
+// void __glVertexAttrib4Nuiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttrib4Nuiv(index, v); }
+//
+// void __glVertexAttrib4Nusv(glProcs const * procs, GLuint index, const GLushort * v)
+// { procs->p_glVertexAttrib4Nusv(index, v); }
+//
+// void __glVertexAttrib4bv(glProcs const * procs, GLuint index, const GLbyte * v)
+// { procs->p_glVertexAttrib4bv(index, v); }
+//
+// void __glVertexAttrib4d(glProcs const * procs, GLuint index, GLdouble x, GLdouble y, GLdouble z, GLdouble w)
+// { procs->p_glVertexAttrib4d(index, x, y, z, w); }
+//
+// void __glVertexAttrib4dv(glProcs const * procs, GLuint index, const GLdouble * v)
+// { procs->p_glVertexAttrib4dv(index, v); }
+//
+// void __glVertexAttrib4f(glProcs const * procs, GLuint index, GLfloat x, GLfloat y, GLfloat z, GLfloat w)
+// { procs->p_glVertexAttrib4f(index, x, y, z, w); }
+//
+// void __glVertexAttrib4fv(glProcs const * procs, GLuint index, const GLfloat * v)
+// { procs->p_glVertexAttrib4fv(index, v); }
+//
+// void __glVertexAttrib4iv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttrib4iv(index, v); }
+//
+// void __glVertexAttrib4s(glProcs const * procs, GLuint index, GLshort x, GLshort y, GLshort z, GLshort w)
+// { procs->p_glVertexAttrib4s(index, x, y, z, w); }
+//
+// void __glVertexAttrib4sv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttrib4sv(index, v); }
+//
+// void __glVertexAttrib4ubv(glProcs const * procs, GLuint index, const GLubyte * v)
+// { procs->p_glVertexAttrib4ubv(index, v); }
+//
+// void __glVertexAttrib4uiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttrib4uiv(index, v); }
+//
+// void __glVertexAttrib4usv(glProcs const * procs, GLuint index, const GLushort * v)
+// { procs->p_glVertexAttrib4usv(index, v); }
+//
+// void __glVertexAttribPointer(glProcs const * procs, GLuint index, GLint size, GLenum type, GLboolean normalized, GLsizei stride, const GLvoid * pointer)
+// { procs->p_glVertexAttribPointer(index, size, type, normalized, stride, pointer); }
+//
+// void __glUniformMatrix2x3fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix2x3fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix3x2fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix3x2fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix2x4fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix2x4fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix4x2fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix4x2fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix3x4fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix3x4fv(location, count, transpose, value); }
+//
+// void __glUniformMatrix4x3fv(glProcs const * procs, GLint location, GLsizei count, GLboolean transpose, const GLfloat * value)
+// { procs->p_glUniformMatrix4x3fv(location, count, transpose, value); }
+//
+// void __glColorMaski(glProcs const * procs, GLuint index, GLboolean r, GLboolean g, GLboolean b, GLboolean a)
+// { procs->p_glColorMaski(index, r, g, b, a); }
+//
+// void __glGetBooleani_v(glProcs const * procs, GLenum target, GLuint index, GLboolean * data)
+// { procs->p_glGetBooleani_v(target, index, data); }
+//
+// void __glGetIntegeri_v(glProcs const * procs, GLenum target, GLuint index, GLint * data)
+// { procs->p_glGetIntegeri_v(target, index, data); }
+//
+// void __glEnablei(glProcs const * procs, GLenum target, GLuint index)
+// { procs->p_glEnablei(target, index); }
+//
+// void __glDisablei(glProcs const * procs, GLenum target, GLuint index)
+// { procs->p_glDisablei(target, index); }
+//
+// GLboolean __glIsEnabledi(glProcs const * procs, GLenum target, GLuint index)
+// { return procs->p_glIsEnabledi(target, index); }
+//
+// void __glBeginTransformFeedback(glProcs const * procs, GLenum primitiveMode)
+// { procs->p_glBeginTransformFeedback(primitiveMode); }
+//
+// void __glEndTransformFeedback(glProcs const * procs)
+// { procs->p_glEndTransformFeedback(); }
+//
+// void __glBindBufferRange(glProcs const * procs, GLenum target, GLuint index, GLuint buffer, GLintptr offset, GLsizeiptr size)
+// { procs->p_glBindBufferRange(target, index, buffer, offset, size); }
+//
+// void __glBindBufferBase(glProcs const * procs, GLenum target, GLuint index, GLuint buffer)
+// { procs->p_glBindBufferBase(target, index, buffer); }
+//
+// void __glTransformFeedbackVaryings(glProcs const * procs, GLuint program, GLsizei count, const GLchar* * varyings, GLenum bufferMode)
+// { procs->p_glTransformFeedbackVaryings(program, count, varyings, bufferMode); }
+//
+// void __glGetTransformFeedbackVarying(glProcs const * procs, GLuint program, GLuint index, GLsizei bufSize, GLsizei * length, GLsizei * size, GLenum * type, GLchar * name)
+// { procs->p_glGetTransformFeedbackVarying(program, index, bufSize, length, size, type, name); }
+//
+// void __glClampColor(glProcs const * procs, GLenum target, GLenum clamp)
+// { procs->p_glClampColor(target, clamp); }
+//
+// void __glBeginConditionalRender(glProcs const * procs, GLuint id, GLenum mode)
+// { procs->p_glBeginConditionalRender(id, mode); }
+//
+// void __glEndConditionalRender(glProcs const * procs)
+// { procs->p_glEndConditionalRender(); }
+//
+// void __glVertexAttribIPointer(glProcs const * procs, GLuint index, GLint size, GLenum type, GLsizei stride, const GLvoid * pointer)
+// { procs->p_glVertexAttribIPointer(index, size, type, stride, pointer); }
+//
+// void __glGetVertexAttribIiv(glProcs const * procs, GLuint index, GLenum pname, GLint * params)
+// { procs->p_glGetVertexAttribIiv(index, pname, params); }
+//
+// void __glGetVertexAttribIuiv(glProcs const * procs, GLuint index, GLenum pname, GLuint * params)
+// { procs->p_glGetVertexAttribIuiv(index, pname, params); }
+//
+// void __glVertexAttribI1i(glProcs const * procs, GLuint index, GLint x)
+// { procs->p_glVertexAttribI1i(index, x); }
+//
+// void __glVertexAttribI2i(glProcs const * procs, GLuint index, GLint x, GLint y)
+// { procs->p_glVertexAttribI2i(index, x, y); }
+//
+// void __glVertexAttribI3i(glProcs const * procs, GLuint index, GLint x, GLint y, GLint z)
+// { procs->p_glVertexAttribI3i(index, x, y, z); }
+//
+// void __glVertexAttribI4i(glProcs const * procs, GLuint index, GLint x, GLint y, GLint z, GLint w)
+// { procs->p_glVertexAttribI4i(index, x, y, z, w); }
+//
+// void __glVertexAttribI1ui(glProcs const * procs, GLuint index, GLuint x)
+// { procs->p_glVertexAttribI1ui(index, x); }
+//
+// void __glVertexAttribI2ui(glProcs const * procs, GLuint index, GLuint x, GLuint y)
+// { procs->p_glVertexAttribI2ui(index, x, y); }
+//
+// void __glVertexAttribI3ui(glProcs const * procs, GLuint index, GLuint x, GLuint y, GLuint z)
+// { procs->p_glVertexAttribI3ui(index, x, y, z); }
+//
+// void __glVertexAttribI4ui(glProcs const * procs, GLuint index, GLuint x, GLuint y, GLuint z, GLuint w)
+// { procs->p_glVertexAttribI4ui(index, x, y, z, w); }
+//
+// void __glVertexAttribI1iv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttribI1iv(index, v); }
+//
+// void __glVertexAttribI2iv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttribI2iv(index, v); }
+//
+// void __glVertexAttribI3iv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttribI3iv(index, v); }
+//
+// void __glVertexAttribI4iv(glProcs const * procs, GLuint index, const GLint * v)
+// { procs->p_glVertexAttribI4iv(index, v); }
+//
+// void __glVertexAttribI1uiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttribI1uiv(index, v); }
+//
+// void __glVertexAttribI2uiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttribI2uiv(index, v); }
+//
+// void __glVertexAttribI3uiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttribI3uiv(index, v); }
+//
+// void __glVertexAttribI4uiv(glProcs const * procs, GLuint index, const GLuint * v)
+// { procs->p_glVertexAttribI4uiv(index, v); }
+//
+// void __glVertexAttribI4bv(glProcs const * procs, GLuint index, const GLbyte * v)
+// { procs->p_glVertexAttribI4bv(index, v); }
+//
+// void __glVertexAttribI4sv(glProcs const * procs, GLuint index, const GLshort * v)
+// { procs->p_glVertexAttribI4sv(index, v); }
+//
+// void __glVertexAttribI4ubv(glProcs const * procs, GLuint index, const GLubyte * v)
+// { procs->p_glVertexAttribI4ubv(index, v); }
+//
+// void __glVertexAttribI4usv(glProcs const * procs, GLuint index, const GLushort * v)
+// { procs->p_glVertexAttribI4usv(index, v); }
+//
+// void __glGetUniformuiv(glProcs const * procs, GLuint program, GLint location, GLuint * params)
+// { procs->p_glGetUniformuiv(program, location, params); }
+//
+// void __glBindFragDataLocation(glProcs const * procs, GLuint program, GLuint color, const GLchar * name)
+// { procs->p_glBindFragDataLocation(program, color, name); }
+//
+// GLint __glGetFragDataLocation(glProcs const * procs, GLuint program, const GLchar * name)
+// { return procs->p_glGetFragDataLocation(program, name); }
+//
+// void __glUniform1ui(glProcs const * procs, GLint location, GLuint v0)
+// { procs->p_glUniform1ui(location, v0); }
+//
+// void __glUniform2ui(glProcs const * procs, GLint location, GLuint v0, GLuint v1)
+// { procs->p_glUniform2ui(location, v0, v1); }
+//
+// void __glUniform3ui(glProcs const * procs, GLint location, GLuint v0, GLuint v1, GLuint v2)
+// { procs->p_glUniform3ui(location, v0, v1, v2); }
+//
+// void __glUniform4ui(glProcs const * procs, GLint location, GLuint v0, GLuint v1, GLuint v2, GLuint v3)
+// { procs->p_glUniform4ui(location, v0, v1, v2, v3); }
+//
+// void __glUniform1uiv(glProcs const * procs, GLint location, GLsizei count, const GLuint * value)
+// { procs->p_glUniform1uiv(location, count, value); }
+//
+// void __glUniform2uiv(glProcs const * procs, GLint location, GLsizei count, const GLuint * value)
+// { procs->p_glUniform2uiv(location, count, value); }
+//
+// void __glUniform3uiv(glProcs const * procs, GLint location, GLsizei count, const GLuint * value)
+// { procs->p_glUniform3uiv(location, count, value); }
+//
+// void __glUniform4uiv(glProcs const * procs, GLint location, GLsizei count, const GLuint * value)
+// { procs->p_glUniform4uiv(location, count, value); }
+//
+// void __glTexParameterIiv(glProcs const * procs, GLenum target, GLenum pname, const GLint * params)
+// { procs->p_glTexParameterIiv(target, pname, params); }
+//
+// void __glTexParameterIuiv(glProcs const * procs, GLenum target, GLenum pname, const GLuint * params)
+// { procs->p_glTexParameterIuiv(target, pname, params); }
+//
+// void __glGetTexParameterIiv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetTexParameterIiv(target, pname, params); }
+//
+// void __glGetTexParameterIuiv(glProcs const * procs, GLenum target, GLenum pname, GLuint * params)
+// { procs->p_glGetTexParameterIuiv(target, pname, params); }
+//
+// void __glClearBufferiv(glProcs const * procs, GLenum buffer, GLint drawbuffer, const GLint * value)
+// { procs->p_glClearBufferiv(buffer, drawbuffer, value); }
+//
+// void __glClearBufferuiv(glProcs const * procs, GLenum buffer, GLint drawbuffer, const GLuint * value)
+// { procs->p_glClearBufferuiv(buffer, drawbuffer, value); }
+//
+// void __glClearBufferfv(glProcs const * procs, GLenum buffer, GLint drawbuffer, const GLfloat * value)
+// { procs->p_glClearBufferfv(buffer, drawbuffer, value); }
+//
+// void __glClearBufferfi(glProcs const * procs, GLenum buffer, GLint drawbuffer, GLfloat depth, GLint stencil)
+// { procs->p_glClearBufferfi(buffer, drawbuffer, depth, stencil); }
+//
+// const GLubyte * __glGetStringi(glProcs const * procs, GLenum name, GLuint index)
+// { return procs->p_glGetStringi(name, index); }
+//
+// GLboolean __glIsRenderbuffer(glProcs const * procs, GLuint renderbuffer)
+// { return procs->p_glIsRenderbuffer(renderbuffer); }
+//
+// void __glBindRenderbuffer(glProcs const * procs, GLenum target, GLuint renderbuffer)
+// { procs->p_glBindRenderbuffer(target, renderbuffer); }
+//
+// void __glDeleteRenderbuffers(glProcs const * procs, GLsizei n, const GLuint * renderbuffers)
+// { procs->p_glDeleteRenderbuffers(n, renderbuffers); }
+//
+// void __glGenRenderbuffers(glProcs const * procs, GLsizei n, GLuint * renderbuffers)
+// { procs->p_glGenRenderbuffers(n, renderbuffers); }
+//
+// void __glRenderbufferStorage(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLsizei height)
+// { procs->p_glRenderbufferStorage(target, internalformat, width, height); }
+//
+// void __glGetRenderbufferParameteriv(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetRenderbufferParameteriv(target, pname, params); }
+//
+// GLboolean __glIsFramebuffer(glProcs const * procs, GLuint framebuffer)
+// { return procs->p_glIsFramebuffer(framebuffer); }
+//
+// void __glBindFramebuffer(glProcs const * procs, GLenum target, GLuint framebuffer)
+// { procs->p_glBindFramebuffer(target, framebuffer); }
+//
+// void __glDeleteFramebuffers(glProcs const * procs, GLsizei n, const GLuint * framebuffers)
+// { procs->p_glDeleteFramebuffers(n, framebuffers); }
+//
+// void __glGenFramebuffers(glProcs const * procs, GLsizei n, GLuint * framebuffers)
+// { procs->p_glGenFramebuffers(n, framebuffers); }
+//
+// GLenum __glCheckFramebufferStatus(glProcs const * procs, GLenum target)
+// { return procs->p_glCheckFramebufferStatus(target); }
+//
+// void __glFramebufferTexture1D(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level)
+// { procs->p_glFramebufferTexture1D(target, attachment, textarget, texture, level); }
+//
+// void __glFramebufferTexture2D(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level)
+// { procs->p_glFramebufferTexture2D(target, attachment, textarget, texture, level); }
+//
+// void __glFramebufferTexture3D(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level, GLint zoffset)
+// { procs->p_glFramebufferTexture3D(target, attachment, textarget, texture, level, zoffset); }
+//
+// void __glFramebufferRenderbuffer(glProcs const * procs, GLenum target, GLenum attachment, GLenum renderbuffertarget, GLuint renderbuffer)
+// { procs->p_glFramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer); }
+//
+// void __glGetFramebufferAttachmentParameteriv(glProcs const * procs, GLenum target, GLenum attachment, GLenum pname, GLint * params)
+// { procs->p_glGetFramebufferAttachmentParameteriv(target, attachment, pname, params); }
+//
+// void __glGenerateMipmap(glProcs const * procs, GLenum target)
+// { procs->p_glGenerateMipmap(target); }
+//
+// void __glBlitFramebuffer(glProcs const * procs, GLint srcX0, GLint srcY0, GLint srcX1, GLint srcY1, GLint dstX0, GLint dstY0, GLint dstX1, GLint dstY1, GLbitfield mask, GLenum filter)
+// { procs->p_glBlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter); }
+//
+// void __glRenderbufferStorageMultisample(glProcs const * procs, GLenum target, GLsizei samples, GLenum internalformat, GLsizei width, GLsizei height)
+// { procs->p_glRenderbufferStorageMultisample(target, samples, internalformat, width, height); }
+//
+// void __glFramebufferTextureLayer(glProcs const * procs, GLenum target, GLenum attachment, GLuint texture, GLint level, GLint layer)
+// { procs->p_glFramebufferTextureLayer(target, attachment, texture, level, layer); }
+//
+// GLboolean __glIsRenderbufferEXT(glProcs const * procs, GLuint renderbuffer)
+// { return procs->p_glIsRenderbufferEXT(renderbuffer); }
+//
+// void __glBindRenderbufferEXT(glProcs const * procs, GLenum target, GLuint renderbuffer)
+// { procs->p_glBindRenderbufferEXT(target, renderbuffer); }
+//
+// void __glDeleteRenderbuffersEXT(glProcs const * procs, GLsizei n, const GLuint * renderbuffers)
+// { procs->p_glDeleteRenderbuffersEXT(n, renderbuffers); }
+//
+// void __glGenRenderbuffersEXT(glProcs const * procs, GLsizei n, GLuint * renderbuffers)
+// { procs->p_glGenRenderbuffersEXT(n, renderbuffers); }
+//
+// void __glRenderbufferStorageEXT(glProcs const * procs, GLenum target, GLenum internalformat, GLsizei width, GLsizei height)
+// { procs->p_glRenderbufferStorageEXT(target, internalformat, width, height); }
+//
+// void __glGetRenderbufferParameterivEXT(glProcs const * procs, GLenum target, GLenum pname, GLint * params)
+// { procs->p_glGetRenderbufferParameterivEXT(target, pname, params); }
+//
+// GLboolean __glIsFramebufferEXT(glProcs const * procs, GLuint framebuffer)
+// { return procs->p_glIsFramebufferEXT(framebuffer); }
+//
+// void __glBindFramebufferEXT(glProcs const * procs, GLenum target, GLuint framebuffer)
+// { procs->p_glBindFramebufferEXT(target, framebuffer); }
+//
+// void __glDeleteFramebuffersEXT(glProcs const * procs, GLsizei n, const GLuint * framebuffers)
+// { procs->p_glDeleteFramebuffersEXT(n, framebuffers); }
+//
+// void __glGenFramebuffersEXT(glProcs const * procs, GLsizei n, GLuint * framebuffers)
+// { procs->p_glGenFramebuffersEXT(n, framebuffers); }
+//
+// GLenum __glCheckFramebufferStatusEXT(glProcs const * procs, GLenum target)
+// { return procs->p_glCheckFramebufferStatusEXT(target); }
+//
+// void __glFramebufferTexture1DEXT(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level)
+// { procs->p_glFramebufferTexture1DEXT(target, attachment, textarget, texture, level); }
+//
+// void __glFramebufferTexture2DEXT(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level)
+// { procs->p_glFramebufferTexture2DEXT(target, attachment, textarget, texture, level); }
+//
+// void __glFramebufferTexture3DEXT(glProcs const * procs, GLenum target, GLenum attachment, GLenum textarget, GLuint texture, GLint level, GLint zoffset)
+// { procs->p_glFramebufferTexture3DEXT(target, attachment, textarget, texture, level, zoffset); }
+//
+// void __glFramebufferRenderbufferEXT(glProcs const * procs, GLenum target, GLenum attachment, GLenum renderbuffertarget, GLuint renderbuffer)
+// { procs->p_glFramebufferRenderbufferEXT(target, attachment, renderbuffertarget, renderbuffer); }
+//
+// void __glGetFramebufferAttachmentParameterivEXT(glProcs const * procs, GLenum target, GLenum attachment, GLenum pname, GLint * params)
+// { procs->p_glGetFramebufferAttachmentParameterivEXT(target, attachment, pname, params); }
+//
+// void __glGenerateMipmapEXT(glProcs const * procs, GLenum target)
+// { procs->p_glGenerateMipmapEXT(target); }
+import "C"
+import "unsafe"
+
+// GL_VERSION_1_2
+const (
+	GL_UNSIGNED_BYTE_3_3_2           = 0x8032
+	GL_UNSIGNED_SHORT_4_4_4_4        = 0x8033
+	GL_UNSIGNED_SHORT_5_5_5_1        = 0x8034
+	GL_UNSIGNED_INT_8_8_8_8          = 0x8035
+	GL_UNSIGNED_INT_10_10_10_2       = 0x8036
+	GL_TEXTURE_BINDING_3D            = 0x806A
+	GL_PACK_SKIP_IMAGES              = 0x806B
+	GL_PACK_IMAGE_HEIGHT             = 0x806C
+	GL_UNPACK_SKIP_IMAGES            = 0x806D
+	GL_UNPACK_IMAGE_HEIGHT           = 0x806E
+	GL_TEXTURE_3D                    = 0x806F
+	GL_PROXY_TEXTURE_3D              = 0x8070
+	GL_TEXTURE_DEPTH                 = 0x8071
+	GL_TEXTURE_WRAP_R                = 0x8072
+	GL_MAX_3D_TEXTURE_SIZE           = 0x8073
+	GL_UNSIGNED_BYTE_2_3_3_REV       = 0x8362
+	GL_UNSIGNED_SHORT_5_6_5          = 0x8363
+	GL_UNSIGNED_SHORT_5_6_5_REV      = 0x8364
+	GL_UNSIGNED_SHORT_4_4_4_4_REV    = 0x8365
+	GL_UNSIGNED_SHORT_1_5_5_5_REV    = 0x8366
+	GL_UNSIGNED_INT_8_8_8_8_REV      = 0x8367
+	GL_UNSIGNED_INT_2_10_10_10_REV   = 0x8368
+	GL_BGR                           = 0x80E0
+	GL_BGRA                          = 0x80E1
+	GL_MAX_ELEMENTS_VERTICES         = 0x80E8
+	GL_MAX_ELEMENTS_INDICES          = 0x80E9
+	GL_CLAMP_TO_EDGE                 = 0x812F
+	GL_TEXTURE_MIN_LOD               = 0x813A
+	GL_TEXTURE_MAX_LOD               = 0x813B
+	GL_TEXTURE_BASE_LEVEL            = 0x813C
+	GL_TEXTURE_MAX_LEVEL             = 0x813D
+	GL_SMOOTH_POINT_SIZE_RANGE       = 0x0B12
+	GL_SMOOTH_POINT_SIZE_GRANULARITY = 0x0B13
+	GL_SMOOTH_LINE_WIDTH_RANGE       = 0x0B22
+	GL_SMOOTH_LINE_WIDTH_GRANULARITY = 0x0B23
+	GL_ALIASED_LINE_WIDTH_RANGE      = 0x846E
+)
+
+// GL_VERSION_1_2_DEPRECATED
+const (
+	GL_RESCALE_NORMAL            = 0x803A
+	GL_LIGHT_MODEL_COLOR_CONTROL = 0x81F8
+	GL_SINGLE_COLOR              = 0x81F9
+	GL_SEPARATE_SPECULAR_COLOR   = 0x81FA
+	GL_ALIASED_POINT_SIZE_RANGE  = 0x846D
+)
+
+// GL_VERSION_1_3
+const (
+	GL_TEXTURE0                       = 0x84C0
+	GL_TEXTURE1                       = 0x84C1
+	GL_TEXTURE2                       = 0x84C2
+	GL_TEXTURE3                       = 0x84C3
+	GL_TEXTURE4                       = 0x84C4
+	GL_TEXTURE5                       = 0x84C5
+	GL_TEXTURE6                       = 0x84C6
+	GL_TEXTURE7                       = 0x84C7
+	GL_TEXTURE8                       = 0x84C8
+	GL_TEXTURE9                       = 0x84C9
+	GL_TEXTURE10                      = 0x84CA
+	GL_TEXTURE11                      = 0x84CB
+	GL_TEXTURE12                      = 0x84CC
+	GL_TEXTURE13                      = 0x84CD
+	GL_TEXTURE14                      = 0x84CE
+	GL_TEXTURE15                      = 0x84CF
+	GL_TEXTURE16                      = 0x84D0
+	GL_TEXTURE17                      = 0x84D1
+	GL_TEXTURE18                      = 0x84D2
+	GL_TEXTURE19                      = 0x84D3
+	GL_TEXTURE20                      = 0x84D4
+	GL_TEXTURE21                      = 0x84D5
+	GL_TEXTURE22                      = 0x84D6
+	GL_TEXTURE23                      = 0x84D7
+	GL_TEXTURE24                      = 0x84D8
+	GL_TEXTURE25                      = 0x84D9
+	GL_TEXTURE26                      = 0x84DA
+	GL_TEXTURE27                      = 0x84DB
+	GL_TEXTURE28                      = 0x84DC
+	GL_TEXTURE29                      = 0x84DD
+	GL_TEXTURE30                      = 0x84DE
+	GL_TEXTURE31                      = 0x84DF
+	GL_ACTIVE_TEXTURE                 = 0x84E0
+	GL_MULTISAMPLE                    = 0x809D
+	GL_SAMPLE_ALPHA_TO_COVERAGE       = 0x809E
+	GL_SAMPLE_ALPHA_TO_ONE            = 0x809F
+	GL_SAMPLE_COVERAGE                = 0x80A0
+	GL_SAMPLE_BUFFERS                 = 0x80A8
+	GL_SAMPLES                        = 0x80A9
+	GL_SAMPLE_COVERAGE_VALUE          = 0x80AA
+	GL_SAMPLE_COVERAGE_INVERT         = 0x80AB
+	GL_TEXTURE_CUBE_MAP               = 0x8513
+	GL_TEXTURE_BINDING_CUBE_MAP       = 0x8514
+	GL_TEXTURE_CUBE_MAP_POSITIVE_X    = 0x8515
+	GL_TEXTURE_CUBE_MAP_NEGATIVE_X    = 0x8516
+	GL_TEXTURE_CUBE_MAP_POSITIVE_Y    = 0x8517
+	GL_TEXTURE_CUBE_MAP_NEGATIVE_Y    = 0x8518
+	GL_TEXTURE_CUBE_MAP_POSITIVE_Z    = 0x8519
+	GL_TEXTURE_CUBE_MAP_NEGATIVE_Z    = 0x851A
+	GL_PROXY_TEXTURE_CUBE_MAP         = 0x851B
+	GL_MAX_CUBE_MAP_TEXTURE_SIZE      = 0x851C
+	GL_COMPRESSED_RGB                 = 0x84ED
+	GL_COMPRESSED_RGBA                = 0x84EE
+	GL_TEXTURE_COMPRESSION_HINT       = 0x84EF
+	GL_TEXTURE_COMPRESSED_IMAGE_SIZE  = 0x86A0
+	GL_TEXTURE_COMPRESSED             = 0x86A1
+	GL_NUM_COMPRESSED_TEXTURE_FORMATS = 0x86A2
+	GL_COMPRESSED_TEXTURE_FORMATS     = 0x86A3
+	GL_CLAMP_TO_BORDER                = 0x812D
+)
+
+// GL_VERSION_1_3_DEPRECATED
+const (
+	GL_CLIENT_ACTIVE_TEXTURE       = 0x84E1
+	GL_MAX_TEXTURE_UNITS           = 0x84E2
+	GL_TRANSPOSE_MODELVIEW_MATRIX  = 0x84E3
+	GL_TRANSPOSE_PROJECTION_MATRIX = 0x84E4
+	GL_TRANSPOSE_TEXTURE_MATRIX    = 0x84E5
+	GL_TRANSPOSE_COLOR_MATRIX      = 0x84E6
+	GL_MULTISAMPLE_BIT             = 0x20000000
+	GL_NORMAL_MAP                  = 0x8511
+	GL_REFLECTION_MAP              = 0x8512
+	GL_COMPRESSED_ALPHA            = 0x84E9
+	GL_COMPRESSED_LUMINANCE        = 0x84EA
+	GL_COMPRESSED_LUMINANCE_ALPHA  = 0x84EB
+	GL_COMPRESSED_INTENSITY        = 0x84EC
+	GL_COMBINE                     = 0x8570
+	GL_COMBINE_RGB                 = 0x8571
+	GL_COMBINE_ALPHA               = 0x8572
+	GL_SOURCE0_RGB                 = 0x8580
+	GL_SOURCE1_RGB                 = 0x8581
+	GL_SOURCE2_RGB                 = 0x8582
+	GL_SOURCE0_ALPHA               = 0x8588
+	GL_SOURCE1_ALPHA               = 0x8589
+	GL_SOURCE2_ALPHA               = 0x858A
+	GL_OPERAND0_RGB                = 0x8590
+	GL_OPERAND1_RGB                = 0x8591
+	GL_OPERAND2_RGB                = 0x8592
+	GL_OPERAND0_ALPHA              = 0x8598
+	GL_OPERAND1_ALPHA              = 0x8599
+	GL_OPERAND2_ALPHA              = 0x859A
+	GL_RGB_SCALE                   = 0x8573
+	GL_ADD_SIGNED                  = 0x8574
+	GL_INTERPOLATE                 = 0x8575
+	GL_SUBTRACT                    = 0x84E7
+	GL_CONSTANT                    = 0x8576
+	GL_PRIMARY_COLOR               = 0x8577
+	GL_PREVIOUS                    = 0x8578
+	GL_DOT3_RGB                    = 0x86AE
+	GL_DOT3_RGBA                   = 0x86AF
+)
+
+// GL_VERSION_1_4
+const (
+	GL_BLEND_DST_RGB             = 0x80C8
+	GL_BLEND_SRC_RGB             = 0x80C9
+	GL_BLEND_DST_ALPHA           = 0x80CA
+	GL_BLEND_SRC_ALPHA           = 0x80CB
+	GL_POINT_FADE_THRESHOLD_SIZE = 0x8128
+	GL_DEPTH_COMPONENT16         = 0x81A5
+	GL_DEPTH_COMPONENT24         = 0x81A6
+	GL_DEPTH_COMPONENT32         = 0x81A7
+	GL_MIRRORED_REPEAT           = 0x8370
+	GL_MAX_TEXTURE_LOD_BIAS      = 0x84FD
+	GL_TEXTURE_LOD_BIAS          = 0x8501
+	GL_INCR_WRAP                 = 0x8507
+	GL_DECR_WRAP                 = 0x8508
+	GL_TEXTURE_DEPTH_SIZE        = 0x884A
+	GL_TEXTURE_COMPARE_MODE      = 0x884C
+	GL_TEXTURE_COMPARE_FUNC      = 0x884D
+)
+
+// GL_VERSION_1_4_DEPRECATED
+const (
+	GL_POINT_SIZE_MIN                = 0x8126
+	GL_POINT_SIZE_MAX                = 0x8127
+	GL_POINT_DISTANCE_ATTENUATION    = 0x8129
+	GL_GENERATE_MIPMAP               = 0x8191
+	GL_GENERATE_MIPMAP_HINT          = 0x8192
+	GL_FOG_COORDINATE_SOURCE         = 0x8450
+	GL_FOG_COORDINATE                = 0x8451
+	GL_FRAGMENT_DEPTH                = 0x8452
+	GL_CURRENT_FOG_COORDINATE        = 0x8453
+	GL_FOG_COORDINATE_ARRAY_TYPE     = 0x8454
+	GL_FOG_COORDINATE_ARRAY_STRIDE   = 0x8455
+	GL_FOG_COORDINATE_ARRAY_POINTER  = 0x8456
+	GL_FOG_COORDINATE_ARRAY          = 0x8457
+	GL_COLOR_SUM                     = 0x8458
+	GL_CURRENT_SECONDARY_COLOR       = 0x8459
+	GL_SECONDARY_COLOR_ARRAY_SIZE    = 0x845A
+	GL_SECONDARY_COLOR_ARRAY_TYPE    = 0x845B
+	GL_SECONDARY_COLOR_ARRAY_STRIDE  = 0x845C
+	GL_SECONDARY_COLOR_ARRAY_POINTER = 0x845D
+	GL_SECONDARY_COLOR_ARRAY         = 0x845E
+	GL_TEXTURE_FILTER_CONTROL        = 0x8500
+	GL_DEPTH_TEXTURE_MODE            = 0x884B
+	GL_COMPARE_R_TO_TEXTURE          = 0x884E
+)
+
+// GL_VERSION_1_5
+const (
+	GL_BUFFER_SIZE                        = 0x8764
+	GL_BUFFER_USAGE                       = 0x8765
+	GL_QUERY_COUNTER_BITS                 = 0x8864
+	GL_CURRENT_QUERY                      = 0x8865
+	GL_QUERY_RESULT                       = 0x8866
+	GL_QUERY_RESULT_AVAILABLE             = 0x8867
+	GL_ARRAY_BUFFER                       = 0x8892
+	GL_ELEMENT_ARRAY_BUFFER               = 0x8893
+	GL_ARRAY_BUFFER_BINDING               = 0x8894
+	GL_ELEMENT_ARRAY_BUFFER_BINDING       = 0x8895
+	GL_VERTEX_ATTRIB_ARRAY_BUFFER_BINDING = 0x889F
+	GL_READ_ONLY                          = 0x88B8
+	GL_WRITE_ONLY                         = 0x88B9
+	GL_READ_WRITE                         = 0x88BA
+	GL_BUFFER_ACCESS                      = 0x88BB
+	GL_BUFFER_MAPPED                      = 0x88BC
+	GL_BUFFER_MAP_POINTER                 = 0x88BD
+	GL_STREAM_DRAW                        = 0x88E0
+	GL_STREAM_READ                        = 0x88E1
+	GL_STREAM_COPY                        = 0x88E2
+	GL_STATIC_DRAW                        = 0x88E4
+	GL_STATIC_READ                        = 0x88E5
+	GL_STATIC_COPY                        = 0x88E6
+	GL_DYNAMIC_DRAW                       = 0x88E8
+	GL_DYNAMIC_READ                       = 0x88E9
+	GL_DYNAMIC_COPY                       = 0x88EA
+	GL_SAMPLES_PASSED                     = 0x8914
+)
+
+// GL_VERSION_1_5_DEPRECATED
+const (
+	GL_VERTEX_ARRAY_BUFFER_BINDING          = 0x8896
+	GL_NORMAL_ARRAY_BUFFER_BINDING          = 0x8897
+	GL_COLOR_ARRAY_BUFFER_BINDING           = 0x8898
+	GL_INDEX_ARRAY_BUFFER_BINDING           = 0x8899
+	GL_TEXTURE_COORD_ARRAY_BUFFER_BINDING   = 0x889A
+	GL_EDGE_FLAG_ARRAY_BUFFER_BINDING       = 0x889B
+	GL_SECONDARY_COLOR_ARRAY_BUFFER_BINDING = 0x889C
+	GL_FOG_COORDINATE_ARRAY_BUFFER_BINDING  = 0x889D
+	GL_WEIGHT_ARRAY_BUFFER_BINDING          = 0x889E
+	GL_FOG_COORD_SRC                        = 0x8450
+	GL_FOG_COORD                            = 0x8451
+	GL_CURRENT_FOG_COORD                    = 0x8453
+	GL_FOG_COORD_ARRAY_TYPE                 = 0x8454
+	GL_FOG_COORD_ARRAY_STRIDE               = 0x8455
+	GL_FOG_COORD_ARRAY_POINTER              = 0x8456
+	GL_FOG_COORD_ARRAY                      = 0x8457
+	GL_FOG_COORD_ARRAY_BUFFER_BINDING       = 0x889D
+	GL_SRC0_RGB                             = 0x8580
+	GL_SRC1_RGB                             = 0x8581
+	GL_SRC2_RGB                             = 0x8582
+	GL_SRC0_ALPHA                           = 0x8588
+	GL_SRC1_ALPHA                           = 0x8589
+	GL_SRC2_ALPHA                           = 0x858A
+)
+
+// GL_VERSION_2_0
+const (
+	GL_BLEND_EQUATION_RGB               = 0x8009
+	GL_VERTEX_ATTRIB_ARRAY_ENABLED      = 0x8622
+	GL_VERTEX_ATTRIB_ARRAY_SIZE         = 0x8623
+	GL_VERTEX_ATTRIB_ARRAY_STRIDE       = 0x8624
+	GL_VERTEX_ATTRIB_ARRAY_TYPE         = 0x8625
+	GL_CURRENT_VERTEX_ATTRIB            = 0x8626
+	GL_VERTEX_PROGRAM_POINT_SIZE        = 0x8642
+	GL_VERTEX_ATTRIB_ARRAY_POINTER      = 0x8645
+	GL_STENCIL_BACK_FUNC                = 0x8800
+	GL_STENCIL_BACK_FAIL                = 0x8801
+	GL_STENCIL_BACK_PASS_DEPTH_FAIL     = 0x8802
+	GL_STENCIL_BACK_PASS_DEPTH_PASS     = 0x8803
+	GL_MAX_DRAW_BUFFERS                 = 0x8824
+	GL_DRAW_BUFFER0                     = 0x8825
+	GL_DRAW_BUFFER1                     = 0x8826
+	GL_DRAW_BUFFER2                     = 0x8827
+	GL_DRAW_BUFFER3                     = 0x8828
+	GL_DRAW_BUFFER4                     = 0x8829
+	GL_DRAW_BUFFER5                     = 0x882A
+	GL_DRAW_BUFFER6                     = 0x882B
+	GL_DRAW_BUFFER7                     = 0x882C
+	GL_DRAW_BUFFER8                     = 0x882D
+	GL_DRAW_BUFFER9                     = 0x882E
+	GL_DRAW_BUFFER10                    = 0x882F
+	GL_DRAW_BUFFER11                    = 0x8830
+	GL_DRAW_BUFFER12                    = 0x8831
+	GL_DRAW_BUFFER13                    = 0x8832
+	GL_DRAW_BUFFER14                    = 0x8833
+	GL_DRAW_BUFFER15                    = 0x8834
+	GL_BLEND_EQUATION_ALPHA             = 0x883D
+	GL_MAX_VERTEX_ATTRIBS               = 0x8869
+	GL_VERTEX_ATTRIB_ARRAY_NORMALIZED   = 0x886A
+	GL_MAX_TEXTURE_IMAGE_UNITS          = 0x8872
+	GL_FRAGMENT_SHADER                  = 0x8B30
+	GL_VERTEX_SHADER                    = 0x8B31
+	GL_MAX_FRAGMENT_UNIFORM_COMPONENTS  = 0x8B49
+	GL_MAX_VERTEX_UNIFORM_COMPONENTS    = 0x8B4A
+	GL_MAX_VARYING_FLOATS               = 0x8B4B
+	GL_MAX_VERTEX_TEXTURE_IMAGE_UNITS   = 0x8B4C
+	GL_MAX_COMBINED_TEXTURE_IMAGE_UNITS = 0x8B4D
+	GL_SHADER_TYPE                      = 0x8B4F
+	GL_FLOAT_VEC2                       = 0x8B50
+	GL_FLOAT_VEC3                       = 0x8B51
+	GL_FLOAT_VEC4                       = 0x8B52
+	GL_INT_VEC2                         = 0x8B53
+	GL_INT_VEC3                         = 0x8B54
+	GL_INT_VEC4                         = 0x8B55
+	GL_BOOL                             = 0x8B56
+	GL_BOOL_VEC2                        = 0x8B57
+	GL_BOOL_VEC3                        = 0x8B58
+	GL_BOOL_VEC4                        = 0x8B59
+	GL_FLOAT_MAT2                       = 0x8B5A
+	GL_FLOAT_MAT3                       = 0x8B5B
+	GL_FLOAT_MAT4                       = 0x8B5C
+	GL_SAMPLER_1D                       = 0x8B5D
+	GL_SAMPLER_2D                       = 0x8B5E
+	GL_SAMPLER_3D                       = 0x8B5F
+	GL_SAMPLER_CUBE                     = 0x8B60
+	GL_SAMPLER_1D_SHADOW                = 0x8B61
+	GL_SAMPLER_2D_SHADOW                = 0x8B62
+	GL_DELETE_STATUS                    = 0x8B80
+	GL_COMPILE_STATUS                   = 0x8B81
+	GL_LINK_STATUS                      = 0x8B82
+	GL_VALIDATE_STATUS                  = 0x8B83
+	GL_INFO_LOG_LENGTH                  = 0x8B84
+	GL_ATTACHED_SHADERS                 = 0x8B85
+	GL_ACTIVE_UNIFORMS                  = 0x8B86
+	GL_ACTIVE_UNIFORM_MAX_LENGTH        = 0x8B87
+	GL_SHADER_SOURCE_LENGTH             = 0x8B88
+	GL_ACTIVE_ATTRIBUTES                = 0x8B89
+	GL_ACTIVE_ATTRIBUTE_MAX_LENGTH      = 0x8B8A
+	GL_FRAGMENT_SHADER_DERIVATIVE_HINT  = 0x8B8B
+	GL_SHADING_LANGUAGE_VERSION         = 0x8B8C
+	GL_CURRENT_PROGRAM                  = 0x8B8D
+	GL_POINT_SPRITE_COORD_ORIGIN        = 0x8CA0
+	GL_LOWER_LEFT                       = 0x8CA1
+	GL_UPPER_LEFT                       = 0x8CA2
+	GL_STENCIL_BACK_REF                 = 0x8CA3
+	GL_STENCIL_BACK_VALUE_MASK          = 0x8CA4
+	GL_STENCIL_BACK_WRITEMASK           = 0x8CA5
+)
+
+// GL_VERSION_2_0_DEPRECATED
+const (
+	GL_VERTEX_PROGRAM_TWO_SIDE = 0x8643
+	GL_POINT_SPRITE            = 0x8861
+	GL_COORD_REPLACE           = 0x8862
+	GL_MAX_TEXTURE_COORDS      = 0x8871
+)
+
+// GL_VERSION_2_1
+const (
+	GL_PIXEL_PACK_BUFFER           = 0x88EB
+	GL_PIXEL_UNPACK_BUFFER         = 0x88EC
+	GL_PIXEL_PACK_BUFFER_BINDING   = 0x88ED
+	GL_PIXEL_UNPACK_BUFFER_BINDING = 0x88EF
+	GL_FLOAT_MAT2x3                = 0x8B65
+	GL_FLOAT_MAT2x4                = 0x8B66
+	GL_FLOAT_MAT3x2                = 0x8B67
+	GL_FLOAT_MAT3x4                = 0x8B68
+	GL_FLOAT_MAT4x2                = 0x8B69
+	GL_FLOAT_MAT4x3                = 0x8B6A
+	GL_SRGB                        = 0x8C40
+	GL_SRGB8                       = 0x8C41
+	GL_SRGB_ALPHA                  = 0x8C42
+	GL_SRGB8_ALPHA8                = 0x8C43
+	GL_COMPRESSED_SRGB             = 0x8C48
+	GL_COMPRESSED_SRGB_ALPHA       = 0x8C49
+)
+
+// GL_VERSION_2_1_DEPRECATED
+const (
+	GL_CURRENT_RASTER_SECONDARY_COLOR = 0x845F
+	GL_SLUMINANCE_ALPHA               = 0x8C44
+	GL_SLUMINANCE8_ALPHA8             = 0x8C45
+	GL_SLUMINANCE                     = 0x8C46
+	GL_SLUMINANCE8                    = 0x8C47
+	GL_COMPRESSED_SLUMINANCE          = 0x8C4A
+	GL_COMPRESSED_SLUMINANCE_ALPHA    = 0x8C4B
+)
+
+// GL_VERSION_3_0
+const (
+	GL_COMPARE_REF_TO_TEXTURE                        = 0x884E
+	GL_CLIP_DISTANCE0                                = 0x3000
+	GL_CLIP_DISTANCE1                                = 0x3001
+	GL_CLIP_DISTANCE2                                = 0x3002
+	GL_CLIP_DISTANCE3                                = 0x3003
+	GL_CLIP_DISTANCE4                                = 0x3004
+	GL_CLIP_DISTANCE5                                = 0x3005
+	GL_CLIP_DISTANCE6                                = 0x3006
+	GL_CLIP_DISTANCE7                                = 0x3007
+	GL_MAX_CLIP_DISTANCES                            = 0x0D32
+	GL_MAJOR_VERSION                                 = 0x821B
+	GL_MINOR_VERSION                                 = 0x821C
+	GL_NUM_EXTENSIONS                                = 0x821D
+	GL_CONTEXT_FLAGS                                 = 0x821E
+	GL_DEPTH_BUFFER                                  = 0x8223
+	GL_STENCIL_BUFFER                                = 0x8224
+	GL_COMPRESSED_RED                                = 0x8225
+	GL_COMPRESSED_RG                                 = 0x8226
+	GL_CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT           = 0x0001
+	GL_RGBA32F                                       = 0x8814
+	GL_RGB32F                                        = 0x8815
+	GL_RGBA16F                                       = 0x881A
+	GL_RGB16F                                        = 0x881B
+	GL_VERTEX_ATTRIB_ARRAY_INTEGER                   = 0x88FD
+	GL_MAX_ARRAY_TEXTURE_LAYERS                      = 0x88FF
+	GL_MIN_PROGRAM_TEXEL_OFFSET                      = 0x8904
+	GL_MAX_PROGRAM_TEXEL_OFFSET                      = 0x8905
+	GL_CLAMP_READ_COLOR                              = 0x891C
+	GL_FIXED_ONLY                                    = 0x891D
+	GL_MAX_VARYING_COMPONENTS                        = 0x8B4B
+	GL_TEXTURE_1D_ARRAY                              = 0x8C18
+	GL_PROXY_TEXTURE_1D_ARRAY                        = 0x8C19
+	GL_TEXTURE_2D_ARRAY                              = 0x8C1A
+	GL_PROXY_TEXTURE_2D_ARRAY                        = 0x8C1B
+	GL_TEXTURE_BINDING_1D_ARRAY                      = 0x8C1C
+	GL_TEXTURE_BINDING_2D_ARRAY                      = 0x8C1D
+	GL_R11F_G11F_B10F                                = 0x8C3A
+	GL_UNSIGNED_INT_10F_11F_11F_REV                  = 0x8C3B
+	GL_RGB9_E5                                       = 0x8C3D
+	GL_UNSIGNED_INT_5_9_9_9_REV                      = 0x8C3E
+	GL_TEXTURE_SHARED_SIZE                           = 0x8C3F
+	GL_TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH         = 0x8C76
+	GL_TRANSFORM_FEEDBACK_BUFFER_MODE                = 0x8C7F
+	GL_MAX_TRANSFORM_FEEDBACK_SEPARATE_COMPONENTS    = 0x8C80
+	GL_TRANSFORM_FEEDBACK_VARYINGS                   = 0x8C83
+	GL_TRANSFORM_FEEDBACK_BUFFER_START               = 0x8C84
+	GL_TRANSFORM_FEEDBACK_BUFFER_SIZE                = 0x8C85
+	GL_PRIMITIVES_GENERATED                          = 0x8C87
+	GL_TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN         = 0x8C88
+	GL_RASTERIZER_DISCARD                            = 0x8C89
+	GL_MAX_TRANSFORM_FEEDBACK_INTERLEAVED_COMPONENTS = 0x8C8A
+	GL_MAX_TRANSFORM_FEEDBACK_SEPARATE_ATTRIBS       = 0x8C8B
+	GL_INTERLEAVED_ATTRIBS                           = 0x8C8C
+	GL_SEPARATE_ATTRIBS                              = 0x8C8D
+	GL_TRANSFORM_FEEDBACK_BUFFER                     = 0x8C8E
+	GL_TRANSFORM_FEEDBACK_BUFFER_BINDING             = 0x8C8F
+	GL_RGBA32UI                                      = 0x8D70
+	GL_RGB32UI                                       = 0x8D71
+	GL_RGBA16UI                                      = 0x8D76
+	GL_RGB16UI                                       = 0x8D77
+	GL_RGBA8UI                                       = 0x8D7C
+	GL_RGB8UI                                        = 0x8D7D
+	GL_RGBA32I                                       = 0x8D82
+	GL_RGB32I                                        = 0x8D83
+	GL_RGBA16I                                       = 0x8D88
+	GL_RGB16I                                        = 0x8D89
+	GL_RGBA8I                                        = 0x8D8E
+	GL_RGB8I                                         = 0x8D8F
+	GL_RED_INTEGER                                   = 0x8D94
+	GL_GREEN_INTEGER                                 = 0x8D95
+	GL_BLUE_INTEGER                                  = 0x8D96
+	GL_RGB_INTEGER                                   = 0x8D98
+	GL_RGBA_INTEGER                                  = 0x8D99
+	GL_BGR_INTEGER                                   = 0x8D9A
+	GL_BGRA_INTEGER                                  = 0x8D9B
+	GL_SAMPLER_1D_ARRAY                              = 0x8DC0
+	GL_SAMPLER_2D_ARRAY                              = 0x8DC1
+	GL_SAMPLER_1D_ARRAY_SHADOW                       = 0x8DC3
+	GL_SAMPLER_2D_ARRAY_SHADOW                       = 0x8DC4
+	GL_SAMPLER_CUBE_SHADOW                           = 0x8DC5
+	GL_UNSIGNED_INT_VEC2                             = 0x8DC6
+	GL_UNSIGNED_INT_VEC3                             = 0x8DC7
+	GL_UNSIGNED_INT_VEC4                             = 0x8DC8
+	GL_INT_SAMPLER_1D                                = 0x8DC9
+	GL_INT_SAMPLER_2D                                = 0x8DCA
+	GL_INT_SAMPLER_3D                                = 0x8DCB
+	GL_INT_SAMPLER_CUBE                              = 0x8DCC
+	GL_INT_SAMPLER_1D_ARRAY                          = 0x8DCE
+	GL_INT_SAMPLER_2D_ARRAY                          = 0x8DCF
+	GL_UNSIGNED_INT_SAMPLER_1D                       = 0x8DD1
+	GL_UNSIGNED_INT_SAMPLER_2D                       = 0x8DD2
+	GL_UNSIGNED_INT_SAMPLER_3D                       = 0x8DD3
+	GL_UNSIGNED_INT_SAMPLER_CUBE                     = 0x8DD4
+	GL_UNSIGNED_INT_SAMPLER_1D_ARRAY                 = 0x8DD6
+	GL_UNSIGNED_INT_SAMPLER_2D_ARRAY                 = 0x8DD7
+	GL_QUERY_WAIT                                    = 0x8E13
+	GL_QUERY_NO_WAIT                                 = 0x8E14
+	GL_QUERY_BY_REGION_WAIT                          = 0x8E15
+	GL_QUERY_BY_REGION_NO_WAIT                       = 0x8E16
+	GL_BUFFER_ACCESS_FLAGS                           = 0x911F
+	GL_BUFFER_MAP_LENGTH                             = 0x9120
+	GL_BUFFER_MAP_OFFSET                             = 0x9121
+)
+
+// GL_VERSION_3_0_DEPRECATED
+const (
+	GL_CLAMP_VERTEX_COLOR   = 0x891A
+	GL_CLAMP_FRAGMENT_COLOR = 0x891B
+	GL_ALPHA_INTEGER        = 0x8D97
+)
+
+// GL_ARB_framebuffer_object
+const (
+	GL_INVALID_FRAMEBUFFER_OPERATION                = 0x0506
+	GL_FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING        = 0x8210
+	GL_FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE        = 0x8211
+	GL_FRAMEBUFFER_ATTACHMENT_RED_SIZE              = 0x8212
+	GL_FRAMEBUFFER_ATTACHMENT_GREEN_SIZE            = 0x8213
+	GL_FRAMEBUFFER_ATTACHMENT_BLUE_SIZE             = 0x8214
+	GL_FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE            = 0x8215
+	GL_FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE            = 0x8216
+	GL_FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE          = 0x8217
+	GL_FRAMEBUFFER_DEFAULT                          = 0x8218
+	GL_FRAMEBUFFER_UNDEFINED                        = 0x8219
+	GL_DEPTH_STENCIL_ATTACHMENT                     = 0x821A
+	GL_MAX_RENDERBUFFER_SIZE                        = 0x84E8
+	GL_DEPTH_STENCIL                                = 0x84F9
+	GL_UNSIGNED_INT_24_8                            = 0x84FA
+	GL_DEPTH24_STENCIL8                             = 0x88F0
+	GL_TEXTURE_STENCIL_SIZE                         = 0x88F1
+	GL_TEXTURE_RED_TYPE                             = 0x8C10
+	GL_TEXTURE_GREEN_TYPE                           = 0x8C11
+	GL_TEXTURE_BLUE_TYPE                            = 0x8C12
+	GL_TEXTURE_ALPHA_TYPE                           = 0x8C13
+	GL_TEXTURE_DEPTH_TYPE                           = 0x8C16
+	GL_UNSIGNED_NORMALIZED                          = 0x8C17
+	GL_FRAMEBUFFER_BINDING                          = 0x8CA6
+	GL_DRAW_FRAMEBUFFER_BINDING                     = GL_FRAMEBUFFER_BINDING
+	GL_RENDERBUFFER_BINDING                         = 0x8CA7
+	GL_READ_FRAMEBUFFER                             = 0x8CA8
+	GL_DRAW_FRAMEBUFFER                             = 0x8CA9
+	GL_READ_FRAMEBUFFER_BINDING                     = 0x8CAA
+	GL_RENDERBUFFER_SAMPLES                         = 0x8CAB
+	GL_FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           = 0x8CD0
+	GL_FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           = 0x8CD1
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         = 0x8CD2
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE = 0x8CD3
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER         = 0x8CD4
+	GL_FRAMEBUFFER_COMPLETE                         = 0x8CD5
+	GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT            = 0x8CD6
+	GL_FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    = 0x8CD7
+	GL_FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER           = 0x8CDB
+	GL_FRAMEBUFFER_INCOMPLETE_READ_BUFFER           = 0x8CDC
+	GL_FRAMEBUFFER_UNSUPPORTED                      = 0x8CDD
+	GL_MAX_COLOR_ATTACHMENTS                        = 0x8CDF
+	GL_COLOR_ATTACHMENT0                            = 0x8CE0
+	GL_COLOR_ATTACHMENT1                            = 0x8CE1
+	GL_COLOR_ATTACHMENT2                            = 0x8CE2
+	GL_COLOR_ATTACHMENT3                            = 0x8CE3
+	GL_COLOR_ATTACHMENT4                            = 0x8CE4
+	GL_COLOR_ATTACHMENT5                            = 0x8CE5
+	GL_COLOR_ATTACHMENT6                            = 0x8CE6
+	GL_COLOR_ATTACHMENT7                            = 0x8CE7
+	GL_COLOR_ATTACHMENT8                            = 0x8CE8
+	GL_COLOR_ATTACHMENT9                            = 0x8CE9
+	GL_COLOR_ATTACHMENT10                           = 0x8CEA
+	GL_COLOR_ATTACHMENT11                           = 0x8CEB
+	GL_COLOR_ATTACHMENT12                           = 0x8CEC
+	GL_COLOR_ATTACHMENT13                           = 0x8CED
+	GL_COLOR_ATTACHMENT14                           = 0x8CEE
+	GL_COLOR_ATTACHMENT15                           = 0x8CEF
+	GL_DEPTH_ATTACHMENT                             = 0x8D00
+	GL_STENCIL_ATTACHMENT                           = 0x8D20
+	GL_FRAMEBUFFER                                  = 0x8D40
+	GL_RENDERBUFFER                                 = 0x8D41
+	GL_RENDERBUFFER_WIDTH                           = 0x8D42
+	GL_RENDERBUFFER_HEIGHT                          = 0x8D43
+	GL_RENDERBUFFER_INTERNAL_FORMAT                 = 0x8D44
+	GL_STENCIL_INDEX1                               = 0x8D46
+	GL_STENCIL_INDEX4                               = 0x8D47
+	GL_STENCIL_INDEX8                               = 0x8D48
+	GL_STENCIL_INDEX16                              = 0x8D49
+	GL_RENDERBUFFER_RED_SIZE                        = 0x8D50
+	GL_RENDERBUFFER_GREEN_SIZE                      = 0x8D51
+	GL_RENDERBUFFER_BLUE_SIZE                       = 0x8D52
+	GL_RENDERBUFFER_ALPHA_SIZE                      = 0x8D53
+	GL_RENDERBUFFER_DEPTH_SIZE                      = 0x8D54
+	GL_RENDERBUFFER_STENCIL_SIZE                    = 0x8D55
+	GL_FRAMEBUFFER_INCOMPLETE_MULTISAMPLE           = 0x8D56
+	GL_MAX_SAMPLES                                  = 0x8D57
+)
+
+// GL_EXT_framebuffer_object
+const (
+	GL_INVALID_FRAMEBUFFER_OPERATION_EXT                = 0x0506
+	GL_MAX_RENDERBUFFER_SIZE_EXT                        = 0x84E8
+	GL_FRAMEBUFFER_BINDING_EXT                          = 0x8CA6
+	GL_RENDERBUFFER_BINDING_EXT                         = 0x8CA7
+	GL_FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE_EXT           = 0x8CD0
+	GL_FRAMEBUFFER_ATTACHMENT_OBJECT_NAME_EXT           = 0x8CD1
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL_EXT         = 0x8CD2
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE_EXT = 0x8CD3
+	GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_3D_ZOFFSET_EXT    = 0x8CD4
+	GL_FRAMEBUFFER_COMPLETE_EXT                         = 0x8CD5
+	GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT_EXT            = 0x8CD6
+	GL_FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT_EXT    = 0x8CD7
+	GL_FRAMEBUFFER_INCOMPLETE_DIMENSIONS_EXT            = 0x8CD9
+	GL_FRAMEBUFFER_INCOMPLETE_FORMATS_EXT               = 0x8CDA
+	GL_FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER_EXT           = 0x8CDB
+	GL_FRAMEBUFFER_INCOMPLETE_READ_BUFFER_EXT           = 0x8CDC
+	GL_FRAMEBUFFER_UNSUPPORTED_EXT                      = 0x8CDD
+	GL_MAX_COLOR_ATTACHMENTS_EXT                        = 0x8CDF
+	GL_COLOR_ATTACHMENT0_EXT                            = 0x8CE0
+	GL_COLOR_ATTACHMENT1_EXT                            = 0x8CE1
+	GL_COLOR_ATTACHMENT2_EXT                            = 0x8CE2
+	GL_COLOR_ATTACHMENT3_EXT                            = 0x8CE3
+	GL_COLOR_ATTACHMENT4_EXT                            = 0x8CE4
+	GL_COLOR_ATTACHMENT5_EXT                            = 0x8CE5
+	GL_COLOR_ATTACHMENT6_EXT                            = 0x8CE6
+	GL_COLOR_ATTACHMENT7_EXT                            = 0x8CE7
+	GL_COLOR_ATTACHMENT8_EXT                            = 0x8CE8
+	GL_COLOR_ATTACHMENT9_EXT                            = 0x8CE9
+	GL_COLOR_ATTACHMENT10_EXT                           = 0x8CEA
+	GL_COLOR_ATTACHMENT11_EXT                           = 0x8CEB
+	GL_COLOR_ATTACHMENT12_EXT                           = 0x8CEC
+	GL_COLOR_ATTACHMENT13_EXT                           = 0x8CED
+	GL_COLOR_ATTACHMENT14_EXT                           = 0x8CEE
+	GL_COLOR_ATTACHMENT15_EXT                           = 0x8CEF
+	GL_DEPTH_ATTACHMENT_EXT                             = 0x8D00
+	GL_STENCIL_ATTACHMENT_EXT                           = 0x8D20
+	GL_FRAMEBUFFER_EXT                                  = 0x8D40
+	GL_RENDERBUFFER_EXT                                 = 0x8D41
+	GL_RENDERBUFFER_WIDTH_EXT                           = 0x8D42
+	GL_RENDERBUFFER_HEIGHT_EXT                          = 0x8D43
+	GL_RENDERBUFFER_INTERNAL_FORMAT_EXT                 = 0x8D44
+	GL_STENCIL_INDEX1_EXT                               = 0x8D46
+	GL_STENCIL_INDEX4_EXT                               = 0x8D47
+	GL_STENCIL_INDEX8_EXT                               = 0x8D48
+	GL_STENCIL_INDEX16_EXT                              = 0x8D49
+	GL_RENDERBUFFER_RED_SIZE_EXT                        = 0x8D50
+	GL_RENDERBUFFER_GREEN_SIZE_EXT                      = 0x8D51
+	GL_RENDERBUFFER_BLUE_SIZE_EXT                       = 0x8D52
+	GL_RENDERBUFFER_ALPHA_SIZE_EXT                      = 0x8D53
+	GL_RENDERBUFFER_DEPTH_SIZE_EXT                      = 0x8D54
+	GL_RENDERBUFFER_STENCIL_SIZE_EXT                    = 0x8D55
+)
+
+// The OpenGL functions of a single Context. Function pointers are only
+// valid for the Context they were loaded on, so a GL must only be used
+// on a Thread where its Context is active.
+type GL struct {
+	procs  C.glProcs
+	loaded bool
+}
+
+// Resolve every function pointer through the backend's GetProcAddress.
+// Expects the owning context to be current.
+func (gl *GL) load() {
+	C.glLoadProcs(&gl.procs)
+	gl.loaded = true
+}
+
+// Returns true once the function pointers have been loaded
+func (gl *GL) IsLoaded() bool {
+	return gl.loaded
+}
+
+// Returns the names of the generated functions the driver did not provide.
+// Calling a missing function will crash, so check this before relying on
+// an extension.
+func (gl *GL) Missing() (missing []string) {
+	if gl.procs.p_glBlendColor == nil {
+		missing = append(missing, "glBlendColor")
+	}
+	if gl.procs.p_glBlendEquation == nil {
+		missing = append(missing, "glBlendEquation")
+	}
+	if gl.procs.p_glDrawRangeElements == nil {
+		missing = append(missing, "glDrawRangeElements")
+	}
+	if gl.procs.p_glTexImage3D == nil {
+		missing = append(missing, "glTexImage3D")
+	}
+	if gl.procs.p_glTexSubImage3D == nil {
+		missing = append(missing, "glTexSubImage3D")
+	}
+	if gl.procs.p_glCopyTexSubImage3D == nil {
+		missing = append(missing, "glCopyTexSubImage3D")
+	}
+	if gl.procs.p_glColorTable == nil {
+		missing = append(missing, "glColorTable")
+	}
+	if gl.procs.p_glColorTableParameterfv == nil {
+		missing = append(missing, "glColorTableParameterfv")
+	}
+	if gl.procs.p_glColorTableParameteriv == nil {
+		missing = append(missing, "glColorTableParameteriv")
+	}
+	if gl.procs.p_glCopyColorTable == nil {
+		missing = append(missing, "glCopyColorTable")
+	}
+	if gl.procs.p_glGetColorTable == nil {
+		missing = append(missing, "glGetColorTable")
+	}
+	if gl.procs.p_glGetColorTableParameterfv == nil {
+		missing = append(missing, "glGetColorTableParameterfv")
+	}
+	if gl.procs.p_glGetColorTableParameteriv == nil {
+		missing = append(missing, "glGetColorTableParameteriv")
+	}
+	if gl.procs.p_glColorSubTable == nil {
+		missing = append(missing, "glColorSubTable")
+	}
+	if gl.procs.p_glCopyColorSubTable == nil {
+		missing = append(missing, "glCopyColorSubTable")
+	}
+	if gl.procs.p_glConvolutionFilter1D == nil {
+		missing = append(missing, "glConvolutionFilter1D")
+	}
+	if gl.procs.p_glConvolutionFilter2D == nil {
+		missing = append(missing, "glConvolutionFilter2D")
+	}
+	if gl.procs.p_glConvolutionParameterf == nil {
+		missing = append(missing, "glConvolutionParameterf")
+	}
+	if gl.procs.p_glConvolutionParameterfv == nil {
+		missing = append(missing, "glConvolutionParameterfv")
+	}
+	if gl.procs.p_glConvolutionParameteri == nil {
+		missing = append(missing, "glConvolutionParameteri")
+	}
+	if gl.procs.p_glConvolutionParameteriv == nil {
+		missing = append(missing, "glConvolutionParameteriv")
+	}
+	if gl.procs.p_glCopyConvolutionFilter1D == nil {
+		missing = append(missing, "glCopyConvolutionFilter1D")
+	}
+	if gl.procs.p_glCopyConvolutionFilter2D == nil {
+		missing = append(missing, "glCopyConvolutionFilter2D")
+	}
+	if gl.procs.p_glGetConvolutionFilter == nil {
+		missing = append(missing, "glGetConvolutionFilter")
+	}
+	if gl.procs.p_glGetConvolutionParameterfv == nil {
+		missing = append(missing, "glGetConvolutionParameterfv")
+	}
+	if gl.procs.p_glGetConvolutionParameteriv == nil {
+		missing = append(missing, "glGetConvolutionParameteriv")
+	}
+	if gl.procs.p_glGetSeparableFilter == nil {
+		missing = append(missing, "glGetSeparableFilter")
+	}
+	if gl.procs.p_glSeparableFilter2D == nil {
+		missing = append(missing, "glSeparableFilter2D")
+	}
+	if gl.procs.p_glGetHistogram == nil {
+		missing = append(missing, "glGetHistogram")
+	}
+	if gl.procs.p_glGetHistogramParameterfv == nil {
+		missing = append(missing, "glGetHistogramParameterfv")
+	}
+	if gl.procs.p_glGetHistogramParameteriv == nil {
+		missing = append(missing, "glGetHistogramParameteriv")
+	}
+	if gl.procs.p_glGetMinmax == nil {
+		missing = append(missing, "glGetMinmax")
+	}
+	if gl.procs.p_glGetMinmaxParameterfv == nil {
+		missing = append(missing, "glGetMinmaxParameterfv")
+	}
+	if gl.procs.p_glGetMinmaxParameteriv == nil {
+		missing = append(missing, "glGetMinmaxParameteriv")
+	}
+	if gl.procs.p_glHistogram == nil {
+		missing = append(missing, "glHistogram")
+	}
+	if gl.procs.p_glMinmax == nil {
+		missing = append(missing, "glMinmax")
+	}
+	if gl.procs.p_glResetHistogram == nil {
+		missing = append(missing, "glResetHistogram")
+	}
+	if gl.procs.p_glResetMinmax == nil {
+		missing = append(missing, "glResetMinmax")
+	}
+	if gl.procs.p_glActiveTexture == nil {
+		missing = append(missing, "glActiveTexture")
+	}
+	if gl.procs.p_glSampleCoverage == nil {
+		missing = append(missing, "glSampleCoverage")
+	}
+	if gl.procs.p_glCompressedTexImage3D == nil {
+		missing = append(missing, "glCompressedTexImage3D")
+	}
+	if gl.procs.p_glCompressedTexImage2D == nil {
+		missing = append(missing, "glCompressedTexImage2D")
+	}
+	if gl.procs.p_glCompressedTexImage1D == nil {
+		missing = append(missing, "glCompressedTexImage1D")
+	}
+	if gl.procs.p_glCompressedTexSubImage3D == nil {
+		missing = append(missing, "glCompressedTexSubImage3D")
+	}
+	if gl.procs.p_glCompressedTexSubImage2D == nil {
+		missing = append(missing, "glCompressedTexSubImage2D")
+	}
+	if gl.procs.p_glCompressedTexSubImage1D == nil {
+		missing = append(missing, "glCompressedTexSubImage1D")
+	}
+	if gl.procs.p_glGetCompressedTexImage == nil {
+		missing = append(missing, "glGetCompressedTexImage")
+	}
+	if gl.procs.p_glClientActiveTexture == nil {
+		missing = append(missing, "glClientActiveTexture")
+	}
+	if gl.procs.p_glMultiTexCoord1d == nil {
+		missing = append(missing, "glMultiTexCoord1d")
+	}
+	if gl.procs.p_glMultiTexCoord1dv == nil {
+		missing = append(missing, "glMultiTexCoord1dv")
+	}
+	if gl.procs.p_glMultiTexCoord1f == nil {
+		missing = append(missing, "glMultiTexCoord1f")
+	}
+	if gl.procs.p_glMultiTexCoord1fv == nil {
+		missing = append(missing, "glMultiTexCoord1fv")
+	}
+	if gl.procs.p_glMultiTexCoord1i == nil {
+		missing = append(missing, "glMultiTexCoord1i")
+	}
+	if gl.procs.p_glMultiTexCoord1iv == nil {
+		missing = append(missing, "glMultiTexCoord1iv")
+	}
+	if gl.procs.p_glMultiTexCoord1s == nil {
+		missing = append(missing, "glMultiTexCoord1s")
+	}
+	if gl.procs.p_glMultiTexCoord1sv == nil {
+		missing = append(missing, "glMultiTexCoord1sv")
+	}
+	if gl.procs.p_glMultiTexCoord2d == nil {
+		missing = append(missing, "glMultiTexCoord2d")
+	}
+	if gl.procs.p_glMultiTexCoord2dv == nil {
+		missing = append(missing, "glMultiTexCoord2dv")
+	}
+	if gl.procs.p_glMultiTexCoord2f == nil {
+		missing = append(missing, "glMultiTexCoord2f")
+	}
+	if gl.procs.p_glMultiTexCoord2fv == nil {
+		missing = append(missing, "glMultiTexCoord2fv")
+	}
+	if gl.procs.p_glMultiTexCoord2i == nil {
+		missing = append(missing, "glMultiTexCoord2i")
+	}
+	if gl.procs.p_glMultiTexCoord2iv == nil {
+		missing = append(missing, "glMultiTexCoord2iv")
+	}
+	if gl.procs.p_glMultiTexCoord2s == nil {
+		missing = append(missing, "glMultiTexCoord2s")
+	}
+	if gl.procs.p_glMultiTexCoord2sv == nil {
+		missing = append(missing, "glMultiTexCoord2sv")
+	}
+	if gl.procs.p_glMultiTexCoord3d == nil {
+		missing = append(missing, "glMultiTexCoord3d")
+	}
+	if gl.procs.p_glMultiTexCoord3dv == nil {
+		missing = append(missing, "glMultiTexCoord3dv")
+	}
+	if gl.procs.p_glMultiTexCoord3f == nil {
+		missing = append(missing, "glMultiTexCoord3f")
+	}
+	if gl.procs.p_glMultiTexCoord3fv == nil {
+		missing = append(missing, "glMultiTexCoord3fv")
+	}
+	if gl.procs.p_glMultiTexCoord3i == nil {
+		missing = append(missing, "glMultiTexCoord3i")
+	}
+	if gl.procs.p_glMultiTexCoord3iv == nil {
+		missing = append(missing, "glMultiTexCoord3iv")
+	}
+	if gl.procs.p_glMultiTexCoord3s == nil {
+		missing = append(missing, "glMultiTexCoord3s")
+	}
+	if gl.procs.p_glMultiTexCoord3sv == nil {
+		missing = append(missing, "glMultiTexCoord3sv")
+	}
+	if gl.procs.p_glMultiTexCoord4d == nil {
+		missing = append(missing, "glMultiTexCoord4d")
+	}
+	if gl.procs.p_glMultiTexCoord4dv == nil {
+		missing = append(missing, "glMultiTexCoord4dv")
+	}
+	if gl.procs.p_glMultiTexCoord4f == nil {
+		missing = append(missing, "glMultiTexCoord4f")
+	}
+	if gl.procs.p_glMultiTexCoord4fv == nil {
+		missing = append(missing, "glMultiTexCoord4fv")
+	}
+	if gl.procs.p_glMultiTexCoord4i == nil {
+		missing = append(missing, "glMultiTexCoord4i")
+	}
+	if gl.procs.p_glMultiTexCoord4iv == nil {
+		missing = append(missing, "glMultiTexCoord4iv")
+	}
+	if gl.procs.p_glMultiTexCoord4s == nil {
+		missing = append(missing, "glMultiTexCoord4s")
+	}
+	if gl.procs.p_glMultiTexCoord4sv == nil {
+		missing = append(missing, "glMultiTexCoord4sv")
+	}
+	if gl.procs.p_glLoadTransposeMatrixf == nil {
+		missing = append(missing, "glLoadTransposeMatrixf")
+	}
+	if gl.procs.p_glLoadTransposeMatrixd == nil {
+		missing = append(missing, "glLoadTransposeMatrixd")
+	}
+	if gl.procs.p_glMultTransposeMatrixf == nil {
+		missing = append(missing, "glMultTransposeMatrixf")
+	}
+	if gl.procs.p_glMultTransposeMatrixd == nil {
+		missing = append(missing, "glMultTransposeMatrixd")
+	}
+	if gl.procs.p_glBlendFuncSeparate == nil {
+		missing = append(missing, "glBlendFuncSeparate")
+	}
+	if gl.procs.p_glMultiDrawArrays == nil {
+		missing = append(missing, "glMultiDrawArrays")
+	}
+	if gl.procs.p_glMultiDrawElements == nil {
+		missing = append(missing, "glMultiDrawElements")
+	}
+	if gl.procs.p_glPointParameterf == nil {
+		missing = append(missing, "glPointParameterf")
+	}
+	if gl.procs.p_glPointParameterfv == nil {
+		missing = append(missing, "glPointParameterfv")
+	}
+	if gl.procs.p_glPointParameteri == nil {
+		missing = append(missing, "glPointParameteri")
+	}
+	if gl.procs.p_glPointParameteriv == nil {
+		missing = append(missing, "glPointParameteriv")
+	}
+	if gl.procs.p_glFogCoordf == nil {
+		missing = append(missing, "glFogCoordf")
+	}
+	if gl.procs.p_glFogCoordfv == nil {
+		missing = append(missing, "glFogCoordfv")
+	}
+	if gl.procs.p_glFogCoordd == nil {
+		missing = append(missing, "glFogCoordd")
+	}
+	if gl.procs.p_glFogCoorddv == nil {
+		missing = append(missing, "glFogCoorddv")
+	}
+	if gl.procs.p_glFogCoordPointer == nil {
+		missing = append(missing, "glFogCoordPointer")
+	}
+	if gl.procs.p_glSecondaryColor3b == nil {
+		missing = append(missing, "glSecondaryColor3b")
+	}
+	if gl.procs.p_glSecondaryColor3bv == nil {
+		missing = append(missing, "glSecondaryColor3bv")
+	}
+	if gl.procs.p_glSecondaryColor3d == nil {
+		missing = append(missing, "glSecondaryColor3d")
+	}
+	if gl.procs.p_glSecondaryColor3dv == nil {
+		missing = append(missing, "glSecondaryColor3dv")
+	}
+	if gl.procs.p_glSecondaryColor3f == nil {
+		missing = append(missing, "glSecondaryColor3f")
+	}
+	if gl.procs.p_glSecondaryColor3fv == nil {
+		missing = append(missing, "glSecondaryColor3fv")
+	}
+	if gl.procs.p_glSecondaryColor3i == nil {
+		missing = append(missing, "glSecondaryColor3i")
+	}
+	if gl.procs.p_glSecondaryColor3iv == nil {
+		missing = append(missing, "glSecondaryColor3iv")
+	}
+	if gl.procs.p_glSecondaryColor3s == nil {
+		missing = append(missing, "glSecondaryColor3s")
+	}
+	if gl.procs.p_glSecondaryColor3sv == nil {
+		missing = append(missing, "glSecondaryColor3sv")
+	}
+	if gl.procs.p_glSecondaryColor3ub == nil {
+		missing = append(missing, "glSecondaryColor3ub")
+	}
+	if gl.procs.p_glSecondaryColor3ubv == nil {
+		missing = append(missing, "glSecondaryColor3ubv")
+	}
+	if gl.procs.p_glSecondaryColor3ui == nil {
+		missing = append(missing, "glSecondaryColor3ui")
+	}
+	if gl.procs.p_glSecondaryColor3uiv == nil {
+		missing = append(missing, "glSecondaryColor3uiv")
+	}
+	if gl.procs.p_glSecondaryColor3us == nil {
+		missing = append(missing, "glSecondaryColor3us")
+	}
+	if gl.procs.p_glSecondaryColor3usv == nil {
+		missing = append(missing, "glSecondaryColor3usv")
+	}
+	if gl.procs.p_glSecondaryColorPointer == nil {
+		missing = append(missing, "glSecondaryColorPointer")
+	}
+	if gl.procs.p_glWindowPos2d == nil {
+		missing = append(missing, "glWindowPos2d")
+	}
+	if gl.procs.p_glWindowPos2dv == nil {
+		missing = append(missing, "glWindowPos2dv")
+	}
+	if gl.procs.p_glWindowPos2f == nil {
+		missing = append(missing, "glWindowPos2f")
+	}
+	if gl.procs.p_glWindowPos2fv == nil {
+		missing = append(missing, "glWindowPos2fv")
+	}
+	if gl.procs.p_glWindowPos2i == nil {
+		missing = append(missing, "glWindowPos2i")
+	}
+	if gl.procs.p_glWindowPos2iv == nil {
+		missing = append(missing, "glWindowPos2iv")
+	}
+	if gl.procs.p_glWindowPos2s == nil {
+		missing = append(missing, "glWindowPos2s")
+	}
+	if gl.procs.p_glWindowPos2sv == nil {
+		missing = append(missing, "glWindowPos2sv")
+	}
+	if gl.procs.p_glWindowPos3d == nil {
+		missing = append(missing, "glWindowPos3d")
+	}
+	if gl.procs.p_glWindowPos3dv == nil {
+		missing = append(missing, "glWindowPos3dv")
+	}
+	if gl.procs.p_glWindowPos3f == nil {
+		missing = append(missing, "glWindowPos3f")
+	}
+	if gl.procs.p_glWindowPos3fv == nil {
+		missing = append(missing, "glWindowPos3fv")
+	}
+	if gl.procs.p_glWindowPos3i == nil {
+		missing = append(missing, "glWindowPos3i")
+	}
+	if gl.procs.p_glWindowPos3iv == nil {
+		missing = append(missing, "glWindowPos3iv")
+	}
+	if gl.procs.p_glWindowPos3s == nil {
+		missing = append(missing, "glWindowPos3s")
+	}
+	if gl.procs.p_glWindowPos3sv == nil {
+		missing = append(missing, "glWindowPos3sv")
+	}
+	if gl.procs.p_glGenQueries == nil {
+		missing = append(missing, "glGenQueries")
+	}
+	if gl.procs.p_glDeleteQueries == nil {
+		missing = append(missing, "glDeleteQueries")
+	}
+	if gl.procs.p_glIsQuery == nil {
+		missing = append(missing, "glIsQuery")
+	}
+	if gl.procs.p_glBeginQuery == nil {
+		missing = append(missing, "glBeginQuery")
+	}
+	if gl.procs.p_glEndQuery == nil {
+		missing = append(missing, "glEndQuery")
+	}
+	if gl.procs.p_glGetQueryiv == nil {
+		missing = append(missing, "glGetQueryiv")
+	}
+	if gl.procs.p_glGetQueryObjectiv == nil {
+		missing = append(missing, "glGetQueryObjectiv")
+	}
+	if gl.procs.p_glGetQueryObjectuiv == nil {
+		missing = append(missing, "glGetQueryObjectuiv")
+	}
+	if gl.procs.p_glBindBuffer == nil {
+		missing = append(missing, "glBindBuffer")
+	}
+	if gl.procs.p_glDeleteBuffers == nil {
+		missing = append(missing, "glDeleteBuffers")
+	}
+	if gl.procs.p_glGenBuffers == nil {
+		missing = append(missing, "glGenBuffers")
+	}
+	if gl.procs.p_glIsBuffer == nil {
+		missing = append(missing, "glIsBuffer")
+	}
+	if gl.procs.p_glBufferData == nil {
+		missing = append(missing, "glBufferData")
+	}
+	if gl.procs.p_glBufferSubData == nil {
+		missing = append(missing, "glBufferSubData")
+	}
+	if gl.procs.p_glGetBufferSubData == nil {
+		missing = append(missing, "glGetBufferSubData")
+	}
+	if gl.procs.p_glMapBuffer == nil {
+		missing = append(missing, "glMapBuffer")
+	}
+	if gl.procs.p_glUnmapBuffer == nil {
+		missing = append(missing, "glUnmapBuffer")
+	}
+	if gl.procs.p_glGetBufferParameteriv == nil {
+		missing = append(missing, "glGetBufferParameteriv")
+	}
+	if gl.procs.p_glGetBufferPointerv == nil {
+		missing = append(missing, "glGetBufferPointerv")
+	}
+	if gl.procs.p_glBlendEquationSeparate == nil {
+		missing = append(missing, "glBlendEquationSeparate")
+	}
+	if gl.procs.p_glDrawBuffers == nil {
+		missing = append(missing, "glDrawBuffers")
+	}
+	if gl.procs.p_glStencilOpSeparate == nil {
+		missing = append(missing, "glStencilOpSeparate")
+	}
+	if gl.procs.p_glStencilFuncSeparate == nil {
+		missing = append(missing, "glStencilFuncSeparate")
+	}
+	if gl.procs.p_glStencilMaskSeparate == nil {
+		missing = append(missing, "glStencilMaskSeparate")
+	}
+	if gl.procs.p_glAttachShader == nil {
+		missing = append(missing, "glAttachShader")
+	}
+	if gl.procs.p_glBindAttribLocation == nil {
+		missing = append(missing, "glBindAttribLocation")
+	}
+	if gl.procs.p_glCompileShader == nil {
+		missing = append(missing, "glCompileShader")
+	}
+	if gl.procs.p_glCreateProgram == nil {
+		missing = append(missing, "glCreateProgram")
+	}
+	if gl.procs.p_glCreateShader == nil {
+		missing = append(missing, "glCreateShader")
+	}
+	if gl.procs.p_glDeleteProgram == nil {
+		missing = append(missing, "glDeleteProgram")
+	}
+	if gl.procs.p_glDeleteShader == nil {
+		missing = append(missing, "glDeleteShader")
+	}
+	if gl.procs.p_glDetachShader == nil {
+		missing = append(missing, "glDetachShader")
+	}
+	if gl.procs.p_glDisableVertexAttribArray == nil {
+		missing = append(missing, "glDisableVertexAttribArray")
+	}
+	if gl.procs.p_glEnableVertexAttribArray == nil {
+		missing = append(missing, "glEnableVertexAttribArray")
+	}
+	if gl.procs.p_glGetActiveAttrib == nil {
+		missing = append(missing, "glGetActiveAttrib")
+	}
+	if gl.procs.p_glGetActiveUniform == nil {
+		missing = append(missing, "glGetActiveUniform")
+	}
+	if gl.procs.p_glGetAttachedShaders == nil {
+		missing = append(missing, "glGetAttachedShaders")
+	}
+	if gl.procs.p_glGetAttribLocation == nil {
+		missing = append(missing, "glGetAttribLocation")
+	}
+	if gl.procs.p_glGetProgramiv == nil {
+		missing = append(missing, "glGetProgramiv")
+	}
+	if gl.procs.p_glGetProgramInfoLog == nil {
+		missing = append(missing, "glGetProgramInfoLog")
+	}
+	if gl.procs.p_glGetShaderiv == nil {
+		missing = append(missing, "glGetShaderiv")
+	}
+	if gl.procs.p_glGetShaderInfoLog == nil {
+		missing = append(missing, "glGetShaderInfoLog")
+	}
+	if gl.procs.p_glGetShaderSource == nil {
+		missing = append(missing, "glGetShaderSource")
+	}
+	if gl.procs.p_glGetUniformLocation == nil {
+		missing = append(missing, "glGetUniformLocation")
+	}
+	if gl.procs.p_glGetUniformfv == nil {
+		missing = append(missing, "glGetUniformfv")
+	}
+	if gl.procs.p_glGetUniformiv == nil {
+		missing = append(missing, "glGetUniformiv")
+	}
+	if gl.procs.p_glGetVertexAttribdv == nil {
+		missing = append(missing, "glGetVertexAttribdv")
+	}
+	if gl.procs.p_glGetVertexAttribfv == nil {
+		missing = append(missing, "glGetVertexAttribfv")
+	}
+	if gl.procs.p_glGetVertexAttribiv == nil {
+		missing = append(missing, "glGetVertexAttribiv")
+	}
+	if gl.procs.p_glGetVertexAttribPointerv == nil {
+		missing = append(missing, "glGetVertexAttribPointerv")
+	}
+	if gl.procs.p_glIsProgram == nil {
+		missing = append(missing, "glIsProgram")
+	}
+	if gl.procs.p_glIsShader == nil {
+		missing = append(missing, "glIsShader")
+	}
+	if gl.procs.p_glLinkProgram == nil {
+		missing = append(missing, "glLinkProgram")
+	}
+	if gl.procs.p_glShaderSource == nil {
+		missing = append(missing, "glShaderSource")
+	}
+	if gl.procs.p_glUseProgram == nil {
+		missing = append(missing, "glUseProgram")
+	}
+	if gl.procs.p_glUniform1f == nil {
+		missing = append(missing, "glUniform1f")
+	}
+	if gl.procs.p_glUniform2f == nil {
+		missing = append(missing, "glUniform2f")
+	}
+	if gl.procs.p_glUniform3f == nil {
+		missing = append(missing, "glUniform3f")
+	}
+	if gl.procs.p_glUniform4f == nil {
+		missing = append(missing, "glUniform4f")
+	}
+	if gl.procs.p_glUniform1i == nil {
+		missing = append(missing, "glUniform1i")
+	}
+	if gl.procs.p_glUniform2i == nil {
+		missing = append(missing, "glUniform2i")
+	}
+	if gl.procs.p_glUniform3i == nil {
+		missing = append(missing, "glUniform3i")
+	}
+	if gl.procs.p_glUniform4i == nil {
+		missing = append(missing, "glUniform4i")
+	}
+	if gl.procs.p_glUniform1fv == nil {
+		missing = append(missing, "glUniform1fv")
+	}
+	if gl.procs.p_glUniform2fv == nil {
+		missing = append(missing, "glUniform2fv")
+	}
+	if gl.procs.p_glUniform3fv == nil {
+		missing = append(missing, "glUniform3fv")
+	}
+	if gl.procs.p_glUniform4fv == nil {
+		missing = append(missing, "glUniform4fv")
+	}
+	if gl.procs.p_glUniform1iv == nil {
+		missing = append(missing, "glUniform1iv")
+	}
+	if gl.procs.p_glUniform2iv == nil {
+		missing = append(missing, "glUniform2iv")
+	}
+	if gl.procs.p_glUniform3iv == nil {
+		missing = append(missing, "glUniform3iv")
+	}
+	if gl.procs.p_glUniform4iv == nil {
+		missing = append(missing, "glUniform4iv")
+	}
+	if gl.procs.p_glUniformMatrix2fv == nil {
+		missing = append(missing, "glUniformMatrix2fv")
+	}
+	if gl.procs.p_glUniformMatrix3fv == nil {
+		missing = append(missing, "glUniformMatrix3fv")
+	}
+	if gl.procs.p_glUniformMatrix4fv == nil {
+		missing = append(missing, "glUniformMatrix4fv")
+	}
+	if gl.procs.p_glValidateProgram == nil {
+		missing = append(missing, "glValidateProgram")
+	}
+	if gl.procs.p_glVertexAttrib1d == nil {
+		missing = append(missing, "glVertexAttrib1d")
+	}
+	if gl.procs.p_glVertexAttrib1dv == nil {
+		missing = append(missing, "glVertexAttrib1dv")
+	}
+	if gl.procs.p_glVertexAttrib1f == nil {
+		missing = append(missing, "glVertexAttrib1f")
+	}
+	if gl.procs.p_glVertexAttrib1fv == nil {
+		missing = append(missing, "glVertexAttrib1fv")
+	}
+	if gl.procs.p_glVertexAttrib1s == nil {
+		missing = append(missing, "glVertexAttrib1s")
+	}
+	if gl.procs.p_glVertexAttrib1sv == nil {
+		missing = append(missing, "glVertexAttrib1sv")
+	}
+	if gl.procs.p_glVertexAttrib2d == nil {
+		missing = append(missing, "glVertexAttrib2d")
+	}
+	if gl.procs.p_glVertexAttrib2dv == nil {
+		missing = append(missing, "glVertexAttrib2dv")
+	}
+	if gl.procs.p_glVertexAttrib2f == nil {
+		missing = append(missing, "glVertexAttrib2f")
+	}
+	if gl.procs.p_glVertexAttrib2fv == nil {
+		missing = append(missing, "glVertexAttrib2fv")
+	}
+	if gl.procs.p_glVertexAttrib2s == nil {
+		missing = append(missing, "glVertexAttrib2s")
+	}
+	if gl.procs.p_glVertexAttrib2sv == nil {
+		missing = append(missing, "glVertexAttrib2sv")
+	}
+	if gl.procs.p_glVertexAttrib3d == nil {
+		missing = append(missing, "glVertexAttrib3d")
+	}
+	if gl.procs.p_glVertexAttrib3dv == nil {
+		missing = append(missing, "glVertexAttrib3dv")
+	}
+	if gl.procs.p_glVertexAttrib3f == nil {
+		missing = append(missing, "glVertexAttrib3f")
+	}
+	if gl.procs.p_glVertexAttrib3fv == nil {
+		missing = append(missing, "glVertexAttrib3fv")
+	}
+	if gl.procs.p_glVertexAttrib3s == nil {
+		missing = append(missing, "glVertexAttrib3s")
+	}
+	if gl.procs.p_glVertexAttrib3sv == nil {
+		missing = append(missing, "glVertexAttrib3sv")
+	}
+	if gl.procs.p_glVertexAttrib4Nbv == nil {
+		missing = append(missing, "glVertexAttrib4Nbv")
+	}
+	if gl.procs.p_glVertexAttrib4Niv == nil {
+		missing = append(missing, "glVertexAttrib4Niv")
+	}
+	if gl.procs.p_glVertexAttrib4Nsv == nil {
+		missing = append(missing, "glVertexAttrib4Nsv")
+	}
+	if gl.procs.p_glVertexAttrib4Nub == nil {
+		missing = append(missing, "glVertexAttrib4Nub")
+	}
+	if gl.procs.p_glVertexAttrib4Nubv == nil {
+		missing = append(missing, "glVertexAttrib4Nubv")
+	}
+	if gl.procs.p_glVertexAttrib4Nuiv == nil {
+		missing = append(missing, "glVertexAttrib4Nuiv")
+	}
+	if gl.procs.p_glVertexAttrib4Nusv == nil {
+		missing = append(missing, "glVertexAttrib4Nusv")
+	}
+	if gl.procs.p_glVertexAttrib4bv == nil {
+		missing = append(missing, "glVertexAttrib4bv")
+	}
+	if gl.procs.p_glVertexAttrib4d == nil {
+		missing = append(missing, "glVertexAttrib4d")
+	}
+	if gl.procs.p_glVertexAttrib4dv == nil {
+		missing = append(missing, "glVertexAttrib4dv")
+	}
+	if gl.procs.p_glVertexAttrib4f == nil {
+		missing = append(missing, "glVertexAttrib4f")
+	}
+	if gl.procs.p_glVertexAttrib4fv == nil {
+		missing = append(missing, "glVertexAttrib4fv")
+	}
+	if gl.procs.p_glVertexAttrib4iv == nil {
+		missing = append(missing, "glVertexAttrib4iv")
+	}
+	if gl.procs.p_glVertexAttrib4s == nil {
+		missing = append(missing, "glVertexAttrib4s")
+	}
+	if gl.procs.p_glVertexAttrib4sv == nil {
+		missing = append(missing, "glVertexAttrib4sv")
+	}
+	if gl.procs.p_glVertexAttrib4ubv == nil {
+		missing = append(missing, "glVertexAttrib4ubv")
+	}
+	if gl.procs.p_glVertexAttrib4uiv == nil {
+		missing = append(missing, "glVertexAttrib4uiv")
+	}
+	if gl.procs.p_glVertexAttrib4usv == nil {
+		missing = append(missing, "glVertexAttrib4usv")
+	}
+	if gl.procs.p_glVertexAttribPointer == nil {
+		missing = append(missing, "glVertexAttribPointer")
+	}
+	if gl.procs.p_glUniformMatrix2x3fv == nil {
+		missing = append(missing, "glUniformMatrix2x3fv")
+	}
+	if gl.procs.p_glUniformMatrix3x2fv == nil {
+		missing = append(missing, "glUniformMatrix3x2fv")
+	}
+	if gl.procs.p_glUniformMatrix2x4fv == nil {
+		missing = append(missing, "glUniformMatrix2x4fv")
+	}
+	if gl.procs.p_glUniformMatrix4x2fv == nil {
+		missing = append(missing, "glUniformMatrix4x2fv")
+	}
+	if gl.procs.p_glUniformMatrix3x4fv == nil {
+		missing = append(missing, "glUniformMatrix3x4fv")
+	}
+	if gl.procs.p_glUniformMatrix4x3fv == nil {
+		missing = append(missing, "glUniformMatrix4x3fv")
+	}
+	if gl.procs.p_glColorMaski == nil {
+		missing = append(missing, "glColorMaski")
+	}
+	if gl.procs.p_glGetBooleani_v == nil {
+		missing = append(missing, "glGetBooleani_v")
+	}
+	if gl.procs.p_glGetIntegeri_v == nil {
+		missing = append(missing, "glGetIntegeri_v")
+	}
+	if gl.procs.p_glEnablei == nil {
+		missing = append(missing, "glEnablei")
+	}
+	if gl.procs.p_glDisablei == nil {
+		missing = append(missing, "glDisablei")
+	}
+	if gl.procs.p_glIsEnabledi == nil {
+		missing = append(missing, "glIsEnabledi")
+	}
+	if gl.procs.p_glBeginTransformFeedback == nil {
+		missing = append(missing, "glBeginTransformFeedback")
+	}
+	if gl.procs.p_glEndTransformFeedback == nil {
+		missing = append(missing, "glEndTransformFeedback")
+	}
+	if gl.procs.p_glBindBufferRange == nil {
+		missing = append(missing, "glBindBufferRange")
+	}
+	if gl.procs.p_glBindBufferBase == nil {
+		missing = append(missing, "glBindBufferBase")
+	}
+	if gl.procs.p_glTransformFeedbackVaryings == nil {
+		missing = append(missing, "glTransformFeedbackVaryings")
+	}
+	if gl.procs.p_glGetTransformFeedbackVarying == nil {
+		missing = append(missing, "glGetTransformFeedbackVarying")
+	}
+	if gl.procs.p_glClampColor == nil {
+		missing = append(missing, "glClampColor")
+	}
+	if gl.procs.p_glBeginConditionalRender == nil {
+		missing = append(missing, "glBeginConditionalRender")
+	}
+	if gl.procs.p_glEndConditionalRender == nil {
+		missing = append(missing, "glEndConditionalRender")
+	}
+	if gl.procs.p_glVertexAttribIPointer == nil {
+		missing = append(missing, "glVertexAttribIPointer")
+	}
+	if gl.procs.p_glGetVertexAttribIiv == nil {
+		missing = append(missing, "glGetVertexAttribIiv")
+	}
+	if gl.procs.p_glGetVertexAttribIuiv == nil {
+		missing = append(missing, "glGetVertexAttribIuiv")
+	}
+	if gl.procs.p_glVertexAttribI1i == nil {
+		missing = append(missing, "glVertexAttribI1i")
+	}
+	if gl.procs.p_glVertexAttribI2i == nil {
+		missing = append(missing, "glVertexAttribI2i")
+	}
+	if gl.procs.p_glVertexAttribI3i == nil {
+		missing = append(missing, "glVertexAttribI3i")
+	}
+	if gl.procs.p_glVertexAttribI4i == nil {
+		missing = append(missing, "glVertexAttribI4i")
+	}
+	if gl.procs.p_glVertexAttribI1ui == nil {
+		missing = append(missing, "glVertexAttribI1ui")
+	}
+	if gl.procs.p_glVertexAttribI2ui == nil {
+		missing = append(missing, "glVertexAttribI2ui")
+	}
+	if gl.procs.p_glVertexAttribI3ui == nil {
+		missing = append(missing, "glVertexAttribI3ui")
+	}
+	if gl.procs.p_glVertexAttribI4ui == nil {
+		missing = append(missing, "glVertexAttribI4ui")
+	}
+	if gl.procs.p_glVertexAttribI1iv == nil {
+		missing = append(missing, "glVertexAttribI1iv")
+	}
+	if gl.procs.p_glVertexAttribI2iv == nil {
+		missing = append(missing, "glVertexAttribI2iv")
+	}
+	if gl.procs.p_glVertexAttribI3iv == nil {
+		missing = append(missing, "glVertexAttribI3iv")
+	}
+	if gl.procs.p_glVertexAttribI4iv == nil {
+		missing = append(missing, "glVertexAttribI4iv")
+	}
+	if gl.procs.p_glVertexAttribI1uiv == nil {
+		missing = append(missing, "glVertexAttribI1uiv")
+	}
+	if gl.procs.p_glVertexAttribI2uiv == nil {
+		missing = append(missing, "glVertexAttribI2uiv")
+	}
+	if gl.procs.p_glVertexAttribI3uiv == nil {
+		missing = append(missing, "glVertexAttribI3uiv")
+	}
+	if gl.procs.p_glVertexAttribI4uiv == nil {
+		missing = append(missing, "glVertexAttribI4uiv")
+	}
+	if gl.procs.p_glVertexAttribI4bv == nil {
+		missing = append(missing, "glVertexAttribI4bv")
+	}
+	if gl.procs.p_glVertexAttribI4sv == nil {
+		missing = append(missing, "glVertexAttribI4sv")
+	}
+	if gl.procs.p_glVertexAttribI4ubv == nil {
+		missing = append(missing, "glVertexAttribI4ubv")
+	}
+	if gl.procs.p_glVertexAttribI4usv == nil {
+		missing = append(missing, "glVertexAttribI4usv")
+	}
+	if gl.procs.p_glGetUniformuiv == nil {
+		missing = append(missing, "glGetUniformuiv")
+	}
+	if gl.procs.p_glBindFragDataLocation == nil {
+		missing = append(missing, "glBindFragDataLocation")
+	}
+	if gl.procs.p_glGetFragDataLocation == nil {
+		missing = append(missing, "glGetFragDataLocation")
+	}
+	if gl.procs.p_glUniform1ui == nil {
+		missing = append(missing, "glUniform1ui")
+	}
+	if gl.procs.p_glUniform2ui == nil {
+		missing = append(missing, "glUniform2ui")
+	}
+	if gl.procs.p_glUniform3ui == nil {
+		missing = append(missing, "glUniform3ui")
+	}
+	if gl.procs.p_glUniform4ui == nil {
+		missing = append(missing, "glUniform4ui")
+	}
+	if gl.procs.p_glUniform1uiv == nil {
+		missing = append(missing, "glUniform1uiv")
+	}
+	if gl.procs.p_glUniform2uiv == nil {
+		missing = append(missing, "glUniform2uiv")
+	}
+	if gl.procs.p_glUniform3uiv == nil {
+		missing = append(missing, "glUniform3uiv")
+	}
+	if gl.procs.p_glUniform4uiv == nil {
+		missing = append(missing, "glUniform4uiv")
+	}
+	if gl.procs.p_glTexParameterIiv == nil {
+		missing = append(missing, "glTexParameterIiv")
+	}
+	if gl.procs.p_glTexParameterIuiv == nil {
+		missing = append(missing, "glTexParameterIuiv")
+	}
+	if gl.procs.p_glGetTexParameterIiv == nil {
+		missing = append(missing, "glGetTexParameterIiv")
+	}
+	if gl.procs.p_glGetTexParameterIuiv == nil {
+		missing = append(missing, "glGetTexParameterIuiv")
+	}
+	if gl.procs.p_glClearBufferiv == nil {
+		missing = append(missing, "glClearBufferiv")
+	}
+	if gl.procs.p_glClearBufferuiv == nil {
+		missing = append(missing, "glClearBufferuiv")
+	}
+	if gl.procs.p_glClearBufferfv == nil {
+		missing = append(missing, "glClearBufferfv")
+	}
+	if gl.procs.p_glClearBufferfi == nil {
+		missing = append(missing, "glClearBufferfi")
+	}
+	if gl.procs.p_glGetStringi == nil {
+		missing = append(missing, "glGetStringi")
+	}
+	if gl.procs.p_glIsRenderbuffer == nil {
+		missing = append(missing, "glIsRenderbuffer")
+	}
+	if gl.procs.p_glBindRenderbuffer == nil {
+		missing = append(missing, "glBindRenderbuffer")
+	}
+	if gl.procs.p_glDeleteRenderbuffers == nil {
+		missing = append(missing, "glDeleteRenderbuffers")
+	}
+	if gl.procs.p_glGenRenderbuffers == nil {
+		missing = append(missing, "glGenRenderbuffers")
+	}
+	if gl.procs.p_glRenderbufferStorage == nil {
+		missing = append(missing, "glRenderbufferStorage")
+	}
+	if gl.procs.p_glGetRenderbufferParameteriv == nil {
+		missing = append(missing, "glGetRenderbufferParameteriv")
+	}
+	if gl.procs.p_glIsFramebuffer == nil {
+		missing = append(missing, "glIsFramebuffer")
+	}
+	if gl.procs.p_glBindFramebuffer == nil {
+		missing = append(missing, "glBindFramebuffer")
+	}
+	if gl.procs.p_glDeleteFramebuffers == nil {
+		missing = append(missing, "glDeleteFramebuffers")
+	}
+	if gl.procs.p_glGenFramebuffers == nil {
+		missing = append(missing, "glGenFramebuffers")
+	}
+	if gl.procs.p_glCheckFramebufferStatus == nil {
+		missing = append(missing, "glCheckFramebufferStatus")
+	}
+	if gl.procs.p_glFramebufferTexture1D == nil {
+		missing = append(missing, "glFramebufferTexture1D")
+	}
+	if gl.procs.p_glFramebufferTexture2D == nil {
+		missing = append(missing, "glFramebufferTexture2D")
+	}
+	if gl.procs.p_glFramebufferTexture3D == nil {
+		missing = append(missing, "glFramebufferTexture3D")
+	}
+	if gl.procs.p_glFramebufferRenderbuffer == nil {
+		missing = append(missing, "glFramebufferRenderbuffer")
+	}
+	if gl.procs.p_glGetFramebufferAttachmentParameteriv == nil {
+		missing = append(missing, "glGetFramebufferAttachmentParameteriv")
+	}
+	if gl.procs.p_glGenerateMipmap == nil {
+		missing = append(missing, "glGenerateMipmap")
+	}
+	if gl.procs.p_glBlitFramebuffer == nil {
+		missing = append(missing, "glBlitFramebuffer")
+	}
+	if gl.procs.p_glRenderbufferStorageMultisample == nil {
+		missing = append(missing, "glRenderbufferStorageMultisample")
+	}
+	if gl.procs.p_glFramebufferTextureLayer == nil {
+		missing = append(missing, "glFramebufferTextureLayer")
+	}
+	if gl.procs.p_glIsRenderbufferEXT == nil {
+		missing = append(missing, "glIsRenderbufferEXT")
+	}
+	if gl.procs.p_glBindRenderbufferEXT == nil {
+		missing = append(missing, "glBindRenderbufferEXT")
+	}
+	if gl.procs.p_glDeleteRenderbuffersEXT == nil {
+		missing = append(missing, "glDeleteRenderbuffersEXT")
+	}
+	if gl.procs.p_glGenRenderbuffersEXT == nil {
+		missing = append(missing, "glGenRenderbuffersEXT")
+	}
+	if gl.procs.p_glRenderbufferStorageEXT == nil {
+		missing = append(missing, "glRenderbufferStorageEXT")
+	}
+	if gl.procs.p_glGetRenderbufferParameterivEXT == nil {
+		missing = append(missing, "glGetRenderbufferParameterivEXT")
+	}
+	if gl.procs.p_glIsFramebufferEXT == nil {
+		missing = append(missing, "glIsFramebufferEXT")
+	}
+	if gl.procs.p_glBindFramebufferEXT == nil {
+		missing = append(missing, "glBindFramebufferEXT")
+	}
+	if gl.procs.p_glDeleteFramebuffersEXT == nil {
+		missing = append(missing, "glDeleteFramebuffersEXT")
+	}
+	if gl.procs.p_glGenFramebuffersEXT == nil {
+		missing = append(missing, "glGenFramebuffersEXT")
+	}
+	if gl.procs.p_glCheckFramebufferStatusEXT == nil {
+		missing = append(missing, "glCheckFramebufferStatusEXT")
+	}
+	if gl.procs.p_glFramebufferTexture1DEXT == nil {
+		missing = append(missing, "glFramebufferTexture1DEXT")
+	}
+	if gl.procs.p_glFramebufferTexture2DEXT == nil {
+		missing = append(missing, "glFramebufferTexture2DEXT")
+	}
+	if gl.procs.p_glFramebufferTexture3DEXT == nil {
+		missing = append(missing, "glFramebufferTexture3DEXT")
+	}
+	if gl.procs.p_glFramebufferRenderbufferEXT == nil {
+		missing = append(missing, "glFramebufferRenderbufferEXT")
+	}
+	if gl.procs.p_glGetFramebufferAttachmentParameterivEXT == nil {
+		missing = append(missing, "glGetFramebufferAttachmentParameterivEXT")
+	}
+	if gl.procs.p_glGenerateMipmapEXT == nil {
+		missing = append(missing, "glGenerateMipmapEXT")
+	}
+	return
+}
+
+func glBoolean(b bool) C.GLboolean {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// glBlendColor (GL_VERSION_1_2)
+func (gl *GL) BlendColor(red float32, green float32, blue float32, alpha float32) {
+	C.__glBlendColor(&gl.procs, C.GLclampf(red), C.GLclampf(green), C.GLclampf(blue), C.GLclampf(alpha))
+}
+
+// glBlendEquation (GL_VERSION_1_2)
+func (gl *GL) BlendEquation(mode uint32) {
+	C.__glBlendEquation(&gl.procs, C.GLenum(mode))
+}
+
+// glDrawRangeElements (GL_VERSION_1_2)
+func (gl *GL) DrawRangeElements(mode uint32, start uint32, end uint32, count int32, type_ uint32, indices unsafe.Pointer) {
+	C.__glDrawRangeElements(&gl.procs, C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count), C.GLenum(type_), indices)
+}
+
+// glTexImage3D (GL_VERSION_1_2)
+func (gl *GL) TexImage3D(target uint32, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format uint32, type_ uint32, pixels unsafe.Pointer) {
+	C.__glTexImage3D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLint(border), C.GLenum(format), C.GLenum(type_), pixels)
+}
+
+// glTexSubImage3D (GL_VERSION_1_2)
+func (gl *GL) TexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, type_ uint32, pixels unsafe.Pointer) {
+	C.__glTexSubImage3D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLenum(format), C.GLenum(type_), pixels)
+}
+
+// glCopyTexSubImage3D (GL_VERSION_1_2)
+func (gl *GL) CopyTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	C.__glCopyTexSubImage3D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// glColorTable (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ColorTable(target uint32, internalformat uint32, width int32, format uint32, type_ uint32, table unsafe.Pointer) {
+	C.__glColorTable(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLenum(format), C.GLenum(type_), table)
+}
+
+// glColorTableParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ColorTableParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glColorTableParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glColorTableParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ColorTableParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glColorTableParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glCopyColorTable (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) CopyColorTable(target uint32, internalformat uint32, x int32, y int32, width int32) {
+	C.__glCopyColorTable(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLint(x), C.GLint(y), C.GLsizei(width))
+}
+
+// glGetColorTable (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetColorTable(target uint32, format uint32, type_ uint32, table unsafe.Pointer) {
+	C.__glGetColorTable(&gl.procs, C.GLenum(target), C.GLenum(format), C.GLenum(type_), table)
+}
+
+// glGetColorTableParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetColorTableParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetColorTableParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glGetColorTableParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetColorTableParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetColorTableParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glColorSubTable (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ColorSubTable(target uint32, start int32, count int32, format uint32, type_ uint32, data unsafe.Pointer) {
+	C.__glColorSubTable(&gl.procs, C.GLenum(target), C.GLsizei(start), C.GLsizei(count), C.GLenum(format), C.GLenum(type_), data)
+}
+
+// glCopyColorSubTable (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) CopyColorSubTable(target uint32, start int32, x int32, y int32, width int32) {
+	C.__glCopyColorSubTable(&gl.procs, C.GLenum(target), C.GLsizei(start), C.GLint(x), C.GLint(y), C.GLsizei(width))
+}
+
+// glConvolutionFilter1D (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionFilter1D(target uint32, internalformat uint32, width int32, format uint32, type_ uint32, image unsafe.Pointer) {
+	C.__glConvolutionFilter1D(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLenum(format), C.GLenum(type_), image)
+}
+
+// glConvolutionFilter2D (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionFilter2D(target uint32, internalformat uint32, width int32, height int32, format uint32, type_ uint32, image unsafe.Pointer) {
+	C.__glConvolutionFilter2D(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLenum(type_), image)
+}
+
+// glConvolutionParameterf (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionParameterf(target uint32, pname uint32, params float32) {
+	C.__glConvolutionParameterf(&gl.procs, C.GLenum(target), C.GLenum(pname), C.GLfloat(params))
+}
+
+// glConvolutionParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glConvolutionParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glConvolutionParameteri (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionParameteri(target uint32, pname uint32, params int32) {
+	C.__glConvolutionParameteri(&gl.procs, C.GLenum(target), C.GLenum(pname), C.GLint(params))
+}
+
+// glConvolutionParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ConvolutionParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glConvolutionParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glCopyConvolutionFilter1D (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) CopyConvolutionFilter1D(target uint32, internalformat uint32, x int32, y int32, width int32) {
+	C.__glCopyConvolutionFilter1D(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLint(x), C.GLint(y), C.GLsizei(width))
+}
+
+// glCopyConvolutionFilter2D (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) CopyConvolutionFilter2D(target uint32, internalformat uint32, x int32, y int32, width int32, height int32) {
+	C.__glCopyConvolutionFilter2D(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// glGetConvolutionFilter (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetConvolutionFilter(target uint32, format uint32, type_ uint32, image unsafe.Pointer) {
+	C.__glGetConvolutionFilter(&gl.procs, C.GLenum(target), C.GLenum(format), C.GLenum(type_), image)
+}
+
+// glGetConvolutionParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetConvolutionParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetConvolutionParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glGetConvolutionParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetConvolutionParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetConvolutionParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetSeparableFilter (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetSeparableFilter(target uint32, format uint32, type_ uint32, row unsafe.Pointer, column unsafe.Pointer, span unsafe.Pointer) {
+	C.__glGetSeparableFilter(&gl.procs, C.GLenum(target), C.GLenum(format), C.GLenum(type_), row, column, span)
+}
+
+// glSeparableFilter2D (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) SeparableFilter2D(target uint32, internalformat uint32, width int32, height int32, format uint32, type_ uint32, row unsafe.Pointer, column unsafe.Pointer) {
+	C.__glSeparableFilter2D(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLenum(type_), row, column)
+}
+
+// glGetHistogram (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetHistogram(target uint32, reset bool, format uint32, type_ uint32, values unsafe.Pointer) {
+	C.__glGetHistogram(&gl.procs, C.GLenum(target), glBoolean(reset), C.GLenum(format), C.GLenum(type_), values)
+}
+
+// glGetHistogramParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetHistogramParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetHistogramParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glGetHistogramParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetHistogramParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetHistogramParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetMinmax (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetMinmax(target uint32, reset bool, format uint32, type_ uint32, values unsafe.Pointer) {
+	C.__glGetMinmax(&gl.procs, C.GLenum(target), glBoolean(reset), C.GLenum(format), C.GLenum(type_), values)
+}
+
+// glGetMinmaxParameterfv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetMinmaxParameterfv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetMinmaxParameterfv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glGetMinmaxParameteriv (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) GetMinmaxParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetMinmaxParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glHistogram (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) Histogram(target uint32, width int32, internalformat uint32, sink bool) {
+	C.__glHistogram(&gl.procs, C.GLenum(target), C.GLsizei(width), C.GLenum(internalformat), glBoolean(sink))
+}
+
+// glMinmax (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) Minmax(target uint32, internalformat uint32, sink bool) {
+	C.__glMinmax(&gl.procs, C.GLenum(target), C.GLenum(internalformat), glBoolean(sink))
+}
+
+// glResetHistogram (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ResetHistogram(target uint32) {
+	C.__glResetHistogram(&gl.procs, C.GLenum(target))
+}
+
+// glResetMinmax (GL_VERSION_1_2_DEPRECATED)
+func (gl *GL) ResetMinmax(target uint32) {
+	C.__glResetMinmax(&gl.procs, C.GLenum(target))
+}
+
+// glActiveTexture (GL_VERSION_1_3)
+func (gl *GL) ActiveTexture(texture uint32) {
+	C.__glActiveTexture(&gl.procs, C.GLenum(texture))
+}
+
+// glSampleCoverage (GL_VERSION_1_3)
+func (gl *GL) SampleCoverage(value float32, invert bool) {
+	C.__glSampleCoverage(&gl.procs, C.GLclampf(value), glBoolean(invert))
+}
+
+// glCompressedTexImage3D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexImage3D(target uint32, level int32, internalformat uint32, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexImage3D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLint(border), C.GLsizei(imageSize), data)
+}
+
+// glCompressedTexImage2D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexImage2D(target uint32, level int32, internalformat uint32, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexImage2D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLint(border), C.GLsizei(imageSize), data)
+}
+
+// glCompressedTexImage1D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexImage1D(target uint32, level int32, internalformat uint32, width int32, border int32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexImage1D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLsizei(width), C.GLint(border), C.GLsizei(imageSize), data)
+}
+
+// glCompressedTexSubImage3D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexSubImage3D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLenum(format), C.GLsizei(imageSize), data)
+}
+
+// glCompressedTexSubImage2D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexSubImage2D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLsizei(imageSize), data)
+}
+
+// glCompressedTexSubImage1D (GL_VERSION_1_3)
+func (gl *GL) CompressedTexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	C.__glCompressedTexSubImage1D(&gl.procs, C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLsizei(width), C.GLenum(format), C.GLsizei(imageSize), data)
+}
+
+// glGetCompressedTexImage (GL_VERSION_1_3)
+func (gl *GL) GetCompressedTexImage(target uint32, level int32, img unsafe.Pointer) {
+	C.__glGetCompressedTexImage(&gl.procs, C.GLenum(target), C.GLint(level), img)
+}
+
+// glClientActiveTexture (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) ClientActiveTexture(texture uint32) {
+	C.__glClientActiveTexture(&gl.procs, C.GLenum(texture))
+}
+
+// glMultiTexCoord1d (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1d(target uint32, s float64) {
+	C.__glMultiTexCoord1d(&gl.procs, C.GLenum(target), C.GLdouble(s))
+}
+
+// glMultiTexCoord1dv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1dv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord1dv(&gl.procs, C.GLenum(target), (*C.GLdouble)(v))
+}
+
+// glMultiTexCoord1f (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1f(target uint32, s float32) {
+	C.__glMultiTexCoord1f(&gl.procs, C.GLenum(target), C.GLfloat(s))
+}
+
+// glMultiTexCoord1fv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1fv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord1fv(&gl.procs, C.GLenum(target), (*C.GLfloat)(v))
+}
+
+// glMultiTexCoord1i (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1i(target uint32, s int32) {
+	C.__glMultiTexCoord1i(&gl.procs, C.GLenum(target), C.GLint(s))
+}
+
+// glMultiTexCoord1iv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1iv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord1iv(&gl.procs, C.GLenum(target), (*C.GLint)(v))
+}
+
+// glMultiTexCoord1s (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1s(target uint32, s int16) {
+	C.__glMultiTexCoord1s(&gl.procs, C.GLenum(target), C.GLshort(s))
+}
+
+// glMultiTexCoord1sv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord1sv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord1sv(&gl.procs, C.GLenum(target), (*C.GLshort)(v))
+}
+
+// glMultiTexCoord2d (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2d(target uint32, s float64, t float64) {
+	C.__glMultiTexCoord2d(&gl.procs, C.GLenum(target), C.GLdouble(s), C.GLdouble(t))
+}
+
+// glMultiTexCoord2dv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2dv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord2dv(&gl.procs, C.GLenum(target), (*C.GLdouble)(v))
+}
+
+// glMultiTexCoord2f (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2f(target uint32, s float32, t float32) {
+	C.__glMultiTexCoord2f(&gl.procs, C.GLenum(target), C.GLfloat(s), C.GLfloat(t))
+}
+
+// glMultiTexCoord2fv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2fv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord2fv(&gl.procs, C.GLenum(target), (*C.GLfloat)(v))
+}
+
+// glMultiTexCoord2i (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2i(target uint32, s int32, t int32) {
+	C.__glMultiTexCoord2i(&gl.procs, C.GLenum(target), C.GLint(s), C.GLint(t))
+}
+
+// glMultiTexCoord2iv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2iv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord2iv(&gl.procs, C.GLenum(target), (*C.GLint)(v))
+}
+
+// glMultiTexCoord2s (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2s(target uint32, s int16, t int16) {
+	C.__glMultiTexCoord2s(&gl.procs, C.GLenum(target), C.GLshort(s), C.GLshort(t))
+}
+
+// glMultiTexCoord2sv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord2sv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord2sv(&gl.procs, C.GLenum(target), (*C.GLshort)(v))
+}
+
+// glMultiTexCoord3d (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3d(target uint32, s float64, t float64, r float64) {
+	C.__glMultiTexCoord3d(&gl.procs, C.GLenum(target), C.GLdouble(s), C.GLdouble(t), C.GLdouble(r))
+}
+
+// glMultiTexCoord3dv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3dv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord3dv(&gl.procs, C.GLenum(target), (*C.GLdouble)(v))
+}
+
+// glMultiTexCoord3f (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3f(target uint32, s float32, t float32, r float32) {
+	C.__glMultiTexCoord3f(&gl.procs, C.GLenum(target), C.GLfloat(s), C.GLfloat(t), C.GLfloat(r))
+}
+
+// glMultiTexCoord3fv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3fv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord3fv(&gl.procs, C.GLenum(target), (*C.GLfloat)(v))
+}
+
+// glMultiTexCoord3i (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3i(target uint32, s int32, t int32, r int32) {
+	C.__glMultiTexCoord3i(&gl.procs, C.GLenum(target), C.GLint(s), C.GLint(t), C.GLint(r))
+}
+
+// glMultiTexCoord3iv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3iv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord3iv(&gl.procs, C.GLenum(target), (*C.GLint)(v))
+}
+
+// glMultiTexCoord3s (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3s(target uint32, s int16, t int16, r int16) {
+	C.__glMultiTexCoord3s(&gl.procs, C.GLenum(target), C.GLshort(s), C.GLshort(t), C.GLshort(r))
+}
+
+// glMultiTexCoord3sv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord3sv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord3sv(&gl.procs, C.GLenum(target), (*C.GLshort)(v))
+}
+
+// glMultiTexCoord4d (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4d(target uint32, s float64, t float64, r float64, q float64) {
+	C.__glMultiTexCoord4d(&gl.procs, C.GLenum(target), C.GLdouble(s), C.GLdouble(t), C.GLdouble(r), C.GLdouble(q))
+}
+
+// glMultiTexCoord4dv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4dv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord4dv(&gl.procs, C.GLenum(target), (*C.GLdouble)(v))
+}
+
+// glMultiTexCoord4f (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4f(target uint32, s float32, t float32, r float32, q float32) {
+	C.__glMultiTexCoord4f(&gl.procs, C.GLenum(target), C.GLfloat(s), C.GLfloat(t), C.GLfloat(r), C.GLfloat(q))
+}
+
+// glMultiTexCoord4fv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4fv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord4fv(&gl.procs, C.GLenum(target), (*C.GLfloat)(v))
+}
+
+// glMultiTexCoord4i (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4i(target uint32, s int32, t int32, r int32, q int32) {
+	C.__glMultiTexCoord4i(&gl.procs, C.GLenum(target), C.GLint(s), C.GLint(t), C.GLint(r), C.GLint(q))
+}
+
+// glMultiTexCoord4iv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4iv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord4iv(&gl.procs, C.GLenum(target), (*C.GLint)(v))
+}
+
+// glMultiTexCoord4s (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4s(target uint32, s int16, t int16, r int16, q int16) {
+	C.__glMultiTexCoord4s(&gl.procs, C.GLenum(target), C.GLshort(s), C.GLshort(t), C.GLshort(r), C.GLshort(q))
+}
+
+// glMultiTexCoord4sv (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultiTexCoord4sv(target uint32, v unsafe.Pointer) {
+	C.__glMultiTexCoord4sv(&gl.procs, C.GLenum(target), (*C.GLshort)(v))
+}
+
+// glLoadTransposeMatrixf (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) LoadTransposeMatrixf(m unsafe.Pointer) {
+	C.__glLoadTransposeMatrixf(&gl.procs, (*C.GLfloat)(m))
+}
+
+// glLoadTransposeMatrixd (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) LoadTransposeMatrixd(m unsafe.Pointer) {
+	C.__glLoadTransposeMatrixd(&gl.procs, (*C.GLdouble)(m))
+}
+
+// glMultTransposeMatrixf (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultTransposeMatrixf(m unsafe.Pointer) {
+	C.__glMultTransposeMatrixf(&gl.procs, (*C.GLfloat)(m))
+}
+
+// glMultTransposeMatrixd (GL_VERSION_1_3_DEPRECATED)
+func (gl *GL) MultTransposeMatrixd(m unsafe.Pointer) {
+	C.__glMultTransposeMatrixd(&gl.procs, (*C.GLdouble)(m))
+}
+
+// glBlendFuncSeparate (GL_VERSION_1_4)
+func (gl *GL) BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	C.__glBlendFuncSeparate(&gl.procs, C.GLenum(sfactorRGB), C.GLenum(dfactorRGB), C.GLenum(sfactorAlpha), C.GLenum(dfactorAlpha))
+}
+
+// glMultiDrawArrays (GL_VERSION_1_4)
+func (gl *GL) MultiDrawArrays(mode uint32, first unsafe.Pointer, count unsafe.Pointer, primcount int32) {
+	C.__glMultiDrawArrays(&gl.procs, C.GLenum(mode), (*C.GLint)(first), (*C.GLsizei)(count), C.GLsizei(primcount))
+}
+
+// glMultiDrawElements (GL_VERSION_1_4)
+func (gl *GL) MultiDrawElements(mode uint32, count unsafe.Pointer, type_ uint32, indices unsafe.Pointer, primcount int32) {
+	C.__glMultiDrawElements(&gl.procs, C.GLenum(mode), (*C.GLsizei)(count), C.GLenum(type_), (*unsafe.Pointer)(indices), C.GLsizei(primcount))
+}
+
+// glPointParameterf (GL_VERSION_1_4)
+func (gl *GL) PointParameterf(pname uint32, param float32) {
+	C.__glPointParameterf(&gl.procs, C.GLenum(pname), C.GLfloat(param))
+}
+
+// glPointParameterfv (GL_VERSION_1_4)
+func (gl *GL) PointParameterfv(pname uint32, params unsafe.Pointer) {
+	C.__glPointParameterfv(&gl.procs, C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glPointParameteri (GL_VERSION_1_4)
+func (gl *GL) PointParameteri(pname uint32, param int32) {
+	C.__glPointParameteri(&gl.procs, C.GLenum(pname), C.GLint(param))
+}
+
+// glPointParameteriv (GL_VERSION_1_4)
+func (gl *GL) PointParameteriv(pname uint32, params unsafe.Pointer) {
+	C.__glPointParameteriv(&gl.procs, C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glFogCoordf (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) FogCoordf(coord float32) {
+	C.__glFogCoordf(&gl.procs, C.GLfloat(coord))
+}
+
+// glFogCoordfv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) FogCoordfv(coord unsafe.Pointer) {
+	C.__glFogCoordfv(&gl.procs, (*C.GLfloat)(coord))
+}
+
+// glFogCoordd (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) FogCoordd(coord float64) {
+	C.__glFogCoordd(&gl.procs, C.GLdouble(coord))
+}
+
+// glFogCoorddv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) FogCoorddv(coord unsafe.Pointer) {
+	C.__glFogCoorddv(&gl.procs, (*C.GLdouble)(coord))
+}
+
+// glFogCoordPointer (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) FogCoordPointer(type_ uint32, stride int32, pointer unsafe.Pointer) {
+	C.__glFogCoordPointer(&gl.procs, C.GLenum(type_), C.GLsizei(stride), pointer)
+}
+
+// glSecondaryColor3b (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3b(red int8, green int8, blue int8) {
+	C.__glSecondaryColor3b(&gl.procs, C.GLbyte(red), C.GLbyte(green), C.GLbyte(blue))
+}
+
+// glSecondaryColor3bv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3bv(v unsafe.Pointer) {
+	C.__glSecondaryColor3bv(&gl.procs, (*C.GLbyte)(v))
+}
+
+// glSecondaryColor3d (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3d(red float64, green float64, blue float64) {
+	C.__glSecondaryColor3d(&gl.procs, C.GLdouble(red), C.GLdouble(green), C.GLdouble(blue))
+}
+
+// glSecondaryColor3dv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3dv(v unsafe.Pointer) {
+	C.__glSecondaryColor3dv(&gl.procs, (*C.GLdouble)(v))
+}
+
+// glSecondaryColor3f (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3f(red float32, green float32, blue float32) {
+	C.__glSecondaryColor3f(&gl.procs, C.GLfloat(red), C.GLfloat(green), C.GLfloat(blue))
+}
+
+// glSecondaryColor3fv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3fv(v unsafe.Pointer) {
+	C.__glSecondaryColor3fv(&gl.procs, (*C.GLfloat)(v))
+}
+
+// glSecondaryColor3i (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3i(red int32, green int32, blue int32) {
+	C.__glSecondaryColor3i(&gl.procs, C.GLint(red), C.GLint(green), C.GLint(blue))
+}
+
+// glSecondaryColor3iv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3iv(v unsafe.Pointer) {
+	C.__glSecondaryColor3iv(&gl.procs, (*C.GLint)(v))
+}
+
+// glSecondaryColor3s (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3s(red int16, green int16, blue int16) {
+	C.__glSecondaryColor3s(&gl.procs, C.GLshort(red), C.GLshort(green), C.GLshort(blue))
+}
+
+// glSecondaryColor3sv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3sv(v unsafe.Pointer) {
+	C.__glSecondaryColor3sv(&gl.procs, (*C.GLshort)(v))
+}
+
+// glSecondaryColor3ub (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3ub(red uint8, green uint8, blue uint8) {
+	C.__glSecondaryColor3ub(&gl.procs, C.GLubyte(red), C.GLubyte(green), C.GLubyte(blue))
+}
+
+// glSecondaryColor3ubv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3ubv(v unsafe.Pointer) {
+	C.__glSecondaryColor3ubv(&gl.procs, (*C.GLubyte)(v))
+}
+
+// glSecondaryColor3ui (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3ui(red uint32, green uint32, blue uint32) {
+	C.__glSecondaryColor3ui(&gl.procs, C.GLuint(red), C.GLuint(green), C.GLuint(blue))
+}
+
+// glSecondaryColor3uiv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3uiv(v unsafe.Pointer) {
+	C.__glSecondaryColor3uiv(&gl.procs, (*C.GLuint)(v))
+}
+
+// glSecondaryColor3us (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3us(red uint16, green uint16, blue uint16) {
+	C.__glSecondaryColor3us(&gl.procs, C.GLushort(red), C.GLushort(green), C.GLushort(blue))
+}
+
+// glSecondaryColor3usv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColor3usv(v unsafe.Pointer) {
+	C.__glSecondaryColor3usv(&gl.procs, (*C.GLushort)(v))
+}
+
+// glSecondaryColorPointer (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) SecondaryColorPointer(size int32, type_ uint32, stride int32, pointer unsafe.Pointer) {
+	C.__glSecondaryColorPointer(&gl.procs, C.GLint(size), C.GLenum(type_), C.GLsizei(stride), pointer)
+}
+
+// glWindowPos2d (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2d(x float64, y float64) {
+	C.__glWindowPos2d(&gl.procs, C.GLdouble(x), C.GLdouble(y))
+}
+
+// glWindowPos2dv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2dv(v unsafe.Pointer) {
+	C.__glWindowPos2dv(&gl.procs, (*C.GLdouble)(v))
+}
+
+// glWindowPos2f (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2f(x float32, y float32) {
+	C.__glWindowPos2f(&gl.procs, C.GLfloat(x), C.GLfloat(y))
+}
+
+// glWindowPos2fv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2fv(v unsafe.Pointer) {
+	C.__glWindowPos2fv(&gl.procs, (*C.GLfloat)(v))
+}
+
+// glWindowPos2i (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2i(x int32, y int32) {
+	C.__glWindowPos2i(&gl.procs, C.GLint(x), C.GLint(y))
+}
+
+// glWindowPos2iv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2iv(v unsafe.Pointer) {
+	C.__glWindowPos2iv(&gl.procs, (*C.GLint)(v))
+}
+
+// glWindowPos2s (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2s(x int16, y int16) {
+	C.__glWindowPos2s(&gl.procs, C.GLshort(x), C.GLshort(y))
+}
+
+// glWindowPos2sv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos2sv(v unsafe.Pointer) {
+	C.__glWindowPos2sv(&gl.procs, (*C.GLshort)(v))
+}
+
+// glWindowPos3d (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3d(x float64, y float64, z float64) {
+	C.__glWindowPos3d(&gl.procs, C.GLdouble(x), C.GLdouble(y), C.GLdouble(z))
+}
+
+// glWindowPos3dv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3dv(v unsafe.Pointer) {
+	C.__glWindowPos3dv(&gl.procs, (*C.GLdouble)(v))
+}
+
+// glWindowPos3f (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3f(x float32, y float32, z float32) {
+	C.__glWindowPos3f(&gl.procs, C.GLfloat(x), C.GLfloat(y), C.GLfloat(z))
+}
+
+// glWindowPos3fv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3fv(v unsafe.Pointer) {
+	C.__glWindowPos3fv(&gl.procs, (*C.GLfloat)(v))
+}
+
+// glWindowPos3i (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3i(x int32, y int32, z int32) {
+	C.__glWindowPos3i(&gl.procs, C.GLint(x), C.GLint(y), C.GLint(z))
+}
+
+// glWindowPos3iv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3iv(v unsafe.Pointer) {
+	C.__glWindowPos3iv(&gl.procs, (*C.GLint)(v))
+}
+
+// glWindowPos3s (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3s(x int16, y int16, z int16) {
+	C.__glWindowPos3s(&gl.procs, C.GLshort(x), C.GLshort(y), C.GLshort(z))
+}
+
+// glWindowPos3sv (GL_VERSION_1_4_DEPRECATED)
+func (gl *GL) WindowPos3sv(v unsafe.Pointer) {
+	C.__glWindowPos3sv(&gl.procs, (*C.GLshort)(v))
+}
+
+// glGenQueries (GL_VERSION_1_5)
+func (gl *GL) GenQueries(n int32, ids unsafe.Pointer) {
+	C.__glGenQueries(&gl.procs, C.GLsizei(n), (*C.GLuint)(ids))
+}
+
+// glDeleteQueries (GL_VERSION_1_5)
+func (gl *GL) DeleteQueries(n int32, ids unsafe.Pointer) {
+	C.__glDeleteQueries(&gl.procs, C.GLsizei(n), (*C.GLuint)(ids))
+}
+
+// glIsQuery (GL_VERSION_1_5)
+func (gl *GL) IsQuery(id uint32) bool {
+	return C.__glIsQuery(&gl.procs, C.GLuint(id)) != 0
+}
+
+// glBeginQuery (GL_VERSION_1_5)
+func (gl *GL) BeginQuery(target uint32, id uint32) {
+	C.__glBeginQuery(&gl.procs, C.GLenum(target), C.GLuint(id))
+}
+
+// glEndQuery (GL_VERSION_1_5)
+func (gl *GL) EndQuery(target uint32) {
+	C.__glEndQuery(&gl.procs, C.GLenum(target))
+}
+
+// glGetQueryiv (GL_VERSION_1_5)
+func (gl *GL) GetQueryiv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetQueryiv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetQueryObjectiv (GL_VERSION_1_5)
+func (gl *GL) GetQueryObjectiv(id uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetQueryObjectiv(&gl.procs, C.GLuint(id), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetQueryObjectuiv (GL_VERSION_1_5)
+func (gl *GL) GetQueryObjectuiv(id uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetQueryObjectuiv(&gl.procs, C.GLuint(id), C.GLenum(pname), (*C.GLuint)(params))
+}
+
+// glBindBuffer (GL_VERSION_1_5)
+func (gl *GL) BindBuffer(target uint32, buffer uint32) {
+	C.__glBindBuffer(&gl.procs, C.GLenum(target), C.GLuint(buffer))
+}
+
+// glDeleteBuffers (GL_VERSION_1_5)
+func (gl *GL) DeleteBuffers(n int32, buffers unsafe.Pointer) {
+	C.__glDeleteBuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(buffers))
+}
+
+// glGenBuffers (GL_VERSION_1_5)
+func (gl *GL) GenBuffers(n int32, buffers unsafe.Pointer) {
+	C.__glGenBuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(buffers))
+}
+
+// glIsBuffer (GL_VERSION_1_5)
+func (gl *GL) IsBuffer(buffer uint32) bool {
+	return C.__glIsBuffer(&gl.procs, C.GLuint(buffer)) != 0
+}
+
+// glBufferData (GL_VERSION_1_5)
+func (gl *GL) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	C.__glBufferData(&gl.procs, C.GLenum(target), C.GLsizeiptr(size), data, C.GLenum(usage))
+}
+
+// glBufferSubData (GL_VERSION_1_5)
+func (gl *GL) BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	C.__glBufferSubData(&gl.procs, C.GLenum(target), C.GLintptr(offset), C.GLsizeiptr(size), data)
+}
+
+// glGetBufferSubData (GL_VERSION_1_5)
+func (gl *GL) GetBufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	C.__glGetBufferSubData(&gl.procs, C.GLenum(target), C.GLintptr(offset), C.GLsizeiptr(size), data)
+}
+
+// glMapBuffer (GL_VERSION_1_5)
+func (gl *GL) MapBuffer(target uint32, access uint32) unsafe.Pointer {
+	return C.__glMapBuffer(&gl.procs, C.GLenum(target), C.GLenum(access))
+}
+
+// glUnmapBuffer (GL_VERSION_1_5)
+func (gl *GL) UnmapBuffer(target uint32) bool {
+	return C.__glUnmapBuffer(&gl.procs, C.GLenum(target)) != 0
+}
+
+// glGetBufferParameteriv (GL_VERSION_1_5)
+func (gl *GL) GetBufferParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetBufferParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetBufferPointerv (GL_VERSION_1_5)
+func (gl *GL) GetBufferPointerv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetBufferPointerv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*unsafe.Pointer)(params))
+}
+
+// glBlendEquationSeparate (GL_VERSION_2_0)
+func (gl *GL) BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	C.__glBlendEquationSeparate(&gl.procs, C.GLenum(modeRGB), C.GLenum(modeAlpha))
+}
+
+// glDrawBuffers (GL_VERSION_2_0)
+func (gl *GL) DrawBuffers(n int32, bufs unsafe.Pointer) {
+	C.__glDrawBuffers(&gl.procs, C.GLsizei(n), (*C.GLenum)(bufs))
+}
+
+// glStencilOpSeparate (GL_VERSION_2_0)
+func (gl *GL) StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	C.__glStencilOpSeparate(&gl.procs, C.GLenum(face), C.GLenum(sfail), C.GLenum(dpfail), C.GLenum(dppass))
+}
+
+// glStencilFuncSeparate (GL_VERSION_2_0)
+func (gl *GL) StencilFuncSeparate(face uint32, func_ uint32, ref int32, mask uint32) {
+	C.__glStencilFuncSeparate(&gl.procs, C.GLenum(face), C.GLenum(func_), C.GLint(ref), C.GLuint(mask))
+}
+
+// glStencilMaskSeparate (GL_VERSION_2_0)
+func (gl *GL) StencilMaskSeparate(face uint32, mask uint32) {
+	C.__glStencilMaskSeparate(&gl.procs, C.GLenum(face), C.GLuint(mask))
+}
+
+// glAttachShader (GL_VERSION_2_0)
+func (gl *GL) AttachShader(program uint32, shader uint32) {
+	C.__glAttachShader(&gl.procs, C.GLuint(program), C.GLuint(shader))
+}
+
+// glBindAttribLocation (GL_VERSION_2_0)
+func (gl *GL) BindAttribLocation(program uint32, index uint32, name unsafe.Pointer) {
+	C.__glBindAttribLocation(&gl.procs, C.GLuint(program), C.GLuint(index), (*C.GLchar)(name))
+}
+
+// glCompileShader (GL_VERSION_2_0)
+func (gl *GL) CompileShader(shader uint32) {
+	C.__glCompileShader(&gl.procs, C.GLuint(shader))
+}
+
+// glCreateProgram (GL_VERSION_2_0)
+func (gl *GL) CreateProgram() uint32 {
+	return uint32(C.__glCreateProgram(&gl.procs))
+}
+
+// glCreateShader (GL_VERSION_2_0)
+func (gl *GL) CreateShader(type_ uint32) uint32 {
+	return uint32(C.__glCreateShader(&gl.procs, C.GLenum(type_)))
+}
+
+// glDeleteProgram (GL_VERSION_2_0)
+func (gl *GL) DeleteProgram(program uint32) {
+	C.__glDeleteProgram(&gl.procs, C.GLuint(program))
+}
+
+// glDeleteShader (GL_VERSION_2_0)
+func (gl *GL) DeleteShader(shader uint32) {
+	C.__glDeleteShader(&gl.procs, C.GLuint(shader))
+}
+
+// glDetachShader (GL_VERSION_2_0)
+func (gl *GL) DetachShader(program uint32, shader uint32) {
+	C.__glDetachShader(&gl.procs, C.GLuint(program), C.GLuint(shader))
+}
+
+// glDisableVertexAttribArray (GL_VERSION_2_0)
+func (gl *GL) DisableVertexAttribArray(index uint32) {
+	C.__glDisableVertexAttribArray(&gl.procs, C.GLuint(index))
+}
+
+// glEnableVertexAttribArray (GL_VERSION_2_0)
+func (gl *GL) EnableVertexAttribArray(index uint32) {
+	C.__glEnableVertexAttribArray(&gl.procs, C.GLuint(index))
+}
+
+// glGetActiveAttrib (GL_VERSION_2_0)
+func (gl *GL) GetActiveAttrib(program uint32, index uint32, bufSize int32, length unsafe.Pointer, size unsafe.Pointer, type_ unsafe.Pointer, name unsafe.Pointer) {
+	C.__glGetActiveAttrib(&gl.procs, C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLint)(size), (*C.GLenum)(type_), (*C.GLchar)(name))
+}
+
+// glGetActiveUniform (GL_VERSION_2_0)
+func (gl *GL) GetActiveUniform(program uint32, index uint32, bufSize int32, length unsafe.Pointer, size unsafe.Pointer, type_ unsafe.Pointer, name unsafe.Pointer) {
+	C.__glGetActiveUniform(&gl.procs, C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLint)(size), (*C.GLenum)(type_), (*C.GLchar)(name))
+}
+
+// glGetAttachedShaders (GL_VERSION_2_0)
+func (gl *GL) GetAttachedShaders(program uint32, maxCount int32, count unsafe.Pointer, obj unsafe.Pointer) {
+	C.__glGetAttachedShaders(&gl.procs, C.GLuint(program), C.GLsizei(maxCount), (*C.GLsizei)(count), (*C.GLuint)(obj))
+}
+
+// glGetAttribLocation (GL_VERSION_2_0)
+func (gl *GL) GetAttribLocation(program uint32, name unsafe.Pointer) int32 {
+	return int32(C.__glGetAttribLocation(&gl.procs, C.GLuint(program), (*C.GLchar)(name)))
+}
+
+// glGetProgramiv (GL_VERSION_2_0)
+func (gl *GL) GetProgramiv(program uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetProgramiv(&gl.procs, C.GLuint(program), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetProgramInfoLog (GL_VERSION_2_0)
+func (gl *GL) GetProgramInfoLog(program uint32, bufSize int32, length unsafe.Pointer, infoLog unsafe.Pointer) {
+	C.__glGetProgramInfoLog(&gl.procs, C.GLuint(program), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLchar)(infoLog))
+}
+
+// glGetShaderiv (GL_VERSION_2_0)
+func (gl *GL) GetShaderiv(shader uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetShaderiv(&gl.procs, C.GLuint(shader), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetShaderInfoLog (GL_VERSION_2_0)
+func (gl *GL) GetShaderInfoLog(shader uint32, bufSize int32, length unsafe.Pointer, infoLog unsafe.Pointer) {
+	C.__glGetShaderInfoLog(&gl.procs, C.GLuint(shader), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLchar)(infoLog))
+}
+
+// glGetShaderSource (GL_VERSION_2_0)
+func (gl *GL) GetShaderSource(shader uint32, bufSize int32, length unsafe.Pointer, source unsafe.Pointer) {
+	C.__glGetShaderSource(&gl.procs, C.GLuint(shader), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLchar)(source))
+}
+
+// glGetUniformLocation (GL_VERSION_2_0)
+func (gl *GL) GetUniformLocation(program uint32, name unsafe.Pointer) int32 {
+	return int32(C.__glGetUniformLocation(&gl.procs, C.GLuint(program), (*C.GLchar)(name)))
+}
+
+// glGetUniformfv (GL_VERSION_2_0)
+func (gl *GL) GetUniformfv(program uint32, location int32, params unsafe.Pointer) {
+	C.__glGetUniformfv(&gl.procs, C.GLuint(program), C.GLint(location), (*C.GLfloat)(params))
+}
+
+// glGetUniformiv (GL_VERSION_2_0)
+func (gl *GL) GetUniformiv(program uint32, location int32, params unsafe.Pointer) {
+	C.__glGetUniformiv(&gl.procs, C.GLuint(program), C.GLint(location), (*C.GLint)(params))
+}
+
+// glGetVertexAttribdv (GL_VERSION_2_0)
+func (gl *GL) GetVertexAttribdv(index uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetVertexAttribdv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*C.GLdouble)(params))
+}
+
+// glGetVertexAttribfv (GL_VERSION_2_0)
+func (gl *GL) GetVertexAttribfv(index uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetVertexAttribfv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*C.GLfloat)(params))
+}
+
+// glGetVertexAttribiv (GL_VERSION_2_0)
+func (gl *GL) GetVertexAttribiv(index uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetVertexAttribiv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetVertexAttribPointerv (GL_VERSION_2_0)
+func (gl *GL) GetVertexAttribPointerv(index uint32, pname uint32, pointer unsafe.Pointer) {
+	C.__glGetVertexAttribPointerv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*unsafe.Pointer)(pointer))
+}
+
+// glIsProgram (GL_VERSION_2_0)
+func (gl *GL) IsProgram(program uint32) bool {
+	return C.__glIsProgram(&gl.procs, C.GLuint(program)) != 0
+}
+
+// glIsShader (GL_VERSION_2_0)
+func (gl *GL) IsShader(shader uint32) bool {
+	return C.__glIsShader(&gl.procs, C.GLuint(shader)) != 0
+}
+
+// glLinkProgram (GL_VERSION_2_0)
+func (gl *GL) LinkProgram(program uint32) {
+	C.__glLinkProgram(&gl.procs, C.GLuint(program))
+}
+
+// glShaderSource (GL_VERSION_2_0)
+func (gl *GL) ShaderSource(shader uint32, count int32, string_ unsafe.Pointer, length unsafe.Pointer) {
+	C.__glShaderSource(&gl.procs, C.GLuint(shader), C.GLsizei(count), (**C.GLchar)(string_), (*C.GLint)(length))
+}
+
+// glUseProgram (GL_VERSION_2_0)
+func (gl *GL) UseProgram(program uint32) {
+	C.__glUseProgram(&gl.procs, C.GLuint(program))
+}
+
+// glUniform1f (GL_VERSION_2_0)
+func (gl *GL) Uniform1f(location int32, v0 float32) {
+	C.__glUniform1f(&gl.procs, C.GLint(location), C.GLfloat(v0))
+}
+
+// glUniform2f (GL_VERSION_2_0)
+func (gl *GL) Uniform2f(location int32, v0 float32, v1 float32) {
+	C.__glUniform2f(&gl.procs, C.GLint(location), C.GLfloat(v0), C.GLfloat(v1))
+}
+
+// glUniform3f (GL_VERSION_2_0)
+func (gl *GL) Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	C.__glUniform3f(&gl.procs, C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2))
+}
+
+// glUniform4f (GL_VERSION_2_0)
+func (gl *GL) Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	C.__glUniform4f(&gl.procs, C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2), C.GLfloat(v3))
+}
+
+// glUniform1i (GL_VERSION_2_0)
+func (gl *GL) Uniform1i(location int32, v0 int32) {
+	C.__glUniform1i(&gl.procs, C.GLint(location), C.GLint(v0))
+}
+
+// glUniform2i (GL_VERSION_2_0)
+func (gl *GL) Uniform2i(location int32, v0 int32, v1 int32) {
+	C.__glUniform2i(&gl.procs, C.GLint(location), C.GLint(v0), C.GLint(v1))
+}
+
+// glUniform3i (GL_VERSION_2_0)
+func (gl *GL) Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	C.__glUniform3i(&gl.procs, C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2))
+}
+
+// glUniform4i (GL_VERSION_2_0)
+func (gl *GL) Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	C.__glUniform4i(&gl.procs, C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2), C.GLint(v3))
+}
+
+// glUniform1fv (GL_VERSION_2_0)
+func (gl *GL) Uniform1fv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform1fv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLfloat)(value))
+}
+
+// glUniform2fv (GL_VERSION_2_0)
+func (gl *GL) Uniform2fv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform2fv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLfloat)(value))
+}
+
+// glUniform3fv (GL_VERSION_2_0)
+func (gl *GL) Uniform3fv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform3fv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLfloat)(value))
+}
+
+// glUniform4fv (GL_VERSION_2_0)
+func (gl *GL) Uniform4fv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform4fv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLfloat)(value))
+}
+
+// glUniform1iv (GL_VERSION_2_0)
+func (gl *GL) Uniform1iv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform1iv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLint)(value))
+}
+
+// glUniform2iv (GL_VERSION_2_0)
+func (gl *GL) Uniform2iv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform2iv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLint)(value))
+}
+
+// glUniform3iv (GL_VERSION_2_0)
+func (gl *GL) Uniform3iv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform3iv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLint)(value))
+}
+
+// glUniform4iv (GL_VERSION_2_0)
+func (gl *GL) Uniform4iv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform4iv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLint)(value))
+}
+
+// glUniformMatrix2fv (GL_VERSION_2_0)
+func (gl *GL) UniformMatrix2fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix2fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix3fv (GL_VERSION_2_0)
+func (gl *GL) UniformMatrix3fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix3fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix4fv (GL_VERSION_2_0)
+func (gl *GL) UniformMatrix4fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix4fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glValidateProgram (GL_VERSION_2_0)
+func (gl *GL) ValidateProgram(program uint32) {
+	C.__glValidateProgram(&gl.procs, C.GLuint(program))
+}
+
+// glVertexAttrib1d (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1d(index uint32, x float64) {
+	C.__glVertexAttrib1d(&gl.procs, C.GLuint(index), C.GLdouble(x))
+}
+
+// glVertexAttrib1dv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1dv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib1dv(&gl.procs, C.GLuint(index), (*C.GLdouble)(v))
+}
+
+// glVertexAttrib1f (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1f(index uint32, x float32) {
+	C.__glVertexAttrib1f(&gl.procs, C.GLuint(index), C.GLfloat(x))
+}
+
+// glVertexAttrib1fv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1fv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib1fv(&gl.procs, C.GLuint(index), (*C.GLfloat)(v))
+}
+
+// glVertexAttrib1s (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1s(index uint32, x int16) {
+	C.__glVertexAttrib1s(&gl.procs, C.GLuint(index), C.GLshort(x))
+}
+
+// glVertexAttrib1sv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib1sv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib1sv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttrib2d (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2d(index uint32, x float64, y float64) {
+	C.__glVertexAttrib2d(&gl.procs, C.GLuint(index), C.GLdouble(x), C.GLdouble(y))
+}
+
+// glVertexAttrib2dv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2dv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib2dv(&gl.procs, C.GLuint(index), (*C.GLdouble)(v))
+}
+
+// glVertexAttrib2f (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2f(index uint32, x float32, y float32) {
+	C.__glVertexAttrib2f(&gl.procs, C.GLuint(index), C.GLfloat(x), C.GLfloat(y))
+}
+
+// glVertexAttrib2fv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2fv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib2fv(&gl.procs, C.GLuint(index), (*C.GLfloat)(v))
+}
+
+// glVertexAttrib2s (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2s(index uint32, x int16, y int16) {
+	C.__glVertexAttrib2s(&gl.procs, C.GLuint(index), C.GLshort(x), C.GLshort(y))
+}
+
+// glVertexAttrib2sv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib2sv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib2sv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttrib3d (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3d(index uint32, x float64, y float64, z float64) {
+	C.__glVertexAttrib3d(&gl.procs, C.GLuint(index), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z))
+}
+
+// glVertexAttrib3dv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3dv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib3dv(&gl.procs, C.GLuint(index), (*C.GLdouble)(v))
+}
+
+// glVertexAttrib3f (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	C.__glVertexAttrib3f(&gl.procs, C.GLuint(index), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z))
+}
+
+// glVertexAttrib3fv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3fv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib3fv(&gl.procs, C.GLuint(index), (*C.GLfloat)(v))
+}
+
+// glVertexAttrib3s (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3s(index uint32, x int16, y int16, z int16) {
+	C.__glVertexAttrib3s(&gl.procs, C.GLuint(index), C.GLshort(x), C.GLshort(y), C.GLshort(z))
+}
+
+// glVertexAttrib3sv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib3sv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib3sv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttrib4Nbv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nbv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Nbv(&gl.procs, C.GLuint(index), (*C.GLbyte)(v))
+}
+
+// glVertexAttrib4Niv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Niv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Niv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttrib4Nsv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nsv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Nsv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttrib4Nub (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nub(index uint32, x uint8, y uint8, z uint8, w uint8) {
+	C.__glVertexAttrib4Nub(&gl.procs, C.GLuint(index), C.GLubyte(x), C.GLubyte(y), C.GLubyte(z), C.GLubyte(w))
+}
+
+// glVertexAttrib4Nubv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nubv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Nubv(&gl.procs, C.GLuint(index), (*C.GLubyte)(v))
+}
+
+// glVertexAttrib4Nuiv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nuiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Nuiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttrib4Nusv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4Nusv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4Nusv(&gl.procs, C.GLuint(index), (*C.GLushort)(v))
+}
+
+// glVertexAttrib4bv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4bv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4bv(&gl.procs, C.GLuint(index), (*C.GLbyte)(v))
+}
+
+// glVertexAttrib4d (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4d(index uint32, x float64, y float64, z float64, w float64) {
+	C.__glVertexAttrib4d(&gl.procs, C.GLuint(index), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z), C.GLdouble(w))
+}
+
+// glVertexAttrib4dv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4dv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4dv(&gl.procs, C.GLuint(index), (*C.GLdouble)(v))
+}
+
+// glVertexAttrib4f (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	C.__glVertexAttrib4f(&gl.procs, C.GLuint(index), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z), C.GLfloat(w))
+}
+
+// glVertexAttrib4fv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4fv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4fv(&gl.procs, C.GLuint(index), (*C.GLfloat)(v))
+}
+
+// glVertexAttrib4iv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4iv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4iv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttrib4s (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4s(index uint32, x int16, y int16, z int16, w int16) {
+	C.__glVertexAttrib4s(&gl.procs, C.GLuint(index), C.GLshort(x), C.GLshort(y), C.GLshort(z), C.GLshort(w))
+}
+
+// glVertexAttrib4sv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4sv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4sv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttrib4ubv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4ubv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4ubv(&gl.procs, C.GLuint(index), (*C.GLubyte)(v))
+}
+
+// glVertexAttrib4uiv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4uiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4uiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttrib4usv (GL_VERSION_2_0)
+func (gl *GL) VertexAttrib4usv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttrib4usv(&gl.procs, C.GLuint(index), (*C.GLushort)(v))
+}
+
+// glVertexAttribPointer (GL_VERSION_2_0)
+func (gl *GL) VertexAttribPointer(index uint32, size int32, type_ uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	C.__glVertexAttribPointer(&gl.procs, C.GLuint(index), C.GLint(size), C.GLenum(type_), glBoolean(normalized), C.GLsizei(stride), pointer)
+}
+
+// glUniformMatrix2x3fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix2x3fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix2x3fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix3x2fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix3x2fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix3x2fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix2x4fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix2x4fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix2x4fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix4x2fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix4x2fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix4x2fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix3x4fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix3x4fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix3x4fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glUniformMatrix4x3fv (GL_VERSION_2_1)
+func (gl *GL) UniformMatrix4x3fv(location int32, count int32, transpose bool, value unsafe.Pointer) {
+	C.__glUniformMatrix4x3fv(&gl.procs, C.GLint(location), C.GLsizei(count), glBoolean(transpose), (*C.GLfloat)(value))
+}
+
+// glColorMaski (GL_VERSION_3_0)
+func (gl *GL) ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	C.__glColorMaski(&gl.procs, C.GLuint(index), glBoolean(r), glBoolean(g), glBoolean(b), glBoolean(a))
+}
+
+// glGetBooleani_v (GL_VERSION_3_0)
+func (gl *GL) GetBooleani_v(target uint32, index uint32, data unsafe.Pointer) {
+	C.__glGetBooleani_v(&gl.procs, C.GLenum(target), C.GLuint(index), (*C.GLboolean)(data))
+}
+
+// glGetIntegeri_v (GL_VERSION_3_0)
+func (gl *GL) GetIntegeri_v(target uint32, index uint32, data unsafe.Pointer) {
+	C.__glGetIntegeri_v(&gl.procs, C.GLenum(target), C.GLuint(index), (*C.GLint)(data))
+}
+
+// glEnablei (GL_VERSION_3_0)
+func (gl *GL) Enablei(target uint32, index uint32) {
+	C.__glEnablei(&gl.procs, C.GLenum(target), C.GLuint(index))
+}
+
+// glDisablei (GL_VERSION_3_0)
+func (gl *GL) Disablei(target uint32, index uint32) {
+	C.__glDisablei(&gl.procs, C.GLenum(target), C.GLuint(index))
+}
+
+// glIsEnabledi (GL_VERSION_3_0)
+func (gl *GL) IsEnabledi(target uint32, index uint32) bool {
+	return C.__glIsEnabledi(&gl.procs, C.GLenum(target), C.GLuint(index)) != 0
+}
+
+// glBeginTransformFeedback (GL_VERSION_3_0)
+func (gl *GL) BeginTransformFeedback(primitiveMode uint32) {
+	C.__glBeginTransformFeedback(&gl.procs, C.GLenum(primitiveMode))
+}
+
+// glEndTransformFeedback (GL_VERSION_3_0)
+func (gl *GL) EndTransformFeedback() {
+	C.__glEndTransformFeedback(&gl.procs)
+}
+
+// glBindBufferRange (GL_VERSION_3_0)
+func (gl *GL) BindBufferRange(target uint32, index uint32, buffer uint32, offset int, size int) {
+	C.__glBindBufferRange(&gl.procs, C.GLenum(target), C.GLuint(index), C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size))
+}
+
+// glBindBufferBase (GL_VERSION_3_0)
+func (gl *GL) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	C.__glBindBufferBase(&gl.procs, C.GLenum(target), C.GLuint(index), C.GLuint(buffer))
+}
+
+// glTransformFeedbackVaryings (GL_VERSION_3_0)
+func (gl *GL) TransformFeedbackVaryings(program uint32, count int32, varyings unsafe.Pointer, bufferMode uint32) {
+	C.__glTransformFeedbackVaryings(&gl.procs, C.GLuint(program), C.GLsizei(count), (**C.GLchar)(varyings), C.GLenum(bufferMode))
+}
+
+// glGetTransformFeedbackVarying (GL_VERSION_3_0)
+func (gl *GL) GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length unsafe.Pointer, size unsafe.Pointer, type_ unsafe.Pointer, name unsafe.Pointer) {
+	C.__glGetTransformFeedbackVarying(&gl.procs, C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), (*C.GLsizei)(length), (*C.GLsizei)(size), (*C.GLenum)(type_), (*C.GLchar)(name))
+}
+
+// glClampColor (GL_VERSION_3_0)
+func (gl *GL) ClampColor(target uint32, clamp uint32) {
+	C.__glClampColor(&gl.procs, C.GLenum(target), C.GLenum(clamp))
+}
+
+// glBeginConditionalRender (GL_VERSION_3_0)
+func (gl *GL) BeginConditionalRender(id uint32, mode uint32) {
+	C.__glBeginConditionalRender(&gl.procs, C.GLuint(id), C.GLenum(mode))
+}
+
+// glEndConditionalRender (GL_VERSION_3_0)
+func (gl *GL) EndConditionalRender() {
+	C.__glEndConditionalRender(&gl.procs)
+}
+
+// glVertexAttribIPointer (GL_VERSION_3_0)
+func (gl *GL) VertexAttribIPointer(index uint32, size int32, type_ uint32, stride int32, pointer unsafe.Pointer) {
+	C.__glVertexAttribIPointer(&gl.procs, C.GLuint(index), C.GLint(size), C.GLenum(type_), C.GLsizei(stride), pointer)
+}
+
+// glGetVertexAttribIiv (GL_VERSION_3_0)
+func (gl *GL) GetVertexAttribIiv(index uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetVertexAttribIiv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetVertexAttribIuiv (GL_VERSION_3_0)
+func (gl *GL) GetVertexAttribIuiv(index uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetVertexAttribIuiv(&gl.procs, C.GLuint(index), C.GLenum(pname), (*C.GLuint)(params))
+}
+
+// glVertexAttribI1i (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI1i(index uint32, x int32) {
+	C.__glVertexAttribI1i(&gl.procs, C.GLuint(index), C.GLint(x))
+}
+
+// glVertexAttribI2i (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI2i(index uint32, x int32, y int32) {
+	C.__glVertexAttribI2i(&gl.procs, C.GLuint(index), C.GLint(x), C.GLint(y))
+}
+
+// glVertexAttribI3i (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI3i(index uint32, x int32, y int32, z int32) {
+	C.__glVertexAttribI3i(&gl.procs, C.GLuint(index), C.GLint(x), C.GLint(y), C.GLint(z))
+}
+
+// glVertexAttribI4i (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	C.__glVertexAttribI4i(&gl.procs, C.GLuint(index), C.GLint(x), C.GLint(y), C.GLint(z), C.GLint(w))
+}
+
+// glVertexAttribI1ui (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI1ui(index uint32, x uint32) {
+	C.__glVertexAttribI1ui(&gl.procs, C.GLuint(index), C.GLuint(x))
+}
+
+// glVertexAttribI2ui (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI2ui(index uint32, x uint32, y uint32) {
+	C.__glVertexAttribI2ui(&gl.procs, C.GLuint(index), C.GLuint(x), C.GLuint(y))
+}
+
+// glVertexAttribI3ui (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI3ui(index uint32, x uint32, y uint32, z uint32) {
+	C.__glVertexAttribI3ui(&gl.procs, C.GLuint(index), C.GLuint(x), C.GLuint(y), C.GLuint(z))
+}
+
+// glVertexAttribI4ui (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	C.__glVertexAttribI4ui(&gl.procs, C.GLuint(index), C.GLuint(x), C.GLuint(y), C.GLuint(z), C.GLuint(w))
+}
+
+// glVertexAttribI1iv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI1iv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI1iv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttribI2iv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI2iv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI2iv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttribI3iv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI3iv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI3iv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttribI4iv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4iv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4iv(&gl.procs, C.GLuint(index), (*C.GLint)(v))
+}
+
+// glVertexAttribI1uiv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI1uiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI1uiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttribI2uiv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI2uiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI2uiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttribI3uiv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI3uiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI3uiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttribI4uiv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4uiv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4uiv(&gl.procs, C.GLuint(index), (*C.GLuint)(v))
+}
+
+// glVertexAttribI4bv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4bv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4bv(&gl.procs, C.GLuint(index), (*C.GLbyte)(v))
+}
+
+// glVertexAttribI4sv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4sv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4sv(&gl.procs, C.GLuint(index), (*C.GLshort)(v))
+}
+
+// glVertexAttribI4ubv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4ubv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4ubv(&gl.procs, C.GLuint(index), (*C.GLubyte)(v))
+}
+
+// glVertexAttribI4usv (GL_VERSION_3_0)
+func (gl *GL) VertexAttribI4usv(index uint32, v unsafe.Pointer) {
+	C.__glVertexAttribI4usv(&gl.procs, C.GLuint(index), (*C.GLushort)(v))
+}
+
+// glGetUniformuiv (GL_VERSION_3_0)
+func (gl *GL) GetUniformuiv(program uint32, location int32, params unsafe.Pointer) {
+	C.__glGetUniformuiv(&gl.procs, C.GLuint(program), C.GLint(location), (*C.GLuint)(params))
+}
+
+// glBindFragDataLocation (GL_VERSION_3_0)
+func (gl *GL) BindFragDataLocation(program uint32, color uint32, name unsafe.Pointer) {
+	C.__glBindFragDataLocation(&gl.procs, C.GLuint(program), C.GLuint(color), (*C.GLchar)(name))
+}
+
+// glGetFragDataLocation (GL_VERSION_3_0)
+func (gl *GL) GetFragDataLocation(program uint32, name unsafe.Pointer) int32 {
+	return int32(C.__glGetFragDataLocation(&gl.procs, C.GLuint(program), (*C.GLchar)(name)))
+}
+
+// glUniform1ui (GL_VERSION_3_0)
+func (gl *GL) Uniform1ui(location int32, v0 uint32) {
+	C.__glUniform1ui(&gl.procs, C.GLint(location), C.GLuint(v0))
+}
+
+// glUniform2ui (GL_VERSION_3_0)
+func (gl *GL) Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	C.__glUniform2ui(&gl.procs, C.GLint(location), C.GLuint(v0), C.GLuint(v1))
+}
+
+// glUniform3ui (GL_VERSION_3_0)
+func (gl *GL) Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	C.__glUniform3ui(&gl.procs, C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2))
+}
+
+// glUniform4ui (GL_VERSION_3_0)
+func (gl *GL) Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	C.__glUniform4ui(&gl.procs, C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2), C.GLuint(v3))
+}
+
+// glUniform1uiv (GL_VERSION_3_0)
+func (gl *GL) Uniform1uiv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform1uiv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLuint)(value))
+}
+
+// glUniform2uiv (GL_VERSION_3_0)
+func (gl *GL) Uniform2uiv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform2uiv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLuint)(value))
+}
+
+// glUniform3uiv (GL_VERSION_3_0)
+func (gl *GL) Uniform3uiv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform3uiv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLuint)(value))
+}
+
+// glUniform4uiv (GL_VERSION_3_0)
+func (gl *GL) Uniform4uiv(location int32, count int32, value unsafe.Pointer) {
+	C.__glUniform4uiv(&gl.procs, C.GLint(location), C.GLsizei(count), (*C.GLuint)(value))
+}
+
+// glTexParameterIiv (GL_VERSION_3_0)
+func (gl *GL) TexParameterIiv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glTexParameterIiv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glTexParameterIuiv (GL_VERSION_3_0)
+func (gl *GL) TexParameterIuiv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glTexParameterIuiv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLuint)(params))
+}
+
+// glGetTexParameterIiv (GL_VERSION_3_0)
+func (gl *GL) GetTexParameterIiv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetTexParameterIiv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGetTexParameterIuiv (GL_VERSION_3_0)
+func (gl *GL) GetTexParameterIuiv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetTexParameterIuiv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLuint)(params))
+}
+
+// glClearBufferiv (GL_VERSION_3_0)
+func (gl *GL) ClearBufferiv(buffer uint32, drawbuffer int32, value unsafe.Pointer) {
+	C.__glClearBufferiv(&gl.procs, C.GLenum(buffer), C.GLint(drawbuffer), (*C.GLint)(value))
+}
+
+// glClearBufferuiv (GL_VERSION_3_0)
+func (gl *GL) ClearBufferuiv(buffer uint32, drawbuffer int32, value unsafe.Pointer) {
+	C.__glClearBufferuiv(&gl.procs, C.GLenum(buffer), C.GLint(drawbuffer), (*C.GLuint)(value))
+}
+
+// glClearBufferfv (GL_VERSION_3_0)
+func (gl *GL) ClearBufferfv(buffer uint32, drawbuffer int32, value unsafe.Pointer) {
+	C.__glClearBufferfv(&gl.procs, C.GLenum(buffer), C.GLint(drawbuffer), (*C.GLfloat)(value))
+}
+
+// glClearBufferfi (GL_VERSION_3_0)
+func (gl *GL) ClearBufferfi(buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	C.__glClearBufferfi(&gl.procs, C.GLenum(buffer), C.GLint(drawbuffer), C.GLfloat(depth), C.GLint(stencil))
+}
+
+// glGetStringi (GL_VERSION_3_0)
+func (gl *GL) GetStringi(name uint32, index uint32) unsafe.Pointer {
+	return unsafe.Pointer(C.__glGetStringi(&gl.procs, C.GLenum(name), C.GLuint(index)))
+}
+
+// glIsRenderbuffer (GL_ARB_framebuffer_object)
+func (gl *GL) IsRenderbuffer(renderbuffer uint32) bool {
+	return C.__glIsRenderbuffer(&gl.procs, C.GLuint(renderbuffer)) != 0
+}
+
+// glBindRenderbuffer (GL_ARB_framebuffer_object)
+func (gl *GL) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	C.__glBindRenderbuffer(&gl.procs, C.GLenum(target), C.GLuint(renderbuffer))
+}
+
+// glDeleteRenderbuffers (GL_ARB_framebuffer_object)
+func (gl *GL) DeleteRenderbuffers(n int32, renderbuffers unsafe.Pointer) {
+	C.__glDeleteRenderbuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(renderbuffers))
+}
+
+// glGenRenderbuffers (GL_ARB_framebuffer_object)
+func (gl *GL) GenRenderbuffers(n int32, renderbuffers unsafe.Pointer) {
+	C.__glGenRenderbuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(renderbuffers))
+}
+
+// glRenderbufferStorage (GL_ARB_framebuffer_object)
+func (gl *GL) RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	C.__glRenderbufferStorage(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// glGetRenderbufferParameteriv (GL_ARB_framebuffer_object)
+func (gl *GL) GetRenderbufferParameteriv(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetRenderbufferParameteriv(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glIsFramebuffer (GL_ARB_framebuffer_object)
+func (gl *GL) IsFramebuffer(framebuffer uint32) bool {
+	return C.__glIsFramebuffer(&gl.procs, C.GLuint(framebuffer)) != 0
+}
+
+// glBindFramebuffer (GL_ARB_framebuffer_object)
+func (gl *GL) BindFramebuffer(target uint32, framebuffer uint32) {
+	C.__glBindFramebuffer(&gl.procs, C.GLenum(target), C.GLuint(framebuffer))
+}
+
+// glDeleteFramebuffers (GL_ARB_framebuffer_object)
+func (gl *GL) DeleteFramebuffers(n int32, framebuffers unsafe.Pointer) {
+	C.__glDeleteFramebuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(framebuffers))
+}
+
+// glGenFramebuffers (GL_ARB_framebuffer_object)
+func (gl *GL) GenFramebuffers(n int32, framebuffers unsafe.Pointer) {
+	C.__glGenFramebuffers(&gl.procs, C.GLsizei(n), (*C.GLuint)(framebuffers))
+}
+
+// glCheckFramebufferStatus (GL_ARB_framebuffer_object)
+func (gl *GL) CheckFramebufferStatus(target uint32) uint32 {
+	return uint32(C.__glCheckFramebufferStatus(&gl.procs, C.GLenum(target)))
+}
+
+// glFramebufferTexture1D (GL_ARB_framebuffer_object)
+func (gl *GL) FramebufferTexture1D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	C.__glFramebufferTexture1D(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level))
+}
+
+// glFramebufferTexture2D (GL_ARB_framebuffer_object)
+func (gl *GL) FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	C.__glFramebufferTexture2D(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level))
+}
+
+// glFramebufferTexture3D (GL_ARB_framebuffer_object)
+func (gl *GL) FramebufferTexture3D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	C.__glFramebufferTexture3D(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level), C.GLint(zoffset))
+}
+
+// glFramebufferRenderbuffer (GL_ARB_framebuffer_object)
+func (gl *GL) FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	C.__glFramebufferRenderbuffer(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(renderbuffertarget), C.GLuint(renderbuffer))
+}
+
+// glGetFramebufferAttachmentParameteriv (GL_ARB_framebuffer_object)
+func (gl *GL) GetFramebufferAttachmentParameteriv(target uint32, attachment uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetFramebufferAttachmentParameteriv(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGenerateMipmap (GL_ARB_framebuffer_object)
+func (gl *GL) GenerateMipmap(target uint32) {
+	C.__glGenerateMipmap(&gl.procs, C.GLenum(target))
+}
+
+// glBlitFramebuffer (GL_ARB_framebuffer_object)
+func (gl *GL) BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	C.__glBlitFramebuffer(&gl.procs, C.GLint(srcX0), C.GLint(srcY0), C.GLint(srcX1), C.GLint(srcY1), C.GLint(dstX0), C.GLint(dstY0), C.GLint(dstX1), C.GLint(dstY1), C.GLbitfield(mask), C.GLenum(filter))
+}
+
+// glRenderbufferStorageMultisample (GL_ARB_framebuffer_object)
+func (gl *GL) RenderbufferStorageMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32) {
+	C.__glRenderbufferStorageMultisample(&gl.procs, C.GLenum(target), C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// glFramebufferTextureLayer (GL_ARB_framebuffer_object)
+func (gl *GL) FramebufferTextureLayer(target uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	C.__glFramebufferTextureLayer(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLuint(texture), C.GLint(level), C.GLint(layer))
+}
+
+// glIsRenderbufferEXT (GL_EXT_framebuffer_object)
+func (gl *GL) IsRenderbufferEXT(renderbuffer uint32) bool {
+	return C.__glIsRenderbufferEXT(&gl.procs, C.GLuint(renderbuffer)) != 0
+}
+
+// glBindRenderbufferEXT (GL_EXT_framebuffer_object)
+func (gl *GL) BindRenderbufferEXT(target uint32, renderbuffer uint32) {
+	C.__glBindRenderbufferEXT(&gl.procs, C.GLenum(target), C.GLuint(renderbuffer))
+}
+
+// glDeleteRenderbuffersEXT (GL_EXT_framebuffer_object)
+func (gl *GL) DeleteRenderbuffersEXT(n int32, renderbuffers unsafe.Pointer) {
+	C.__glDeleteRenderbuffersEXT(&gl.procs, C.GLsizei(n), (*C.GLuint)(renderbuffers))
+}
+
+// glGenRenderbuffersEXT (GL_EXT_framebuffer_object)
+func (gl *GL) GenRenderbuffersEXT(n int32, renderbuffers unsafe.Pointer) {
+	C.__glGenRenderbuffersEXT(&gl.procs, C.GLsizei(n), (*C.GLuint)(renderbuffers))
+}
+
+// glRenderbufferStorageEXT (GL_EXT_framebuffer_object)
+func (gl *GL) RenderbufferStorageEXT(target uint32, internalformat uint32, width int32, height int32) {
+	C.__glRenderbufferStorageEXT(&gl.procs, C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// glGetRenderbufferParameterivEXT (GL_EXT_framebuffer_object)
+func (gl *GL) GetRenderbufferParameterivEXT(target uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetRenderbufferParameterivEXT(&gl.procs, C.GLenum(target), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glIsFramebufferEXT (GL_EXT_framebuffer_object)
+func (gl *GL) IsFramebufferEXT(framebuffer uint32) bool {
+	return C.__glIsFramebufferEXT(&gl.procs, C.GLuint(framebuffer)) != 0
+}
+
+// glBindFramebufferEXT (GL_EXT_framebuffer_object)
+func (gl *GL) BindFramebufferEXT(target uint32, framebuffer uint32) {
+	C.__glBindFramebufferEXT(&gl.procs, C.GLenum(target), C.GLuint(framebuffer))
+}
+
+// glDeleteFramebuffersEXT (GL_EXT_framebuffer_object)
+func (gl *GL) DeleteFramebuffersEXT(n int32, framebuffers unsafe.Pointer) {
+	C.__glDeleteFramebuffersEXT(&gl.procs, C.GLsizei(n), (*C.GLuint)(framebuffers))
+}
+
+// glGenFramebuffersEXT (GL_EXT_framebuffer_object)
+func (gl *GL) GenFramebuffersEXT(n int32, framebuffers unsafe.Pointer) {
+	C.__glGenFramebuffersEXT(&gl.procs, C.GLsizei(n), (*C.GLuint)(framebuffers))
+}
+
+// glCheckFramebufferStatusEXT (GL_EXT_framebuffer_object)
+func (gl *GL) CheckFramebufferStatusEXT(target uint32) uint32 {
+	return uint32(C.__glCheckFramebufferStatusEXT(&gl.procs, C.GLenum(target)))
+}
+
+// glFramebufferTexture1DEXT (GL_EXT_framebuffer_object)
+func (gl *GL) FramebufferTexture1DEXT(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	C.__glFramebufferTexture1DEXT(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level))
+}
+
+// glFramebufferTexture2DEXT (GL_EXT_framebuffer_object)
+func (gl *GL) FramebufferTexture2DEXT(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	C.__glFramebufferTexture2DEXT(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level))
+}
+
+// glFramebufferTexture3DEXT (GL_EXT_framebuffer_object)
+func (gl *GL) FramebufferTexture3DEXT(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	C.__glFramebufferTexture3DEXT(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level), C.GLint(zoffset))
+}
+
+// glFramebufferRenderbufferEXT (GL_EXT_framebuffer_object)
+func (gl *GL) FramebufferRenderbufferEXT(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	C.__glFramebufferRenderbufferEXT(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(renderbuffertarget), C.GLuint(renderbuffer))
+}
+
+// glGetFramebufferAttachmentParameterivEXT (GL_EXT_framebuffer_object)
+func (gl *GL) GetFramebufferAttachmentParameterivEXT(target uint32, attachment uint32, pname uint32, params unsafe.Pointer) {
+	C.__glGetFramebufferAttachmentParameterivEXT(&gl.procs, C.GLenum(target), C.GLenum(attachment), C.GLenum(pname), (*C.GLint)(params))
+}
+
+// glGenerateMipmapEXT (GL_EXT_framebuffer_object)
+func (gl *GL) GenerateMipmapEXT(target uint32) {
+	C.__glGenerateMipmapEXT(&gl.procs, C.GLenum(target))
+}
@@ -0,0 +1,518 @@
+// Copyright © 2012 Popog
+
+// glgen parses the vendored glext.h and writes the typed Go wrappers and the
+// per-Context proc table used by glml.
+//
+// Usage:
+//
+//	glgen -header glext.h -version 3.0 -ext GL_EXT_framebuffer_object -o gl.go
+//
+// Every GL_VERSION_X_Y block up to and including -version is selected, along
+// with each extension block named in -ext. Functions are loaded through
+// glmlGetProcAddress, which each backend provides in glproc.h.
+//
+// glext.h only declares what came after OpenGL 1.1, so the table never
+// holds the GL 1.0 and 1.1 entry points, e.g. glGetError or glViewport.
+// Those are exported by the system GL library (opengl32.dll on Windows)
+// and glml calls them directly through cgo, linking against it.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	headerFlag     = flag.String("header", "glext.h", "the header to parse")
+	versionFlag    = flag.String("version", "2.1", "the highest GL version to generate, e.g. 3.0")
+	extFlag        = flag.String("ext", "", "comma separated list of extensions to generate")
+	deprecatedFlag = flag.Bool("deprecated", true, "include the GL_VERSION_X_Y_DEPRECATED blocks")
+	outFlag        = flag.String("o", "gl.go", "the output file")
+	packageFlag    = flag.String("package", "glml", "the package of the output file")
+)
+
+var (
+	featureRegexp  = regexp.MustCompile(`^#ifndef\s+(GL_\w+)\s*$`)
+	versionRegexp  = regexp.MustCompile(`^GL_VERSION_(\d+)_(\d+)(_DEPRECATED)?$`)
+	defineRegexp   = regexp.MustCompile(`^#define\s+(GL_\w+)\s+(\S+)\s*$`)
+	functionRegexp = regexp.MustCompile(`^GLAPI\s+(.+?)\s*APIENTRY\s+(gl\w+)\s*\((.*)\)\s*;\s*$`)
+)
+
+// A #define inside a feature block
+type constant struct {
+	name, value string
+}
+
+// A single parameter of a function
+type param struct {
+	name string
+	ctyp string // the C type as written in the header
+}
+
+// A GLAPI prototype inside a feature block
+type function struct {
+	name    string // e.g. glBlendColor
+	ret     string // the C return type as written in the header
+	params  []param
+	feature string
+}
+
+// A #ifndef GL_... block. The header splits each feature into a constants
+// block and a prototypes block with the same name.
+type feature struct {
+	name      string
+	constants []constant
+	functions []function
+}
+
+// Parse every feature block out of a header.
+func parse(r io.Reader) ([]*feature, error) {
+	var features []*feature
+	byName := make(map[string]*feature)
+
+	var current *feature
+	depth, currentDepth := 0, 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "#if"):
+			depth++
+			if m := featureRegexp.FindStringSubmatch(line); m != nil && current == nil && m[1] != "GLEXT_64_TYPES_DEFINED" {
+				if current = byName[m[1]]; current == nil {
+					current = &feature{name: m[1]}
+					byName[m[1]] = current
+					features = append(features, current)
+				}
+				currentDepth = depth
+			}
+			continue
+
+		case strings.HasPrefix(line, "#endif"):
+			if current != nil && depth == currentDepth {
+				current = nil
+			}
+			depth--
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if m := defineRegexp.FindStringSubmatch(line); m != nil {
+			if m[1] != current.name {
+				current.constants = append(current.constants, constant{m[1], m[2]})
+			}
+		} else if m := functionRegexp.FindStringSubmatch(line); m != nil {
+			f, err := parseFunction(m[1], m[2], m[3])
+			if err != nil {
+				return nil, err
+			}
+			f.feature = current.name
+			current.functions = append(current.functions, f)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return features, nil
+}
+
+func parseFunction(ret, name, params string) (function, error) {
+	f := function{name: name, ret: strings.TrimSpace(ret)}
+
+	params = strings.TrimSpace(params)
+	if params == "void" || params == "" {
+		return f, nil
+	}
+
+	for i, p := range strings.Split(params, ",") {
+		p = strings.TrimSpace(p)
+
+		// The name is the trailing identifier
+		split := strings.LastIndexAny(p, " *")
+		if split < 0 {
+			return f, fmt.Errorf("%s: unnamed parameter %d", name, i)
+		}
+		f.params = append(f.params, param{
+			name: strings.TrimSpace(p[split+1:]),
+			ctyp: strings.TrimSpace(p[:split+1]),
+		})
+	}
+	return f, nil
+}
+
+// Whether or not a feature was requested on the command line
+func selected(name string, major, minor int, extensions map[string]bool) bool {
+	if m := versionRegexp.FindStringSubmatch(name); m != nil {
+		if m[3] != "" && !*deprecatedFlag {
+			return false
+		}
+		vmajor, _ := strconv.Atoi(m[1])
+		vminor, _ := strconv.Atoi(m[2])
+		return vmajor < major || (vmajor == major && vminor <= minor)
+	}
+	return extensions[name]
+}
+
+// The Go type used for each scalar GL type
+var goTypes = map[string]string{
+	"GLenum":           "uint32",
+	"GLbitfield":       "uint32",
+	"GLuint":           "uint32",
+	"GLhandleARB":      "uint32",
+	"GLint":            "int32",
+	"GLsizei":          "int32",
+	"GLfloat":          "float32",
+	"GLclampf":         "float32",
+	"GLdouble":         "float64",
+	"GLclampd":         "float64",
+	"GLshort":          "int16",
+	"GLushort":         "uint16",
+	"GLhalfARB":        "uint16",
+	"GLhalfNV":         "uint16",
+	"GLbyte":           "int8",
+	"GLubyte":          "uint8",
+	"GLchar":           "int8",
+	"GLcharARB":        "int8",
+	"GLint64":          "int64",
+	"GLint64EXT":       "int64",
+	"GLuint64":         "uint64",
+	"GLuint64EXT":      "uint64",
+	"GLintptr":         "int",
+	"GLsizeiptr":       "int",
+	"GLintptrARB":      "int",
+	"GLsizeiptrARB":    "int",
+	"GLvdpauSurfaceNV": "int",
+	"GLboolean":        "bool",
+}
+
+// Opaque pointer typedefs are passed as unsafe.Pointer
+var opaqueTypes = map[string]bool{
+	"GLsync":         true,
+	"GLDEBUGPROCARB": true,
+	"GLDEBUGPROCAMD": true,
+}
+
+// Split a C type into its base type and pointer depth, ignoring const
+func splitType(ctyp string) (base string, pointers int) {
+	pointers = strings.Count(ctyp, "*")
+	fields := strings.Fields(strings.Replace(ctyp, "*", " ", -1))
+	var kept []string
+	for _, f := range fields {
+		if f != "const" {
+			kept = append(kept, f)
+		}
+	}
+	return strings.Join(kept, "_"), pointers
+}
+
+// Returns the Go type of a C type
+func goType(ctyp string) (string, error) {
+	base, pointers := splitType(ctyp)
+	if pointers > 0 || opaqueTypes[base] {
+		return "unsafe.Pointer", nil
+	}
+	if t, ok := goTypes[base]; ok {
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown type %q", ctyp)
+}
+
+// Returns the expression converting the Go value v to the C type
+func toC(ctyp, v string) string {
+	base, pointers := splitType(ctyp)
+	switch {
+	case base == "GLboolean" && pointers == 0:
+		return "glBoolean(" + v + ")"
+	case pointers == 0:
+		return "C." + base + "(" + v + ")"
+	}
+
+	// void * is already an unsafe.Pointer
+	if base == "GLvoid" || base == "void" {
+		base, pointers = "unsafe.Pointer", pointers-1
+		if pointers == 0 {
+			return v
+		}
+	} else {
+		base = "C." + base
+	}
+	return "(" + strings.Repeat("*", pointers) + base + ")(" + v + ")"
+}
+
+// Returns the expression converting the C value v to the Go type
+func fromC(ctyp, v string) string {
+	base, pointers := splitType(ctyp)
+	switch {
+	case base == "GLboolean" && pointers == 0:
+		return v + " != 0"
+	case (base == "GLvoid" || base == "void") && pointers == 1:
+		return v
+	case pointers > 0 || opaqueTypes[base]:
+		return "unsafe.Pointer(" + v + ")"
+	}
+	t, _ := goType(ctyp)
+	return t + "(" + v + ")"
+}
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+
+	// predeclared or used by the generated code
+	"string": true, "gl": true, "C": true, "unsafe": true,
+}
+
+func goName(name string) string {
+	if goKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+func pfnName(name string) string {
+	return "PFN" + strings.ToUpper(name) + "PROC"
+}
+
+type generator struct {
+	bytes.Buffer
+	features  []*feature
+	functions []function
+	constants map[string]bool
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.Buffer, format, args...)
+	g.WriteByte('\n')
+}
+
+func (g *generator) generate() error {
+	g.p("// Code generated by glgen; DO NOT EDIT.")
+	g.p("// glgen %s", strings.Join(os.Args[1:], " "))
+	g.p("")
+	g.p("package %s", *packageFlag)
+	g.p("")
+
+	// The C preamble: the proc table, its loader and the call trampolines
+	g.p("// #include \"glproc.h\"")
+	g.p("//")
+	g.p("// typedef struct")
+	g.p("// {")
+	for _, f := range g.functions {
+		g.p("// \t%s p_%s;", pfnName(f.name), f.name)
+	}
+	g.p("// } glProcs;")
+	g.p("//")
+	g.p("// void glLoadProcs(glProcs * procs)")
+	g.p("// {")
+	for _, f := range g.functions {
+		g.p("// \tprocs->p_%s = (%s)glmlGetProcAddress(\"%s\");", f.name, pfnName(f.name), f.name)
+	}
+	g.p("// }")
+	for _, f := range g.functions {
+		var cparams, cargs []string
+		cparams = append(cparams, "glProcs const * procs")
+		for _, p := range f.params {
+			cparams = append(cparams, p.ctyp+" "+p.name)
+			cargs = append(cargs, p.name)
+		}
+		ret := "return "
+		if f.ret == "void" {
+			ret = ""
+		}
+		g.p("//")
+		g.p("// %s __%s(%s)", f.ret, f.name, strings.Join(cparams, ", "))
+		g.p("// { %sprocs->p_%s(%s); }", ret, f.name, strings.Join(cargs, ", "))
+	}
+	g.p("import \"C\"")
+	g.p("import \"unsafe\"")
+	g.p("")
+
+	// The constants, grouped by feature
+	for _, feat := range g.features {
+		var lines []string
+		for _, c := range feat.constants {
+			if g.constants[c.name] {
+				continue
+			}
+			value := strings.TrimRight(c.value, "uUlL")
+			if strings.HasPrefix(value, "GL_") && !g.constants[value] {
+				continue // an alias of something outside of glext.h
+			}
+			g.constants[c.name] = true
+			lines = append(lines, fmt.Sprintf("\t%s = %s", c.name, value))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		g.p("// %s", feat.name)
+		g.p("const (")
+		for _, l := range lines {
+			g.p("%s", l)
+		}
+		g.p(")")
+		g.p("")
+	}
+
+	// The proc table
+	g.p("// The OpenGL functions of a single Context. Function pointers are only")
+	g.p("// valid for the Context they were loaded on, so a GL must only be used")
+	g.p("// on a Thread where its Context is active.")
+	g.p("type GL struct {")
+	g.p("\tprocs  C.glProcs")
+	g.p("\tloaded bool")
+	g.p("}")
+	g.p("")
+	g.p("// Resolve every function pointer through the backend's GetProcAddress.")
+	g.p("// Expects the owning context to be current.")
+	g.p("func (gl *GL) load() {")
+	g.p("\tC.glLoadProcs(&gl.procs)")
+	g.p("\tgl.loaded = true")
+	g.p("}")
+	g.p("")
+	g.p("// Returns true once the function pointers have been loaded")
+	g.p("func (gl *GL) IsLoaded() bool {")
+	g.p("\treturn gl.loaded")
+	g.p("}")
+	g.p("")
+	g.p("// Returns the names of the generated functions the driver did not provide.")
+	g.p("// Calling a missing function will crash, so check this before relying on")
+	g.p("// an extension.")
+	g.p("func (gl *GL) Missing() (missing []string) {")
+	for _, f := range g.functions {
+		g.p("\tif gl.procs.p_%s == nil {", f.name)
+		g.p("\t\tmissing = append(missing, %q)", f.name)
+		g.p("\t}")
+	}
+	g.p("\treturn")
+	g.p("}")
+	g.p("")
+	g.p("func glBoolean(b bool) C.GLboolean {")
+	g.p("\tif b {")
+	g.p("\t\treturn 1")
+	g.p("\t}")
+	g.p("\treturn 0")
+	g.p("}")
+
+	// The wrappers
+	for _, f := range g.functions {
+		var params, args []string
+		args = append(args, "&gl.procs")
+		for _, p := range f.params {
+			t, err := goType(p.ctyp)
+			if err != nil {
+				return fmt.Errorf("%s: %v", f.name, err)
+			}
+			params = append(params, goName(p.name)+" "+t)
+			args = append(args, toC(p.ctyp, goName(p.name)))
+		}
+		call := fmt.Sprintf("C.__%s(%s)", f.name, strings.Join(args, ", "))
+
+		g.p("")
+		g.p("// %s (%s)", f.name, f.feature)
+		if f.ret == "void" {
+			g.p("func (gl *GL) %s(%s) {", f.name[2:], strings.Join(params, ", "))
+			g.p("\t%s", call)
+		} else {
+			ret, err := goType(f.ret)
+			if err != nil {
+				return fmt.Errorf("%s: %v", f.name, err)
+			}
+			g.p("func (gl *GL) %s(%s) %s {", f.name[2:], strings.Join(params, ", "), ret)
+			g.p("\treturn %s", fromC(f.ret, call))
+		}
+		g.p("}")
+	}
+
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "glgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var major, minor int
+	if _, err := fmt.Sscanf(*versionFlag, "%d.%d", &major, &minor); err != nil {
+		return errors.New("-version must be of the form major.minor")
+	}
+
+	extensions := make(map[string]bool)
+	for _, e := range strings.Split(*extFlag, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			extensions[e] = true
+		}
+	}
+
+	header, err := os.Open(*headerFlag)
+	if err != nil {
+		return err
+	}
+	defer header.Close()
+
+	features, err := parse(header)
+	if err != nil {
+		return err
+	}
+
+	g := &generator{constants: make(map[string]bool)}
+	seen := make(map[string]bool)
+	for _, feat := range features {
+		if !selected(feat.name, major, minor, extensions) {
+			continue
+		}
+		delete(extensions, feat.name)
+		g.features = append(g.features, feat)
+
+		// Extensions promoted to core share function names, keep the first
+		for _, f := range feat.functions {
+			if !seen[f.name] {
+				seen[f.name] = true
+				g.functions = append(g.functions, f)
+			}
+		}
+	}
+
+	if len(extensions) != 0 {
+		var missing []string
+		for e := range extensions {
+			missing = append(missing, e)
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("unknown extensions %s", strings.Join(missing, ", "))
+	}
+
+	if err := g.generate(); err != nil {
+		return err
+	}
+
+	source, err := format.Source(g.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(*outFlag, source, 0644)
+}
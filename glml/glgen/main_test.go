@@ -0,0 +1,106 @@
+// Copyright © 2012 Popog
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const testHeader = `
+#ifndef GL_VERSION_1_2
+#define GL_BLEND_COLOR                    0x8005
+#endif
+
+#ifndef GL_VERSION_1_2
+#define GL_VERSION_1_2 1
+#ifdef GL_GLEXT_PROTOTYPES
+GLAPI void APIENTRY glBlendColor (GLclampf red, GLclampf green, GLclampf blue, GLclampf alpha);
+GLAPI const GLubyte * APIENTRY glGetStringi (GLenum name, GLuint index);
+#endif /* GL_GLEXT_PROTOTYPES */
+typedef void (APIENTRYP PFNGLBLENDCOLORPROC) (GLclampf red, GLclampf green, GLclampf blue, GLclampf alpha);
+#endif
+
+#ifndef GL_ARB_sync
+#define GL_ARB_sync 1
+#ifdef GL_GLEXT_PROTOTYPES
+GLAPI void APIENTRY glShaderSource (GLuint shader, GLsizei count, const GLchar* *string, const GLint *length);
+#endif /* GL_GLEXT_PROTOTYPES */
+#endif
+`
+
+func TestParse(t *testing.T) {
+	features, err := parse(strings.NewReader(testHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(features) != 2 {
+		t.Fatalf("expected 2 features, got %d", len(features))
+	}
+
+	v12 := features[0]
+	if v12.name != "GL_VERSION_1_2" {
+		t.Errorf("unexpected feature %s", v12.name)
+	}
+	if len(v12.constants) != 1 || v12.constants[0] != (constant{"GL_BLEND_COLOR", "0x8005"}) {
+		t.Errorf("unexpected constants %v", v12.constants)
+	}
+	if len(v12.functions) != 2 {
+		t.Fatalf("expected 2 functions, got %d", len(v12.functions))
+	}
+	if f := v12.functions[1]; f.name != "glGetStringi" || f.ret != "const GLubyte *" || len(f.params) != 2 {
+		t.Errorf("unexpected function %+v", f)
+	}
+
+	source := features[1].functions[0]
+	if p := source.params[2]; p.name != "string" || p.ctyp != "const GLchar* *" {
+		t.Errorf("unexpected parameter %+v", p)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		ctyp, goType, toC string
+	}{
+		{"GLenum", "uint32", "C.GLenum(v)"},
+		{"GLboolean", "bool", "glBoolean(v)"},
+		{"GLsync", "unsafe.Pointer", "C.GLsync(v)"},
+		{"const GLvoid *", "unsafe.Pointer", "v"},
+		{"const GLvoid* *", "unsafe.Pointer", "(*unsafe.Pointer)(v)"},
+		{"const GLchar* *", "unsafe.Pointer", "(**C.GLchar)(v)"},
+		{"GLfloat *", "unsafe.Pointer", "(*C.GLfloat)(v)"},
+	}
+
+	for _, test := range tests {
+		if goType, err := goType(test.ctyp); err != nil || goType != test.goType {
+			t.Errorf("goType(%q) = %q, %v", test.ctyp, goType, err)
+		}
+		if toC := toC(test.ctyp, "v"); toC != test.toC {
+			t.Errorf("toC(%q) = %q", test.ctyp, toC)
+		}
+	}
+
+	if _, err := goType("GLunknown"); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
+
+func TestSelected(t *testing.T) {
+	extensions := map[string]bool{"GL_ARB_sync": true}
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{"GL_VERSION_1_2", true},
+		{"GL_VERSION_3_0", true},
+		{"GL_VERSION_3_0_DEPRECATED", true},
+		{"GL_VERSION_3_1", false},
+		{"GL_ARB_sync", true},
+		{"GL_ARB_debug_output", false},
+	}
+
+	for _, test := range tests {
+		if selected(test.name, 3, 0, extensions) != test.expected {
+			t.Errorf("selected(%s) != %v", test.name, test.expected)
+		}
+	}
+}
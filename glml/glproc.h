@@ -0,0 +1,14 @@
+#pragma once
+
+// Each backend provides the OpenGL headers and glmlGetProcAddress, which
+// resolves a GL function pointer for the context current on the calling
+// thread. Returns NULL if the function is unavailable.
+
+#ifdef _WIN32
+#include "helper_windows.h"
+#endif
+
+#include <GL/gl.h>
+#include "glext.h"
+
+void * glmlGetProcAddress(const char *name);
@@ -41,6 +41,191 @@ BOOL __GetCursorPos(POINT *lpPoint)
 BOOL __TrackMouseEvent(TRACKMOUSEEVENT *lpEventTrack)
 { return TrackMouseEvent(lpEventTrack); }
 
+// Get the client area of a window in screen coordinates
+BOOL __GetClientScreenRect(HWND hWnd, RECT *lpRect)
+{
+	if (!GetClientRect(hWnd, lpRect))
+		return FALSE;
+
+	MapWindowPoints(hWnd, NULL, (POINT *)lpRect, 2);
+	return TRUE;
+}
+
+// Read the relative motion of a WM_INPUT mouse message. Returns FALSE for
+// other devices and for devices reporting absolute positions (e.g. tablets
+// and remote desktop sessions).
+BOOL __GetRawMouseMotion(LPARAM lParam, LONG *dx, LONG *dy)
+{
+	RAWINPUT raw;
+	UINT size = sizeof(raw);
+	if (GetRawInputData((HRAWINPUT)lParam, RID_INPUT, &raw, &size, sizeof(RAWINPUTHEADER)) == (UINT)-1)
+		return FALSE;
+
+	if (raw.header.dwType != RIM_TYPEMOUSE || (raw.data.mouse.usFlags & MOUSE_MOVE_ABSOLUTE))
+		return FALSE;
+
+	*dx = raw.data.mouse.lLastX;
+	*dy = raw.data.mouse.lLastY;
+	return TRUE;
+}
+
+// RtlGetVersion reports the version Windows really is, where GetVersionEx
+// reports the newest one the executable's manifest declares support for
+typedef LONG (WINAPI *RtlGetVersionProc)(OSVERSIONINFOW *info);
+
+BOOL __IsWindowsBuildOrGreater(DWORD major, DWORD build)
+{
+	RtlGetVersionProc rtlGetVersion = (RtlGetVersionProc)GetProcAddress(GetModuleHandleW(L"ntdll.dll"), "RtlGetVersion");
+	OSVERSIONINFOW info = {sizeof(info)};
+	if (rtlGetVersion == NULL || rtlGetVersion(&info) != 0)
+		return FALSE;
+
+	return info.dwMajorVersion > major || (info.dwMajorVersion == major && info.dwBuildNumber >= build);
+}
+
+// Pointer input is only available from Windows 8, so its functions are
+// looked up rather than linked to keep running on Windows 7, which never
+// sends WM_POINTER messages
+typedef BOOL (WINAPI *GetPointerTypeProc)(UINT32 pointerId, POINTER_INPUT_TYPE *pointerType);
+typedef BOOL (WINAPI *GetPointerInfoProc)(UINT32 pointerId, POINTER_INFO *pointerInfo);
+typedef BOOL (WINAPI *GetPointerPenInfoProc)(UINT32 pointerId, POINTER_PEN_INFO *penInfo);
+
+BOOL __GetPointerSample(HWND hWnd, UINT32 pointerId, POINTERSAMPLE *sample)
+{
+	static GetPointerTypeProc getPointerType;
+	static GetPointerInfoProc getPointerInfo;
+	static GetPointerPenInfoProc getPointerPenInfo;
+	if (getPointerType == NULL)
+	{
+		HMODULE user32 = GetModuleHandleW(L"user32.dll");
+		getPointerInfo = (GetPointerInfoProc)GetProcAddress(user32, "GetPointerInfo");
+		getPointerPenInfo = (GetPointerPenInfoProc)GetProcAddress(user32, "GetPointerPenInfo");
+		getPointerType = (GetPointerTypeProc)GetProcAddress(user32, "GetPointerType");
+	}
+	if (getPointerType == NULL || getPointerInfo == NULL || getPointerPenInfo == NULL)
+		return FALSE;
+
+	ZeroMemory(sample, sizeof(*sample));
+	if (!getPointerType(pointerId, &sample->kind))
+		return FALSE;
+
+	POINTER_INFO info;
+	if (sample->kind == PT_PEN)
+	{
+		POINTER_PEN_INFO pen;
+		if (!getPointerPenInfo(pointerId, &pen))
+			return FALSE;
+
+		info = pen.pointerInfo;
+		sample->penFlags = pen.penFlags;
+		sample->penMask = pen.penMask;
+		sample->pressure = pen.pressure;
+		sample->tiltX = pen.tiltX;
+		sample->tiltY = pen.tiltY;
+	}
+	else if (!getPointerInfo(pointerId, &info))
+		return FALSE;
+
+	sample->flags = info.pointerFlags;
+	sample->position = info.ptPixelLocation;
+	return ScreenToClient(hWnd, &sample->position);
+}
+
+HCURSOR __LoadSystemCursor(WORD id)
+{ return LoadCursorW(NULL, MAKEINTRESOURCEW(id)); }
+
+// Create a cursor from top-down, non-premultiplied BGRA pixels
+HCURSOR __CreateCursorBGRA(int width, int height, const BYTE *pixels, int hotX, int hotY)
+{
+	BITMAPV5HEADER header;
+	ZeroMemory(&header, sizeof(header));
+	header.bV5Size = sizeof(header);
+	header.bV5Width = width;
+	header.bV5Height = -height; // Top-down
+	header.bV5Planes = 1;
+	header.bV5BitCount = 32;
+	header.bV5Compression = BI_BITFIELDS;
+	header.bV5RedMask = 0x00ff0000;
+	header.bV5GreenMask = 0x0000ff00;
+	header.bV5BlueMask = 0x000000ff;
+	header.bV5AlphaMask = 0xff000000;
+
+	BYTE *target = NULL;
+	HDC dc = GetDC(NULL);
+	HBITMAP color = CreateDIBSection(dc, (BITMAPINFO *)&header, DIB_RGB_COLORS, (void **)&target, NULL, 0);
+	ReleaseDC(NULL, dc);
+	if (color == NULL)
+		return NULL;
+
+	// The alpha channel is used instead, but a mask is still required
+	HBITMAP mask = CreateBitmap(width, height, 1, 1, NULL);
+	if (mask == NULL)
+	{
+		DeleteObject(color);
+		return NULL;
+	}
+
+	CopyMemory(target, pixels, (SIZE_T)width * height * 4);
+
+	ICONINFO info;
+	ZeroMemory(&info, sizeof(info));
+	info.fIcon = FALSE;
+	info.xHotspot = hotX;
+	info.yHotspot = hotY;
+	info.hbmMask = mask;
+	info.hbmColor = color;
+	HCURSOR cursor = (HCURSOR)CreateIconIndirect(&info);
+
+	// The cursor keeps copies of the bitmaps
+	DeleteObject(color);
+	DeleteObject(mask);
+	return cursor;
+}
+
+// XInput ships as a different DLL with each version of Windows, so the
+// newest one present is looked up rather than linked. Without one, no
+// controller is ever connected.
+typedef DWORD (WINAPI *XInputGetStateProc)(DWORD index, XINPUTSTATE *state);
+typedef DWORD (WINAPI *XInputSetStateProc)(DWORD index, WORD *vibration);
+
+static XInputGetStateProc xinputGetState;
+static XInputSetStateProc xinputSetState;
+
+static BOOL loadXInput(void)
+{
+	static BOOL loaded;
+	if (!loaded)
+	{
+		static const LPCWSTR names[] = {L"xinput1_4.dll", L"xinput1_3.dll", L"xinput9_1_0.dll"};
+		for (int i = 0; i < sizeof(names) / sizeof(names[0]) && xinputGetState == NULL; i++)
+		{
+			HMODULE module = LoadLibraryW(names[i]);
+			if (module == NULL)
+				continue;
+			xinputGetState = (XInputGetStateProc)GetProcAddress(module, "XInputGetState");
+			xinputSetState = (XInputSetStateProc)GetProcAddress(module, "XInputSetState");
+		}
+		loaded = TRUE;
+	}
+	return xinputGetState != NULL;
+}
+
+DWORD __XInputGetState(DWORD index, XINPUTSTATE *state)
+{
+	if (!loadXInput())
+		return ERROR_DEVICE_NOT_CONNECTED;
+	return xinputGetState(index, state);
+}
+
+DWORD __XInputSetState(DWORD index, WORD strong, WORD weak)
+{
+	// XINPUT_VIBRATION is the left (strong) then right (weak) motor speed
+	WORD vibration[2] = {strong, weak};
+	if (!loadXInput() || xinputSetState == NULL)
+		return ERROR_DEVICE_NOT_CONNECTED;
+	return xinputSetState(index, vibration);
+}
+
 WORD __HIWORD(DWORD dwValue)
 { return HIWORD(dwValue); }
 
@@ -49,3 +234,19 @@ WORD __LOWORD(DWORD dwValue)
 
 ULONG SetWindowULong(HWND hWnd, int nIndex, ULONG dwNewLong)
 { return (ULONG) SetWindowLong(hWnd, nIndex, (LONG)dwNewLong); }
+
+
+void * glmlGetProcAddress(const char *name)
+{
+	void *p = (void *)wglGetProcAddress(name);
+
+	// Some drivers return small integers rather than NULL on failure
+	if (p == NULL || p == (void *)1 || p == (void *)2 || p == (void *)3 || p == (void *)-1)
+	{
+		// OpenGL 1.1 functions are only exported by opengl32.dll
+		HMODULE module = GetModuleHandleA("opengl32.dll");
+		p = module ? (void *)GetProcAddress(module, name) : NULL;
+	}
+
+	return p;
+}
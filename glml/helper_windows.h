@@ -1,6 +1,12 @@
 #pragma once
 
 #define WIN32_LEAN_AND_MEAN 1
+#ifndef _WIN32_WINNT
+#define _WIN32_WINNT 0x0602 // GetTickCount64, and the pointer input types of Windows 8
+#endif
+#ifndef WINVER
+#define WINVER _WIN32_WINNT
+#endif
 #include <windows.h>
 
 #define PIXELFORMATDESCRIPTOR_size sizeof(PIXELFORMATDESCRIPTOR)
@@ -23,6 +29,37 @@ BOOL __GetMonitorInfoW(HMONITOR hMonitor, MONITORINFOEXW *lpmi);
 BOOL __ScreenToClient(HWND hWnd, POINT *lpPoint);
 BOOL __GetCursorPos(POINT *lpPoint);
 BOOL __TrackMouseEvent(TRACKMOUSEEVENT *lpEventTrack);
+BOOL __GetClientScreenRect(HWND hWnd, RECT *lpRect);
+BOOL __GetRawMouseMotion(LPARAM lParam, LONG *dx, LONG *dy);
+BOOL __IsWindowsBuildOrGreater(DWORD major, DWORD build);
+// A touch or pen sample of a WM_POINTER message
+typedef struct
+{
+	POINTER_INPUT_TYPE kind; // PT_TOUCH or PT_PEN
+	POINTER_FLAGS flags;
+	POINT position;          // In client coordinates
+	PEN_FLAGS penFlags;
+	PEN_MASK penMask;        // Which of the following the pen reports
+	UINT32 pressure;         // From 0 to 1024
+	INT32 tiltX, tiltY;      // In degrees, from -90 to 90
+} POINTERSAMPLE;
+
+BOOL __GetPointerSample(HWND hWnd, UINT32 pointerId, POINTERSAMPLE *sample);
+HRESULT __RegisterDropTarget(HWND hWnd);
+
+// The state of an XInput controller, laid out as XINPUT_STATE
+typedef struct
+{
+	DWORD packet;
+	WORD buttons;
+	BYTE leftTrigger, rightTrigger;
+	SHORT thumbLX, thumbLY, thumbRX, thumbRY;
+} XINPUTSTATE;
+
+DWORD __XInputGetState(DWORD index, XINPUTSTATE *state);
+DWORD __XInputSetState(DWORD index, WORD strong, WORD weak);
+HCURSOR __LoadSystemCursor(WORD id);
+HCURSOR __CreateCursorBGRA(int width, int height, const BYTE *pixels, int hotX, int hotY);
 WORD __HIWORD(DWORD dwValue);
 WORD __LOWORD(DWORD dwValue);
 
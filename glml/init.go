@@ -0,0 +1,79 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrNotInitialized     = errors.New("glml: Init has not been called")
+	ErrAlreadyInitialized = errors.New("glml: Init has already been called")
+)
+
+// Options for Init
+type InitOptions struct {
+	// The name under which the OS window class for glml windows is
+	// registered, on platforms which have window classes.
+	WindowClassName string
+}
+
+var InitOptionsDefault = InitOptions{
+	WindowClassName: "go-glml/window.Window",
+}
+
+var (
+	initMutex   sync.Mutex
+	initialized bool
+)
+
+// Initialize the library. This must be called before any window or context
+// is created, and may be called from any goroutine.
+//
+// Importing glml has no side effects: no threads, windows or contexts exist
+// until they are explicitly created. Monitor queries do not require Init.
+func Init(options InitOptions) error {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	if initialized {
+		return ErrAlreadyInitialized
+	}
+
+	if options.WindowClassName == "" {
+		options.WindowClassName = InitOptionsDefault.WindowClassName
+	}
+
+	if err := initializeInternal(options); err != nil {
+		return err
+	}
+
+	initialized = true
+	return nil
+}
+
+// Release everything acquired by Init. All windows and contexts must be
+// closed first. Init may be called again afterwards.
+func Terminate() error {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	if !initialized {
+		return ErrNotInitialized
+	}
+
+	if err := terminateInternal(); err != nil {
+		return err
+	}
+
+	initialized = false
+	return nil
+}
+
+// Returns true between Init and Terminate
+func IsInitialized() bool {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	return initialized
+}
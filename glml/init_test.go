@@ -0,0 +1,74 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// The state of the package before any test touched it
+var (
+	importInitialized bool
+	importEffects     []string
+)
+
+func TestMain(m *testing.M) {
+	importInitialized = IsInitialized()
+	importEffects = importSideEffects()
+
+	if err := Init(InitOptionsDefault); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	Terminate()
+	os.Exit(code)
+}
+
+// Importing the package must not do any of the work of Init
+func TestImportHasNoSideEffects(t *testing.T) {
+	if importInitialized {
+		t.Error("package was initialized on import")
+	}
+	for _, effect := range importEffects {
+		t.Errorf("on import, %s", effect)
+	}
+
+	// Init does all of it, so the checks can see it
+	if effects := importSideEffects(); len(effects) == 0 {
+		t.Error("no effects of Init are visible")
+	}
+}
+
+func TestInit_NotInitialized(t *testing.T) {
+	if err := Terminate(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := Init(InitOptionsDefault); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := Terminate(); err != ErrNotInitialized {
+		t.Errorf("Terminate returned %v", err)
+	}
+
+	if _, err := CreateWindow(nil, VideoMode{640, 480, 32}, "Test", WindowStyleDefault, ContextSettingsDefault); err != ErrNotInitialized {
+		t.Errorf("CreateWindow returned %v", err)
+	}
+
+	thread := CreateThread()
+	defer thread.Close()
+	if err := thread.SetActive(CreateContext()); err == nil {
+		t.Error("context activated before Init")
+	}
+}
+
+func TestInit_AlreadyInitialized(t *testing.T) {
+	if err := Init(InitOptionsDefault); err != ErrAlreadyInitialized {
+		t.Errorf("Init returned %v", err)
+	}
+}
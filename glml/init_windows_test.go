@@ -0,0 +1,42 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	getModuleHandleW = syscall.NewLazyDLL("kernel32.dll").NewProc("GetModuleHandleW")
+	getClassInfoW    = syscall.NewLazyDLL("user32.dll").NewProc("GetClassInfoW")
+)
+
+// Is a window class of this name registered by the test binary?
+func windowClassRegistered(name string) bool {
+	instance, _, _ := getModuleHandleW.Call(0)
+	className, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return false
+	}
+	var info [128]byte // Larger than a WNDCLASSW
+	registered, _, _ := getClassInfoW.Call(instance, uintptr(unsafe.Pointer(className)), uintptr(unsafe.Pointer(&info[0])))
+	return registered != 0
+}
+
+// Describe the state Init sets up which is already present
+func importSideEffects() []string {
+	var effects []string
+	if windowClassRegistered(InitOptionsDefault.WindowClassName) {
+		effects = append(effects, "the window class is registered")
+	}
+	if windowClass.lpszClassName != nil || windowClass.hInstance != nil {
+		effects = append(effects, "the window class is set up")
+	}
+	if lShift != 0 {
+		effects = append(effects, "the left shift scancode is read")
+	}
+	if toUnicodeKeepsState {
+		effects = append(effects, "the Windows version is probed")
+	}
+	return effects
+}
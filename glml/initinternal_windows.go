@@ -0,0 +1,39 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "helper_windows.h"
+import "C"
+import (
+	"fmt"
+)
+
+func initializeInternal(options InitOptions) error {
+	// Used to distinguish between left and right shift
+	lShift = C.MapVirtualKey(C.VK_LSHIFT, C.MAPVK_VK_TO_VSC)
+
+	// Used to translate keys without disturbing a pending dead key
+	toUnicodeKeepsState = C.__IsWindowsBuildOrGreater(10, 14393) != 0
+
+	return registerWindowClass(options.WindowClassName)
+}
+
+func terminateInternal() error {
+	return unregisterWindowClass()
+}
+
+func registerWindowClass(name string) error {
+	windowClass.hInstance = C.HINSTANCE(C.GetModuleHandle(nil))
+	windowClass.lpszClassName, _ = utf16Convert(name)
+	if C.RegisterClassW(&windowClass) == 0 {
+		return fmt.Errorf("RegisterClassW failed (%d)", C.GetLastError())
+	}
+	return nil
+}
+
+// Fails if any window of the class still exists
+func unregisterWindowClass() error {
+	if C.UnregisterClassW(windowClass.lpszClassName, windowClass.hInstance) == C.FALSE {
+		return fmt.Errorf("UnregisterClassW failed (%d)", C.GetLastError())
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+)
+
+// Tracks the keyboard and mouse from events. Unlike IsKeyPressed and
+// IsMouseButtonPressed, which ask the OS, it only sees the input of the
+// windows whose events it's given and always agrees with them.
+//
+// Call Update once per frame with the frame's events, e.g. those returned
+// by ThreadPollEvents. The zero value is ready to use.
+type InputState struct {
+	keys, keysPressed, keysReleased          [KeyCount]bool
+	buttons, buttonsPressed, buttonsReleased [MouseButtonCount]bool
+
+	mouseX, mouseY   int     // The last position of the mouse in the window
+	mouseInside      bool    // Is the mouse over the window?
+	wheel            int     // Notches scrolled this frame
+	scrollX, scrollY float64 // Distance scrolled this frame
+	motionX, motionY int     // Raw motion this frame
+}
+
+// Start a new frame and apply its events
+func (s *InputState) Update(events []Event) {
+	s.keysPressed = [KeyCount]bool{}
+	s.keysReleased = [KeyCount]bool{}
+	s.buttonsPressed = [MouseButtonCount]bool{}
+	s.buttonsReleased = [MouseButtonCount]bool{}
+	s.wheel = 0
+	s.scrollX, s.scrollY = 0, 0
+	s.motionX, s.motionY = 0, 0
+
+	for _, e := range events {
+		s.apply(e)
+	}
+}
+
+// Apply an event to the current frame
+func (s *InputState) apply(e Event) {
+	switch e := e.(type) {
+	case KeyPressedEvent:
+		// Repeats of a held key aren't presses
+		if e.Code >= 0 && e.Code < KeyCount && !s.keys[e.Code] {
+			s.keys[e.Code] = true
+			s.keysPressed[e.Code] = true
+		}
+
+	case KeyReleasedEvent:
+		s.releaseKey(e.Code)
+
+	case WindowLostFocusEvent:
+		// The releases will go to another window
+		for key := Key(0); key < KeyCount; key++ {
+			s.releaseKey(key)
+		}
+		for button := MouseButton(0); button < MouseButtonCount; button++ {
+			s.releaseButton(button)
+		}
+
+	case MouseButtonPressedEvent:
+		s.mouseX, s.mouseY = e.X, e.Y
+		if e.Button < MouseButtonCount && !s.buttons[e.Button] {
+			s.buttons[e.Button] = true
+			s.buttonsPressed[e.Button] = true
+		}
+
+	case MouseButtonReleasedEvent:
+		s.mouseX, s.mouseY = e.X, e.Y
+		s.releaseButton(e.Button)
+
+	case MouseMoveEvent:
+		s.mouseX, s.mouseY = e.X, e.Y
+		s.mouseInside = true
+
+	case MouseRawMotionEvent:
+		s.motionX += e.DX
+		s.motionY += e.DY
+
+	case MouseWheelEvent:
+		s.mouseX, s.mouseY = e.X, e.Y
+		s.wheel += e.Delta
+
+	case MouseScrollEvent:
+		s.mouseX, s.mouseY = e.X, e.Y
+		s.scrollX += e.DeltaX
+		s.scrollY += e.DeltaY
+
+	case MouseEnteredEvent:
+		s.mouseInside = true
+
+	case MouseLeftEvent:
+		s.mouseInside = false
+	}
+}
+
+func (s *InputState) releaseKey(key Key) {
+	if key >= 0 && key < KeyCount && s.keys[key] {
+		s.keys[key] = false
+		s.keysReleased[key] = true
+	}
+}
+
+func (s *InputState) releaseButton(button MouseButton) {
+	if button < MouseButtonCount && s.buttons[button] {
+		s.buttons[button] = false
+		s.buttonsReleased[button] = true
+	}
+}
+
+// Check if a key is held down
+func (s *InputState) KeyDown(key Key) bool {
+	return key >= 0 && key < KeyCount && s.keys[key]
+}
+
+// Check if a key went down this frame. It may have been released again in
+// the same frame.
+func (s *InputState) KeyPressed(key Key) bool {
+	return key >= 0 && key < KeyCount && s.keysPressed[key]
+}
+
+// Check if a key was released this frame
+func (s *InputState) KeyReleased(key Key) bool {
+	return key >= 0 && key < KeyCount && s.keysReleased[key]
+}
+
+// The modifier each modifier key holds
+var modifierKeys = map[Key]Modifiers{
+	KeyLShift:   ModLShift,
+	KeyRShift:   ModRShift,
+	KeyLControl: ModLControl,
+	KeyRControl: ModRControl,
+	KeyLAlt:     ModLAlt,
+	KeyRAlt:     ModRAlt,
+	KeyLSystem:  ModLSystem,
+	KeyRSystem:  ModRSystem,
+}
+
+// Get the modifier keys held down. Lock states aren't tracked.
+func (s *InputState) Modifiers() Modifiers {
+	var mods Modifiers
+	for key, mod := range modifierKeys {
+		if s.keys[key] {
+			mods |= mod
+		}
+	}
+	return mods
+}
+
+// The concrete buttons a button stands for
+func concreteMouseButtons(button MouseButton) []MouseButton {
+	switch button {
+	case MouseButtonPrimary:
+		return []MouseButton{MouseLeftRH, MouseRightLH}
+	case MouseButtonSecondary:
+		return []MouseButton{MouseRightRH, MouseLeftLH}
+	case MouseButtonLeft:
+		return []MouseButton{MouseLeftRH, MouseLeftLH}
+	case MouseButtonRight:
+		return []MouseButton{MouseRightRH, MouseRightLH}
+	}
+
+	if button >= MouseButtonCount {
+		panic(errors.New("button out of range"))
+	}
+	return []MouseButton{button}
+}
+
+// Check whether any of the buttons a button stands for are set
+func anyMouseButton(set *[MouseButtonCount]bool, button MouseButton) bool {
+	for _, b := range concreteMouseButtons(button) {
+		if set[b] {
+			return true
+		}
+	}
+	return false
+}
+
+// Check if a mouse button is held down
+func (s *InputState) ButtonDown(button MouseButton) bool {
+	return anyMouseButton(&s.buttons, button)
+}
+
+// Check if a mouse button went down this frame. It may have been released
+// again in the same frame.
+func (s *InputState) ButtonPressed(button MouseButton) bool {
+	return anyMouseButton(&s.buttonsPressed, button)
+}
+
+// Check if a mouse button was released this frame
+func (s *InputState) ButtonReleased(button MouseButton) bool {
+	return anyMouseButton(&s.buttonsReleased, button)
+}
+
+// Get the last position of the mouse, relative to the top-left of the
+// window
+func (s *InputState) MousePosition() (x, y int) {
+	return s.mouseX, s.mouseY
+}
+
+// Check if the mouse is over the window
+func (s *InputState) MouseInside() bool {
+	return s.mouseInside
+}
+
+// Get the number of wheel notches scrolled this frame (positive is up)
+func (s *InputState) Wheel() int {
+	return s.wheel
+}
+
+// Get the distance scrolled this frame, including less than a notch and
+// horizontally (positive is right and up)
+func (s *InputState) Scroll() (dx, dy float64) {
+	return s.scrollX, s.scrollY
+}
+
+// Get the raw mouse motion this frame, reported in CursorModeRelative
+func (s *InputState) MouseMotion() (dx, dy int) {
+	return s.motionX, s.motionY
+}
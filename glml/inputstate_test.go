@@ -0,0 +1,104 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestInputState_Keys(t *testing.T) {
+	var s InputState
+
+	// A tap within one frame, and a held key with repeats
+	s.Update([]Event{
+		KeyPressedEvent{Code: KeyA},
+		KeyReleasedEvent{Code: KeyA},
+		KeyPressedEvent{Code: KeyW},
+		KeyPressedEvent{Code: KeyUnknown},
+	})
+	if s.KeyDown(KeyA) || !s.KeyPressed(KeyA) || !s.KeyReleased(KeyA) {
+		t.Errorf("expected A to be tapped, got down %v pressed %v released %v", s.KeyDown(KeyA), s.KeyPressed(KeyA), s.KeyReleased(KeyA))
+	}
+	if !s.KeyDown(KeyW) || !s.KeyPressed(KeyW) || s.KeyReleased(KeyW) {
+		t.Error("expected W to be pressed")
+	}
+	if s.KeyDown(KeyUnknown) || s.KeyPressed(KeyCount) {
+		t.Error("expected keys out of range to be up")
+	}
+
+	s.Update([]Event{KeyPressedEvent{Code: KeyW}})
+	if !s.KeyDown(KeyW) || s.KeyPressed(KeyW) || s.KeyPressed(KeyA) || s.KeyReleased(KeyA) {
+		t.Error("expected W to be held without edges")
+	}
+
+	s.Update([]Event{KeyReleasedEvent{Code: KeyW}, KeyReleasedEvent{Code: KeyS}})
+	if s.KeyDown(KeyW) || !s.KeyReleased(KeyW) || s.KeyReleased(KeyS) {
+		t.Error("expected only W to be released")
+	}
+
+	// Losing focus releases everything
+	s.Update([]Event{KeyPressedEvent{Code: KeyD}, MouseButtonPressedEvent{Button: MouseMiddle}})
+	s.Update([]Event{WindowLostFocusEvent{}})
+	if s.KeyDown(KeyD) || !s.KeyReleased(KeyD) || s.ButtonDown(MouseMiddle) || !s.ButtonReleased(MouseMiddle) {
+		t.Error("expected losing focus to release D and the middle button")
+	}
+	s.Update(nil)
+	if s.KeyReleased(KeyD) || s.ButtonReleased(MouseMiddle) {
+		t.Error("expected the releases to last one frame")
+	}
+}
+
+func TestInputState_Mouse(t *testing.T) {
+	var s InputState
+
+	s.Update([]Event{
+		MouseEnteredEvent{},
+		MouseMoveEvent{X: 10, Y: 20},
+		MouseButtonPressedEvent{Button: MouseRightLH, X: 11, Y: 21},
+		MouseScrollEvent{DeltaY: 0.5, X: 12, Y: 22},
+		MouseScrollEvent{DeltaX: -0.25, DeltaY: 0.5, X: 12, Y: 22},
+		MouseWheelEvent{Delta: 1, X: 12, Y: 22},
+		MouseRawMotionEvent{DX: 3, DY: -1},
+		MouseRawMotionEvent{DX: 2, DY: -1},
+	})
+	if x, y := s.MousePosition(); x != 12 || y != 22 || !s.MouseInside() {
+		t.Errorf("expected the mouse inside at 12,22, got %d,%d inside %v", x, y, s.MouseInside())
+	}
+	if dx, dy := s.Scroll(); dx != -0.25 || dy != 1 || s.Wheel() != 1 {
+		t.Errorf("unexpected scrolling %v,%v wheel %d", dx, dy, s.Wheel())
+	}
+	if dx, dy := s.MouseMotion(); dx != 5 || dy != -2 {
+		t.Errorf("unexpected motion %d,%d", dx, dy)
+	}
+
+	// Abstract buttons match their concrete buttons
+	for button, expected := range map[MouseButton]bool{
+		MouseRightLH:         true,
+		MouseButtonPrimary:   true,
+		MouseButtonRight:     true,
+		MouseButtonSecondary: false,
+		MouseButtonLeft:      false,
+		MouseLeftRH:          false,
+	} {
+		if s.ButtonDown(button) != expected || s.ButtonPressed(button) != expected {
+			t.Errorf("%s: expected down and pressed %v", button, expected)
+		}
+	}
+
+	s.Update([]Event{MouseButtonReleasedEvent{Button: MouseRightLH, X: 1, Y: 2}, MouseLeftEvent{}})
+	if s.ButtonDown(MouseButtonPrimary) || !s.ButtonReleased(MouseButtonPrimary) || s.ButtonPressed(MouseButtonPrimary) {
+		t.Error("expected the primary button to be released")
+	}
+	if x, y := s.MousePosition(); x != 1 || y != 2 || s.MouseInside() {
+		t.Errorf("expected the mouse outside at 1,2, got %d,%d inside %v", x, y, s.MouseInside())
+	}
+	if dx, dy := s.Scroll(); dx != 0 || dy != 0 || s.Wheel() != 0 {
+		t.Error("expected scrolling to reset each frame")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a button out of range")
+		}
+	}()
+	s.ButtonDown(MouseButtonCount)
+}
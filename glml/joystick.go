@@ -0,0 +1,568 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The direction of a hat switch (or a directional pad reported as one), a
+// combination of HatUp or HatDown and HatLeft or HatRight
+type Hat uint8
+
+const (
+	HatCentered Hat = 0
+	HatUp       Hat = 1
+	HatRight    Hat = 2
+	HatDown     Hat = 4
+	HatLeft     Hat = 8
+)
+
+// A connected joystick or gamepad, kept up to date by JoystickManager.Poll
+//
+// Buttons, axes and hats are numbered the way SDL numbers them, so
+// SDL_GameControllerDB mappings apply.
+type Joystick struct {
+	ID              int    // Unique among connected joysticks, and reused once disconnected
+	Name            string // The name the device reports
+	GUID            string // The SDL-compatible GUID, which gamepad mappings are looked up by
+	Vendor, Product uint16 // The USB or Bluetooth IDs of the device, 0 if unknown
+	path            string
+	device          JoystickDevice
+	buffer          []byte       // A partially read event
+	pending         []evdevEvent // Events waiting for the SYN_REPORT that completes them
+	dropped         bool         // Did the device drop events? Ignore them until the next SYN_REPORT
+	buttonCodes     []uint16
+	axes            []EvdevAxis
+	buttonIndex     map[uint16]int // By evdev code
+	axisIndex       map[uint16]int // By evdev code, hats excluded
+	hatIndex        map[uint16]int // By evdev code, for both the X and Y axes of a hat
+	buttons         []bool
+	axisPositions   []float64
+	hatX, hatY      []int8 // Hat positions, -1 to 1
+	mapping         *GamepadMapping
+	rumble          bool           // Can the device play FF_RUMBLE effects?
+	effect          int16          // The id of the uploaded rumble effect, or -1
+	leds            map[string]int // The device's LEDs with their maximum brightness
+}
+
+func newJoystick(id int, path string, device JoystickDevice, info EvdevInfo) *Joystick {
+	j := &Joystick{
+		ID:          id,
+		Name:        info.Name,
+		GUID:        joystickGUID(info),
+		Vendor:      info.Vendor,
+		Product:     info.Product,
+		path:        path,
+		device:      device,
+		buttonIndex: make(map[uint16]int),
+		axisIndex:   make(map[uint16]int),
+		hatIndex:    make(map[uint16]int),
+		effect:      -1,
+		leds:        info.LEDs,
+	}
+
+	if _, ok := device.(JoystickFeedbackDevice); ok {
+		for _, effect := range info.ForceFeedback {
+			j.rumble = j.rumble || effect == evdevFFRumble && info.Effects > 0
+		}
+	}
+
+	for _, code := range info.Keys {
+		if code >= evdevBtnMisc && code <= evdevKeyMax {
+			j.buttonCodes = append(j.buttonCodes, code)
+		}
+	}
+	sort.Slice(j.buttonCodes, func(a, b int) bool {
+		return evdevButtonOrder(j.buttonCodes[a]) < evdevButtonOrder(j.buttonCodes[b])
+	})
+	for i, code := range j.buttonCodes {
+		j.buttonIndex[code] = i
+	}
+
+	axes := append([]EvdevAxis(nil), info.Axes...)
+	sort.Slice(axes, func(a, b int) bool { return axes[a].Code < axes[b].Code })
+	for _, axis := range axes {
+		switch {
+		case axis.Code > evdevAbsMax:
+		case evdevIsHat(axis.Code):
+			// Hats are numbered in order, skipping missing ones
+			pair := axis.Code &^ 1
+			if _, ok := j.hatIndex[pair]; !ok {
+				j.hatIndex[pair] = len(j.hatX)
+				j.hatIndex[pair+1] = len(j.hatX)
+				j.hatX, j.hatY = append(j.hatX, 0), append(j.hatY, 0)
+			}
+		default:
+			j.axisIndex[axis.Code] = len(j.axes)
+			j.axes = append(j.axes, axis)
+		}
+	}
+
+	j.buttons = make([]bool, len(j.buttonCodes))
+	j.axisPositions = make([]float64, len(j.axes))
+	return j
+}
+
+// Is the device a joystick or gamepad? Mice, touchpads, tablets and
+// keyboards are not.
+func isJoystick(info EvdevInfo) bool {
+	for _, code := range info.Keys {
+		if evdevIsJoystickButton(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the number of buttons
+func (j *Joystick) ButtonCount() int { return len(j.buttons) }
+
+// Get the number of axes, not counting hats
+func (j *Joystick) AxisCount() int { return len(j.axes) }
+
+// Get the number of hats
+func (j *Joystick) HatCount() int { return len(j.hatX) }
+
+// Check if a button is pressed. Returns false for buttons the joystick
+// doesn't have.
+func (j *Joystick) Button(button int) bool {
+	return button >= 0 && button < len(j.buttons) && j.buttons[button]
+}
+
+// Get the position of an axis, from -1 to 1. Returns 0 for axes the
+// joystick doesn't have.
+func (j *Joystick) Axis(axis int) float64 {
+	if axis < 0 || axis >= len(j.axisPositions) {
+		return 0
+	}
+	return j.axisPositions[axis]
+}
+
+// Get the direction of a hat. Returns HatCentered for hats the joystick
+// doesn't have.
+func (j *Joystick) Hat(hat int) Hat {
+	if hat < 0 || hat >= len(j.hatX) {
+		return HatCentered
+	}
+	return joystickHat(j.hatX[hat], j.hatY[hat])
+}
+
+// Combine the axes of a hat into a direction
+func joystickHat(x, y int8) Hat {
+	hat := HatCentered
+	switch {
+	case y < 0:
+		hat |= HatUp
+	case y > 0:
+		hat |= HatDown
+	}
+	switch {
+	case x < 0:
+		hat |= HatLeft
+	case x > 0:
+		hat |= HatRight
+	}
+	return hat
+}
+
+// Does a gamepad mapping apply to the joystick?
+func (j *Joystick) IsGamepad() bool {
+	return j.mapping != nil
+}
+
+// Get the state of the joystick as a standard gamepad. Returns false if no
+// gamepad mapping applies to the joystick, see
+// JoystickManager.AddGamepadMappings.
+func (j *Joystick) Gamepad() (GamepadState, bool) {
+	if j.mapping == nil {
+		return GamepadState{}, false
+	}
+	return j.mapping.state(j), true
+}
+
+// Scale an axis value to -1 to 1, with the device's dead zone at 0
+func joystickAxisPosition(axis EvdevAxis, value int32) float64 {
+	if axis.Max <= axis.Min {
+		return 0
+	}
+
+	center := (float64(axis.Min) + float64(axis.Max)) / 2
+	if math.Abs(float64(value)-center) <= float64(axis.Flat) {
+		return 0
+	}
+
+	position := 2*(float64(value)-float64(axis.Min))/float64(axis.Max-axis.Min) - 1
+	return math.Max(-1, math.Min(1, position))
+}
+
+// Apply a complete group of events, reporting the changes
+func (j *Joystick) apply(header EventHeader, events []evdevEvent) []Event {
+	var changes []Event
+	for _, e := range events {
+		switch e.Type {
+		case evdevKey:
+			button, ok := j.buttonIndex[e.Code]
+			if !ok || e.Value == 2 { // Ignore autorepeat
+				break
+			}
+			if pressed := e.Value != 0; pressed != j.buttons[button] {
+				j.buttons[button] = pressed
+				if pressed {
+					changes = append(changes, JoystickButtonPressedEvent{EventHeader: header, Joystick: j, Button: button})
+				} else {
+					changes = append(changes, JoystickButtonReleasedEvent{EventHeader: header, Joystick: j, Button: button})
+				}
+			}
+
+		case evdevAbs:
+			if hat, ok := j.hatIndex[e.Code]; ok {
+				position := int8(0)
+				switch {
+				case e.Value < 0:
+					position = -1
+				case e.Value > 0:
+					position = 1
+				}
+
+				previous := j.Hat(hat)
+				if e.Code&1 == 0 {
+					j.hatX[hat] = position
+				} else {
+					j.hatY[hat] = position
+				}
+				if current := j.Hat(hat); current != previous {
+					changes = append(changes, JoystickHatMovedEvent{EventHeader: header, Joystick: j, Hat: hat, Position: current})
+				}
+			} else if axis, ok := j.axisIndex[e.Code]; ok {
+				if position := joystickAxisPosition(j.axes[axis], e.Value); position != j.axisPositions[axis] {
+					j.axisPositions[axis] = position
+					changes = append(changes, JoystickAxisMovedEvent{EventHeader: header, Joystick: j, Axis: axis, Position: position})
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// Read the device state, after it dropped events, as events
+func (j *Joystick) resync() ([]evdevEvent, error) {
+	info, err := j.device.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	pressed := make(map[uint16]bool)
+	for _, code := range info.Pressed {
+		pressed[code] = true
+	}
+
+	var events []evdevEvent
+	for _, code := range j.buttonCodes {
+		value := int32(0)
+		if pressed[code] {
+			value = 1
+		}
+		events = append(events, evdevEvent{evdevKey, code, value})
+	}
+	for _, axis := range info.Axes {
+		events = append(events, evdevEvent{evdevAbs, axis.Code, axis.Value})
+	}
+	return events, nil
+}
+
+// Read and apply the pending events, reporting the changes. An error means
+// the device is gone.
+func (j *Joystick) update(header EventHeader) ([]Event, error) {
+	var changes []Event
+	var chunk [64 * evdevEventSize]byte
+	for {
+		n, err := j.device.Read(chunk[:])
+		if err != nil {
+			return changes, err
+		}
+		if n == 0 {
+			return changes, nil
+		}
+
+		var events []evdevEvent
+		events, j.buffer = decodeEvdevEvents(append(j.buffer, chunk[:n]...))
+		for _, e := range events {
+			switch {
+			case e.Type == evdevSyn && e.Code == evdevSynDropped:
+				j.dropped = true
+				j.pending = nil
+
+			case e.Type == evdevSyn && e.Code == evdevSynReport:
+				if j.dropped {
+					j.dropped = false
+					if j.pending, err = j.resync(); err != nil {
+						return changes, err
+					}
+				}
+				changes = append(changes, j.apply(header, j.pending)...)
+				j.pending = nil
+
+			case !j.dropped:
+				j.pending = append(j.pending, e)
+			}
+		}
+	}
+}
+
+// Finds joysticks and keeps them up to date
+//
+// A JoystickManager must not be used concurrently, but it doesn't need to
+// be used on a Thread.
+type JoystickManager struct {
+	source    JoystickSource
+	joysticks []*Joystick              // Sorted by ID
+	ignored   map[string]bool          // Devices which aren't joysticks
+	failed    map[string]joystickRetry // Devices which couldn't be opened or read
+	mappings  []*GamepadMapping
+}
+
+// How long to wait before opening a failed device again. The wait doubles
+// with each failure, e.g. while a device's permissions are still being set
+// up after it was plugged in.
+const (
+	joystickRetryDelay    = 100 * time.Millisecond
+	joystickMaxRetryDelay = 5 * time.Second
+)
+
+// When to open a failed device again
+type joystickRetry struct {
+	at    time.Duration // The timestamp of the next attempt
+	delay time.Duration // The wait after the next failure
+}
+
+// Create a manager for the joysticks of the system, with the built-in
+// gamepad mappings of the platform, which AddGamepadMappings can replace
+func NewJoystickManager() *JoystickManager {
+	m := NewJoystickManagerFromSource(defaultJoystickSource())
+	if err := m.AddGamepadMappings(strings.NewReader(defaultGamepadMappings())); err != nil {
+		panic(err) // The built-in mappings are valid
+	}
+	return m
+}
+
+// Create a manager for the joysticks of a source, e.g. recorded devices
+func NewJoystickManagerFromSource(source JoystickSource) *JoystickManager {
+	return &JoystickManager{
+		source:  source,
+		ignored: make(map[string]bool),
+		failed:  make(map[string]joystickRetry),
+	}
+}
+
+// Get the connected joysticks, as of the last Poll
+func (m *JoystickManager) Joysticks() []*Joystick {
+	return append([]*Joystick(nil), m.joysticks...)
+}
+
+// Get a connected joystick by ID, or nil
+func (m *JoystickManager) Joystick(id int) *Joystick {
+	for _, j := range m.joysticks {
+		if j.ID == id {
+			return j
+		}
+	}
+	return nil
+}
+
+// Add gamepad mappings in the SDL_GameControllerDB text format, one per
+// line. Later mappings replace earlier ones for the same GUID.
+func (m *JoystickManager) AddGamepadMappings(r io.Reader) error {
+	mappings, err := readGamepadMappings(r)
+	if err != nil {
+		return err
+	}
+
+	// Newest first, so lookups find them before the ones they replace
+	for _, mapping := range mappings {
+		m.mappings = append([]*GamepadMapping{mapping}, m.mappings...)
+	}
+	for _, j := range m.joysticks {
+		j.mapping = m.findMapping(j.GUID)
+	}
+	return nil
+}
+
+// Find the mapping for a GUID, preferring one for the same version
+func (m *JoystickManager) findMapping(guid string) *GamepadMapping {
+	for _, ignoreVersion := range []bool{false, true} {
+		for _, mapping := range m.mappings {
+			if gamepadGUIDsMatch(mapping.GUID, guid, ignoreVersion) {
+				return mapping
+			}
+		}
+	}
+	return nil
+}
+
+// The smallest ID no connected joystick has
+func (m *JoystickManager) freeID() int {
+	id := 0
+	for _, j := range m.joysticks {
+		if j.ID == id {
+			id++
+		}
+	}
+	return id
+}
+
+// Connect to new joysticks, read pending input, and notice disconnected
+// joysticks. Returns the changes as JoystickConnectedEvent,
+// JoystickDisconnectedEvent, JoystickButtonPressedEvent,
+// JoystickButtonReleasedEvent, JoystickAxisMovedEvent and
+// JoystickHatMovedEvent. Joysticks present at the first Poll are reported
+// as connected.
+//
+// Call Poll once per frame, e.g. after Window.ThreadPollEvents.
+func (m *JoystickManager) Poll() ([]Event, error) {
+	header := EventHeader{Time: currentTimestamp()}
+	var events []Event
+
+	paths, err := m.source.Devices()
+	if err != nil {
+		return nil, err
+	}
+	present := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		present[path] = true
+	}
+
+	// Read the connected joysticks, dropping the ones which are gone
+	connected := m.joysticks[:0]
+	for _, j := range m.joysticks {
+		changes, err := j.update(header)
+		events = append(events, changes...)
+		if err != nil || !present[j.path] {
+			if err != nil {
+				m.fail(j.path, header.Time)
+			}
+			j.close()
+			events = append(events, JoystickDisconnectedEvent{EventHeader: header, Joystick: j})
+			continue
+		}
+		connected = append(connected, j)
+	}
+	m.joysticks = connected
+
+	// A path may be reused by a new device once the old one is gone
+	for path := range m.ignored {
+		if !present[path] {
+			delete(m.ignored, path)
+		}
+	}
+	for path := range m.failed {
+		if !present[path] {
+			delete(m.failed, path)
+		}
+	}
+
+	// Open the new joysticks, and the failed ones which are due
+	for _, path := range paths {
+		if m.ignored[path] || m.joystickAt(path) != nil {
+			continue
+		}
+		if retry, ok := m.failed[path]; ok && header.Time < retry.at {
+			continue
+		}
+
+		j, err := m.open(path)
+		if err != nil {
+			m.fail(path, header.Time)
+			continue
+		}
+		if j == nil {
+			m.ignored[path] = true
+			continue
+		}
+
+		m.joysticks = append(m.joysticks, j)
+		sort.Slice(m.joysticks, func(a, b int) bool { return m.joysticks[a].ID < m.joysticks[b].ID })
+		events = append(events, JoystickConnectedEvent{EventHeader: header, Joystick: j})
+
+		// Report the input since the device was opened
+		changes, err := j.update(header)
+		events = append(events, changes...)
+		if err != nil {
+			m.fail(path, header.Time)
+			m.remove(j)
+			j.close()
+			events = append(events, JoystickDisconnectedEvent{EventHeader: header, Joystick: j})
+			continue
+		}
+
+		// Only a device which keeps working is forgiven its failures
+		delete(m.failed, path)
+	}
+
+	return events, nil
+}
+
+// Schedule the next attempt to open a failed device
+func (m *JoystickManager) fail(path string, now time.Duration) {
+	retry, ok := m.failed[path]
+	if !ok {
+		retry.delay = joystickRetryDelay
+	}
+	retry.at = now + retry.delay
+	retry.delay *= 2
+	if retry.delay > joystickMaxRetryDelay {
+		retry.delay = joystickMaxRetryDelay
+	}
+	m.failed[path] = retry
+}
+
+// Open a joystick. Returns nil and no error if the device isn't one.
+func (m *JoystickManager) open(path string) (*Joystick, error) {
+	device, err := m.source.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := device.Info()
+	if err != nil {
+		device.Close()
+		return nil, err
+	}
+	if !isJoystick(info) {
+		device.Close()
+		return nil, nil
+	}
+
+	j := newJoystick(m.freeID(), path, device, info)
+	j.mapping = m.findMapping(j.GUID)
+
+	// Start from the current state
+	current, err := j.resync()
+	if err != nil {
+		device.Close()
+		return nil, err
+	}
+	j.apply(EventHeader{}, current)
+	return j, nil
+}
+
+func (m *JoystickManager) joystickAt(path string) *Joystick {
+	for _, j := range m.joysticks {
+		if j.path == path {
+			return j
+		}
+	}
+	return nil
+}
+
+func (m *JoystickManager) remove(j *Joystick) {
+	for i := range m.joysticks {
+		if m.joysticks[i] == j {
+			m.joysticks = append(m.joysticks[:i], m.joysticks[i+1:]...)
+			return
+		}
+	}
+}
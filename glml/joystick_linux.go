@@ -0,0 +1,217 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// The platform gamepad mappings are selected by
+const gamepadPlatform = "Linux"
+
+// Reads the evdev devices in /dev/input
+type devInputSource struct{}
+
+func (devInputSource) Devices() ([]string, error) {
+	return filepath.Glob("/dev/input/event*")
+}
+
+func (devInputSource) Open(path string) (JoystickDevice, error) {
+	// Force feedback needs write access, but input only needs read access
+	writable := true
+	fd, err := syscall.Open(path, syscall.O_RDWR|syscall.O_NONBLOCK|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		writable = false
+		fd, err = syscall.Open(path, syscall.O_RDONLY|syscall.O_NONBLOCK|syscall.O_CLOEXEC, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &evdevDevice{fd: fd, name: filepath.Base(path), writable: writable}, nil
+}
+
+func defaultJoystickSource() JoystickSource {
+	return devInputSource{}
+}
+
+// The mappings every JoystickManager starts with: evdev devices differ too
+// much to guess
+func defaultGamepadMappings() string {
+	return ""
+}
+
+// An open /dev/input/event* file
+type evdevDevice struct {
+	fd       int
+	name     string // e.g. "event3"
+	writable bool
+}
+
+// Build an ioctl request number, see asm-generic/ioctl.h
+func evdevIoctl(dir, nr, size uintptr) uintptr {
+	return dir<<30 | size<<16 | 'E'<<8 | nr
+}
+
+func evdevIoctlRead(nr, size uintptr) uintptr {
+	const iocRead = 2
+	return evdevIoctl(iocRead, nr, size)
+}
+
+func evdevIoctlWrite(nr, size uintptr) uintptr {
+	const iocWrite = 1
+	return evdevIoctl(iocWrite, nr, size)
+}
+
+func (d *evdevDevice) ioctl(request uintptr, data []byte) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(d.fd), request, uintptr(unsafe.Pointer(&data[0]))); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// The sysfs directory of the LEDs of the device, which belong to the
+// device the input device is part of, e.g. the USB interface
+func (d *evdevDevice) ledDirectory() string {
+	return filepath.Join("/sys/class/input", d.name, "device/device/leds")
+}
+
+// Read a bitmask with an ioctl, returning the set bits
+func (d *evdevDevice) bits(nr uintptr, count int) ([]uint16, error) {
+	mask := make([]byte, (count+7)/8)
+	if err := d.ioctl(evdevIoctlRead(nr, uintptr(len(mask))), mask); err != nil {
+		return nil, err
+	}
+
+	var set []uint16
+	for i := 0; i < count; i++ {
+		if mask[i/8]&(1<<(i%8)) != 0 {
+			set = append(set, uint16(i))
+		}
+	}
+	return set, nil
+}
+
+func (d *evdevDevice) Info() (EvdevInfo, error) {
+	var info EvdevInfo
+
+	// EVIOCGID, a struct input_id
+	var id [8]byte
+	if err := d.ioctl(evdevIoctlRead(0x02, uintptr(len(id))), id[:]); err != nil {
+		return info, err
+	}
+	info.Bus = evdevByteOrder.Uint16(id[0:])
+	info.Vendor = evdevByteOrder.Uint16(id[2:])
+	info.Product = evdevByteOrder.Uint16(id[4:])
+	info.Version = evdevByteOrder.Uint16(id[6:])
+
+	var name [256]byte
+	if err := d.ioctl(evdevIoctlRead(0x06, uintptr(len(name))), name[:]); err == nil { // EVIOCGNAME
+		for i, c := range name {
+			if c == 0 {
+				info.Name = string(name[:i])
+				break
+			}
+		}
+	}
+
+	var err error
+	if info.Keys, err = d.bits(0x20+evdevKey, evdevKeyMax+1); err != nil { // EVIOCGBIT(EV_KEY)
+		return info, err
+	}
+	if info.Pressed, err = d.bits(0x18, evdevKeyMax+1); err != nil { // EVIOCGKEY
+		return info, err
+	}
+
+	axes, err := d.bits(0x20+evdevAbs, evdevAbsMax+1) // EVIOCGBIT(EV_ABS)
+	if err != nil {
+		return info, err
+	}
+	for _, code := range axes {
+		// EVIOCGABS, a struct input_absinfo
+		var abs [24]byte
+		if err := d.ioctl(evdevIoctlRead(0x40+uintptr(code), uintptr(len(abs))), abs[:]); err != nil {
+			return info, err
+		}
+		info.Axes = append(info.Axes, EvdevAxis{
+			Code:  code,
+			Value: int32(evdevByteOrder.Uint32(abs[0:])),
+			Min:   int32(evdevByteOrder.Uint32(abs[4:])),
+			Max:   int32(evdevByteOrder.Uint32(abs[8:])),
+			Flat:  int32(evdevByteOrder.Uint32(abs[16:])),
+		})
+	}
+
+	// Force feedback needs write access
+	if d.writable {
+		if info.ForceFeedback, err = d.bits(0x20+evdevFF, evdevFFMax+1); err != nil { // EVIOCGBIT(EV_FF)
+			return info, err
+		}
+
+		var effects [4]byte
+		if err := d.ioctl(evdevIoctlRead(0x84, uintptr(len(effects))), effects[:]); err != nil { // EVIOCGEFFECTS
+			return info, err
+		}
+		info.Effects = int(int32(evdevByteOrder.Uint32(effects[:])))
+	}
+
+	// LEDs aren't part of evdev, but of sysfs
+	leds, _ := filepath.Glob(filepath.Join(d.ledDirectory(), "*", "max_brightness"))
+	for _, led := range leds {
+		data, err := os.ReadFile(led)
+		if err != nil {
+			continue
+		}
+		if max, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			if info.LEDs == nil {
+				info.LEDs = make(map[string]int)
+			}
+			info.LEDs[filepath.Base(filepath.Dir(led))] = max
+		}
+	}
+
+	return info, nil
+}
+
+func (d *evdevDevice) UploadEffect(effect []byte) error {
+	return d.ioctl(evdevIoctlWrite(0x80, uintptr(len(effect))), effect) // EVIOCSFF
+}
+
+func (d *evdevDevice) RemoveEffect(id int16) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(d.fd), evdevIoctlWrite(0x81, 4), uintptr(id)); errno != 0 { // EVIOCRMFF
+		return errno
+	}
+	return nil
+}
+
+func (d *evdevDevice) Write(p []byte) (int, error) {
+	return syscall.Write(d.fd, p)
+}
+
+func (d *evdevDevice) SetLED(name string, brightness int) error {
+	if strings.Contains(name, "/") || name == ".." {
+		return os.ErrNotExist
+	}
+	return os.WriteFile(filepath.Join(d.ledDirectory(), name, "brightness"), []byte(strconv.Itoa(brightness)), 0)
+}
+
+func (d *evdevDevice) Read(p []byte) (int, error) {
+	n, err := syscall.Read(d.fd, p)
+	if err == syscall.EAGAIN || err == syscall.EINTR {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, syscall.ENODEV
+	}
+	return n, nil
+}
+
+func (d *evdevDevice) Close() error {
+	return syscall.Close(d.fd)
+}
@@ -0,0 +1,259 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// A recorded evdev device
+type fakeJoystickDevice struct {
+	info   EvdevInfo
+	stream bytes.Buffer
+	gone   bool
+	closed bool
+}
+
+func (d *fakeJoystickDevice) Info() (EvdevInfo, error) { return d.info, nil }
+
+func (d *fakeJoystickDevice) Read(p []byte) (int, error) {
+	if d.gone {
+		return 0, errors.New("no such device")
+	}
+	if d.stream.Len() == 0 {
+		return 0, nil
+	}
+	return d.stream.Read(p)
+}
+
+func (d *fakeJoystickDevice) Close() error {
+	d.closed = true
+	return nil
+}
+
+// Record events in struct input_event layout
+func (d *fakeJoystickDevice) record(events ...evdevEvent) {
+	for _, e := range events {
+		d.stream.Write(encodeEvdevEvent(e))
+	}
+}
+
+type fakeJoystickSource map[string]JoystickDevice
+
+func (s fakeJoystickSource) Devices() ([]string, error) {
+	var paths []string
+	for path := range s {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func (s fakeJoystickSource) Open(path string) (JoystickDevice, error) {
+	if d, ok := s[path]; ok {
+		return d, nil
+	}
+	return nil, errors.New("no such device")
+}
+
+// An Xbox 360 controller, as the Linux xpad driver reports it
+func xbox360Device() *fakeJoystickDevice {
+	stick := func(code uint16) EvdevAxis { return EvdevAxis{Code: code, Min: -32768, Max: 32767, Flat: 128} }
+	trigger := func(code uint16) EvdevAxis { return EvdevAxis{Code: code, Max: 255} }
+	hat := func(code uint16) EvdevAxis { return EvdevAxis{Code: code, Min: -1, Max: 1} }
+
+	return &fakeJoystickDevice{info: EvdevInfo{
+		Name:    "Microsoft X-Box 360 pad",
+		Bus:     0x03,
+		Vendor:  0x045E,
+		Product: 0x028E,
+		Version: 0x0114,
+		Keys:    []uint16{0x130, 0x131, 0x133, 0x134, 0x136, 0x137, 0x13A, 0x13B, 0x13C, 0x13D, 0x13E},
+		Axes:    []EvdevAxis{stick(0x00), stick(0x01), trigger(0x02), stick(0x03), stick(0x04), trigger(0x05), hat(0x10), hat(0x11)},
+	}}
+}
+
+const xbox360Mappings = `# Game Controller DB excerpt
+030000005e0400008e02000014010000,Xbox 360 Controller,a:b0,b:b1,back:b6,dpdown:h0.4,dpleft:h0.8,dpright:h0.2,dpup:h0.1,guide:b8,leftshoulder:b4,leftstick:b9,lefttrigger:a2,leftx:a0,lefty:a1,rightshoulder:b5,rightstick:b10,righttrigger:a5,rightx:a3,righty:a4,start:b7,x:b2,y:b3,platform:Linux,
+030000005e0400008e02000014010000,Xbox 360 Controller,a:b0,b:b1,x:b2,y:b3,platform:Windows,
+`
+
+// Describe events without their times
+func describeJoystickEvents(events []Event) []string {
+	var descriptions []string
+	for _, e := range events {
+		switch e := e.(type) {
+		case JoystickConnectedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("connected %d", e.Joystick.ID))
+		case JoystickDisconnectedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("disconnected %d", e.Joystick.ID))
+		case JoystickButtonPressedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("pressed %d", e.Button))
+		case JoystickButtonReleasedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("released %d", e.Button))
+		case JoystickAxisMovedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("axis %d %.2f", e.Axis, e.Position))
+		case JoystickHatMovedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("hat %d %d", e.Hat, e.Position))
+		default:
+			descriptions = append(descriptions, fmt.Sprintf("%T", e))
+		}
+	}
+	return descriptions
+}
+
+func TestJoystickManager(t *testing.T) {
+	pad := xbox360Device()
+	keyboard := &fakeJoystickDevice{info: EvdevInfo{Name: "Keyboard", Keys: []uint16{30, 31, 32}}}
+	source := fakeJoystickSource{"/dev/input/event0": keyboard, "/dev/input/event3": pad}
+
+	m := NewJoystickManagerFromSource(source)
+	if err := m.AddGamepadMappings(strings.NewReader(xbox360Mappings)); err != nil {
+		t.Fatal(err)
+	}
+
+	poll := func(expected ...string) {
+		t.Helper()
+		events, err := m.Poll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if descriptions := describeJoystickEvents(events); !reflect.DeepEqual(descriptions, expected) {
+			t.Errorf("expected %q, got %q", expected, descriptions)
+		}
+	}
+
+	// The keyboard isn't a joystick
+	poll("connected 0")
+	if !keyboard.closed {
+		t.Error("expected the keyboard to be closed")
+	}
+
+	j := m.Joystick(0)
+	if j == nil || j.GUID != "030000005e0400008e02000014010000" {
+		t.Fatalf("unexpected joystick %+v", j)
+	}
+	if j.ButtonCount() != 11 || j.AxisCount() != 6 || j.HatCount() != 1 || !j.IsGamepad() {
+		t.Errorf("expected 11 buttons, 6 axes, 1 hat and a mapping, got %d, %d, %d, %v", j.ButtonCount(), j.AxisCount(), j.HatCount(), j.IsGamepad())
+	}
+
+	// A complete group, then half of the next event
+	pad.record(
+		evdevEvent{evdevKey, 0x130, 1},
+		evdevEvent{evdevAbs, 0x00, 32767},
+		evdevEvent{evdevAbs, 0x11, -1},
+		evdevEvent{evdevAbs, 0x02, 255},
+		evdevEvent{evdevSyn, evdevSynReport, 0},
+		evdevEvent{evdevKey, 0x131, 1},
+		evdevEvent{evdevSyn, evdevSynReport, 0},
+	)
+	rest := pad.stream.Bytes()[pad.stream.Len()-evdevEventSize-evdevEventSize/2:]
+	rest = append([]byte(nil), rest...)
+	pad.stream.Truncate(pad.stream.Len() - len(rest))
+
+	poll("pressed 0", "axis 0 1.00", "hat 0 1", "axis 2 1.00")
+
+	state, ok := j.Gamepad()
+	if !ok || !state.Buttons[GamepadA] || !state.Buttons[GamepadDPadUp] || state.Buttons[GamepadB] {
+		t.Errorf("unexpected gamepad buttons %v", state.Buttons)
+	}
+	if state.Axes[GamepadLeftX] != 1 || state.Axes[GamepadLeftTrigger] != 1 || state.Axes[GamepadRightTrigger] != 0 {
+		t.Errorf("unexpected gamepad axes %v", state.Axes)
+	}
+
+	pad.stream.Write(rest)
+	poll("pressed 1")
+
+	// Events were dropped: the state is read from the device instead
+	pad.info.Pressed = []uint16{0x130}
+	pad.info.Axes[0].Value = 100 // Inside the dead zone
+	pad.info.Axes[2].Value = 255
+	pad.info.Axes[7].Value = -1
+	pad.record(
+		evdevEvent{evdevSyn, evdevSynDropped, 0},
+		evdevEvent{evdevKey, 0x133, 1},
+		evdevEvent{evdevSyn, evdevSynReport, 0},
+	)
+	poll("released 1", "axis 0 0.00")
+
+	// A second pad gets the next ID, which is reused once free
+	source["/dev/input/event5"] = xbox360Device()
+	poll("connected 1")
+	delete(source, "/dev/input/event3")
+	poll("disconnected 0")
+	if !pad.closed {
+		t.Error("expected the removed pad to be closed")
+	}
+	source["/dev/input/event6"] = xbox360Device()
+	poll("connected 0")
+
+	// A device error also disconnects
+	source["/dev/input/event6"].(*fakeJoystickDevice).gone = true
+	poll("disconnected 0")
+	if ids := len(m.Joysticks()); ids != 1 {
+		t.Errorf("expected 1 joystick, got %d", ids)
+	}
+}
+
+// A source whose devices can't be opened for a while, as right after they
+// are plugged in
+type deniedJoystickSource struct {
+	fakeJoystickSource
+	denials int // How many more times Open fails
+}
+
+func (s *deniedJoystickSource) Open(path string) (JoystickDevice, error) {
+	if s.denials > 0 {
+		s.denials--
+		return nil, errors.New("permission denied")
+	}
+	return s.fakeJoystickSource.Open(path)
+}
+
+func TestJoystickManager_RetryOpen(t *testing.T) {
+	const path = "/dev/input/event3"
+	source := &deniedJoystickSource{fakeJoystickSource: fakeJoystickSource{path: xbox360Device()}, denials: 2}
+	m := NewJoystickManagerFromSource(source)
+
+	poll := func(expected ...string) {
+		t.Helper()
+		events, err := m.Poll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if descriptions := describeJoystickEvents(events); !reflect.DeepEqual(descriptions, expected) {
+			t.Errorf("expected %q, got %q", expected, descriptions)
+		}
+	}
+
+	// The device isn't opened again until the retry is due
+	poll()
+	retry := m.failed[path]
+	if retry.delay != 2*joystickRetryDelay || source.denials != 1 {
+		t.Fatalf("expected one failure, got %+v with %d denials left", retry, source.denials)
+	}
+	poll()
+	if source.denials != 1 {
+		t.Error("expected no attempt before the retry is due")
+	}
+
+	// The wait doubles with each failure
+	retry.at = 0
+	m.failed[path] = retry
+	poll()
+	if retry = m.failed[path]; retry.delay != 4*joystickRetryDelay {
+		t.Errorf("expected the wait to double, got %v", retry.delay)
+	}
+
+	retry.at = 0
+	m.failed[path] = retry
+	poll("connected 0")
+	if _, ok := m.failed[path]; ok || m.ignored[path] {
+		t.Error("expected the opened device to be forgiven")
+	}
+}
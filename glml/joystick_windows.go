@@ -0,0 +1,167 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "helper_windows.h"
+import "C"
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The platform gamepad mappings are selected by
+const gamepadPlatform = "Windows"
+
+// The mappings every JoystickManager starts with: XInput controllers all
+// have the same layout
+func defaultGamepadMappings() string {
+	return xinputGamepadMapping()
+}
+
+// Reads the XInput controllers, as "xinput0" to "xinput3". DirectInput and
+// raw input devices, e.g. most joysticks which aren't Xbox controllers,
+// aren't read.
+type xinputSource struct {
+	slots xinputSlots
+}
+
+func (s *xinputSource) Devices() ([]string, error) {
+	return s.slots.devices(currentTimestamp(), func(slot int) bool {
+		var state C.XINPUTSTATE
+		return C.__XInputGetState(C.DWORD(slot), &state) == C.ERROR_SUCCESS
+	}), nil
+}
+
+func (s *xinputSource) Open(path string) (JoystickDevice, error) {
+	index, err := strconv.Atoi(strings.TrimPrefix(path, "xinput"))
+	if err != nil || !strings.HasPrefix(path, "xinput") || index < 0 || index >= xinputMaxCount {
+		return nil, errors.New("glml: not an XInput controller: " + path)
+	}
+	d := &xinputDevice{index: C.DWORD(index)}
+	if _, err := d.poll(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func defaultJoystickSource() JoystickSource {
+	return &xinputSource{}
+}
+
+// An XInput controller, presented as an evdev device
+type xinputDevice struct {
+	index   C.DWORD
+	state   xinputState // As last reported
+	pending []byte      // Encoded events which didn't fit in the last Read
+	rumble  xinputRumble
+	stopAt  time.Time // When the playing rumble ends, zero if it doesn't
+}
+
+// Get the current state of the controller
+func (d *xinputDevice) poll() (xinputState, error) {
+	var state C.XINPUTSTATE
+	if C.__XInputGetState(d.index, &state) != C.ERROR_SUCCESS {
+		return xinputState{}, ErrJoystickDisconnected
+	}
+	return xinputState{
+		packet:       uint32(state.packet),
+		buttons:      uint16(state.buttons),
+		leftTrigger:  uint8(state.leftTrigger),
+		rightTrigger: uint8(state.rightTrigger),
+		thumbLX:      int16(state.thumbLX),
+		thumbLY:      int16(state.thumbLY),
+		thumbRX:      int16(state.thumbRX),
+		thumbRY:      int16(state.thumbRY),
+	}, nil
+}
+
+func (d *xinputDevice) Info() (EvdevInfo, error) {
+	state, err := d.poll()
+	if err != nil {
+		return EvdevInfo{}, err
+	}
+	d.state, d.pending = state, nil
+	return xinputInfo(state), nil
+}
+
+func (d *xinputDevice) Read(p []byte) (int, error) {
+	if !d.stopAt.IsZero() && !time.Now().Before(d.stopAt) {
+		d.setRumble(0, 0)
+	}
+
+	if len(d.pending) == 0 {
+		state, err := d.poll()
+		if err != nil {
+			return 0, err
+		}
+		if state.packet != d.state.packet {
+			for _, e := range xinputEvents(d.state, state) {
+				d.pending = append(d.pending, encodeEvdevEvent(e)...)
+			}
+			d.state = state
+		}
+	}
+
+	// Only whole events
+	n := copy(p[:len(p)-len(p)%evdevEventSize], d.pending)
+	d.pending = d.pending[n:]
+	return n, nil
+}
+
+// Keep the rumble, to play it when the Write which starts it comes
+func (d *xinputDevice) UploadEffect(effect []byte) error {
+	rumble, ok := decodeXInputRumble(effect)
+	if !ok {
+		return ErrJoystickUnsupported
+	}
+	d.rumble = rumble
+	evdevByteOrder.PutUint16(effect[2:], 0) // The only effect
+	return nil
+}
+
+func (d *xinputDevice) RemoveEffect(id int16) error {
+	d.rumble = xinputRumble{}
+	return d.setRumble(0, 0)
+}
+
+// Start or stop the rumble with EV_FF events
+func (d *xinputDevice) Write(p []byte) (int, error) {
+	events, _ := decodeEvdevEvents(p)
+	for _, e := range events {
+		if e.Type != evdevFF {
+			continue
+		}
+		if e.Value == 0 {
+			if err := d.setRumble(0, 0); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err := d.setRumble(d.rumble.strong, d.rumble.weak); err != nil {
+			return 0, err
+		}
+		if d.rumble.length > 0 {
+			d.stopAt = time.Now().Add(d.rumble.length)
+		}
+	}
+	return len(p), nil
+}
+
+func (d *xinputDevice) setRumble(strong, weak uint16) error {
+	d.stopAt = time.Time{}
+	if C.__XInputSetState(d.index, C.WORD(strong), C.WORD(weak)) != C.ERROR_SUCCESS {
+		return ErrJoystickDisconnected
+	}
+	return nil
+}
+
+// XInput doesn't expose the controller's LEDs
+func (d *xinputDevice) SetLED(name string, brightness int) error {
+	return ErrJoystickUnsupported
+}
+
+func (d *xinputDevice) Close() error {
+	d.setRumble(0, 0)
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrJoystickUnsupported  = errors.New("glml: the joystick doesn't support this")
+	ErrJoystickDisconnected = errors.New("glml: the joystick is disconnected")
+)
+
+// What a joystick can do besides report input
+type JoystickCapabilities struct {
+	Rumble     bool     // Joystick.Rumble is supported
+	PlayerLEDs int      // The highest player Joystick.SetPlayerIndex can show, 0 if unsupported
+	LEDs       []string // The LEDs Joystick.SetLED can control
+}
+
+// Get what the joystick can do besides report input
+func (j *Joystick) Capabilities() JoystickCapabilities {
+	var caps JoystickCapabilities
+	if _, err := j.feedbackDevice(); err != nil {
+		return caps
+	}
+
+	caps.Rumble = j.rumble
+	caps.PlayerLEDs = len(j.playerLEDs())
+	if caps.PlayerLEDs == 0 && j.xpadLED() != "" {
+		caps.PlayerLEDs = 4
+	}
+	for name := range j.leds {
+		caps.LEDs = append(caps.LEDs, name)
+	}
+	sort.Strings(caps.LEDs)
+	return caps
+}
+
+// Get the feedback interface of the device, or an error saying why there
+// isn't one
+func (j *Joystick) feedbackDevice() (JoystickFeedbackDevice, error) {
+	if j.device == nil {
+		return nil, ErrJoystickDisconnected
+	}
+	device, ok := j.device.(JoystickFeedbackDevice)
+	if !ok {
+		return nil, ErrJoystickUnsupported
+	}
+	return device, nil
+}
+
+// Scale a 0 to 1 strength to an evdev magnitude
+func joystickMagnitude(strength float64) uint16 {
+	return uint16(math.Max(0, math.Min(1, strength)) * 0xFFFF)
+}
+
+// Rumble the joystick's low frequency (large) and high frequency (small)
+// motors, with strengths from 0 to 1, for a duration of up to a minute. A
+// duration of 0 rumbles until StopRumble, and a new rumble replaces the
+// previous one.
+//
+// Returns ErrJoystickUnsupported if Capabilities().Rumble is false.
+func (j *Joystick) Rumble(low, high float64, duration time.Duration) error {
+	device, err := j.feedbackDevice()
+	if err != nil {
+		return err
+	}
+	if !j.rumble {
+		return ErrJoystickUnsupported
+	}
+
+	// Replace the uploaded effect rather than uploading another, as
+	// devices only hold a few
+	effect := encodeEvdevRumble(j.effect, joystickMagnitude(low), joystickMagnitude(high), duration)
+	if err := device.UploadEffect(effect); err != nil {
+		return err
+	}
+	j.effect = int16(evdevByteOrder.Uint16(effect[2:]))
+
+	_, err = device.Write(encodeEvdevEvent(evdevEvent{evdevFF, uint16(j.effect), 1}))
+	return err
+}
+
+// Stop the joystick rumbling
+func (j *Joystick) StopRumble() error {
+	device, err := j.feedbackDevice()
+	if err != nil {
+		return err
+	}
+	if j.effect < 0 {
+		return nil
+	}
+
+	_, err = device.Write(encodeEvdevEvent(evdevEvent{evdevFF, uint16(j.effect), 0}))
+	return err
+}
+
+// Set the brightness, from 0 to 1, of one of Capabilities().LEDs
+func (j *Joystick) SetLED(name string, brightness float64) error {
+	device, err := j.feedbackDevice()
+	if err != nil {
+		return err
+	}
+	max, ok := j.leds[name]
+	if !ok {
+		return ErrJoystickUnsupported
+	}
+
+	return device.SetLED(name, int(math.Round(math.Max(0, math.Min(1, brightness))*float64(max))))
+}
+
+// The player indicator LEDs, e.g. "playstation::00:11:22:33:44:55::player-1",
+// in order
+func (j *Joystick) playerLEDs() []string {
+	var leds []string
+	for name := range j.leds {
+		if _, ok := joystickPlayerLED(name); ok {
+			leds = append(leds, name)
+		}
+	}
+	sort.Slice(leds, func(a, b int) bool {
+		na, _ := joystickPlayerLED(leds[a])
+		nb, _ := joystickPlayerLED(leds[b])
+		return na < nb
+	})
+	return leds
+}
+
+// Get the number of a player indicator LED from its name
+func joystickPlayerLED(name string) (int, bool) {
+	i := strings.LastIndex(name, "player-")
+	if i < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(name[i+len("player-"):])
+	return n, err == nil
+}
+
+// The LED of an Xbox 360 controller, e.g. "xpad0", which shows a player
+// with a pattern rather than separate LEDs
+func (j *Joystick) xpadLED() string {
+	for name := range j.leds {
+		if strings.HasPrefix(name, "xpad") {
+			return name
+		}
+	}
+	return ""
+}
+
+// Show a player number, from 1 to Capabilities().PlayerLEDs, on the
+// joystick's player indicator. Player 0 turns the indicator off.
+func (j *Joystick) SetPlayerIndex(player int) error {
+	device, err := j.feedbackDevice()
+	if err != nil {
+		return err
+	}
+
+	if leds := j.playerLEDs(); len(leds) != 0 {
+		if player < 0 || player > len(leds) {
+			return ErrJoystickUnsupported
+		}
+		for i, name := range leds {
+			brightness := 0
+			if i == player-1 {
+				brightness = j.leds[name]
+			}
+			if err := device.SetLED(name, brightness); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The xpad driver shows players 1 to 4 with the patterns 6 to 9
+	if name := j.xpadLED(); name != "" {
+		if player < 0 || player > 4 {
+			return ErrJoystickUnsupported
+		}
+		pattern := 0
+		if player > 0 {
+			pattern = player + 5
+		}
+		return device.SetLED(name, pattern)
+	}
+
+	return ErrJoystickUnsupported
+}
+
+// Free the uploaded effect and close the device
+func (j *Joystick) close() {
+	if device, ok := j.device.(JoystickFeedbackDevice); ok && j.effect >= 0 {
+		device.RemoveEffect(j.effect)
+	}
+	j.device.Close()
+	j.device = nil
+	j.effect = -1
+}
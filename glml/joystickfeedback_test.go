@@ -0,0 +1,183 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// A recorded evdev device which logs its outputs the way the kernel would
+// see them
+type fakeFeedbackDevice struct {
+	fakeJoystickDevice
+	log     []string
+	nextID  int16
+	effects map[int16]bool
+}
+
+func (d *fakeFeedbackDevice) UploadEffect(effect []byte) error {
+	if len(effect) != evdevEffectSize {
+		return fmt.Errorf("ff_effect is %d bytes", len(effect))
+	}
+
+	id := int16(evdevByteOrder.Uint16(effect[2:]))
+	if id < 0 {
+		id = d.nextID
+		d.nextID++
+		evdevByteOrder.PutUint16(effect[2:], uint16(id))
+	}
+	d.effects[id] = true
+
+	d.log = append(d.log, fmt.Sprintf("EVIOCSFF type=%#x id=%d length=%d strong=%#x weak=%#x",
+		evdevByteOrder.Uint16(effect[0:]), id, evdevByteOrder.Uint16(effect[10:]),
+		evdevByteOrder.Uint16(effect[16:]), evdevByteOrder.Uint16(effect[18:])))
+	return nil
+}
+
+func (d *fakeFeedbackDevice) RemoveEffect(id int16) error {
+	if !d.effects[id] {
+		return fmt.Errorf("no effect %d", id)
+	}
+	delete(d.effects, id)
+	d.log = append(d.log, fmt.Sprintf("EVIOCRMFF %d", id))
+	return nil
+}
+
+func (d *fakeFeedbackDevice) Write(p []byte) (int, error) {
+	events, rest := decodeEvdevEvents(p)
+	if len(rest) != 0 {
+		return 0, fmt.Errorf("partial event")
+	}
+	for _, e := range events {
+		d.log = append(d.log, fmt.Sprintf("write type=%#x code=%d value=%d", e.Type, e.Code, e.Value))
+	}
+	return len(p), nil
+}
+
+func (d *fakeFeedbackDevice) SetLED(name string, brightness int) error {
+	d.log = append(d.log, fmt.Sprintf("led %s=%d", name, brightness))
+	return nil
+}
+
+func newFakeFeedbackDevice(info EvdevInfo) *fakeFeedbackDevice {
+	return &fakeFeedbackDevice{
+		fakeJoystickDevice: fakeJoystickDevice{info: info},
+		nextID:             3,
+		effects:            make(map[int16]bool),
+	}
+}
+
+func TestJoystick_Rumble(t *testing.T) {
+	info := xbox360Device().info
+	info.ForceFeedback = []uint16{evdevFFRumble, 0x51, 0x52}
+	info.Effects = 16
+	info.LEDs = map[string]int{"xpad0": 15}
+	device := newFakeFeedbackDevice(info)
+
+	// A device without outputs
+	source := fakeJoystickSource{"/dev/input/event2": xbox360Device(), "/dev/input/event3": device}
+	m := NewJoystickManagerFromSource(source)
+	if _, err := m.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	if caps := m.Joystick(0).Capabilities(); caps.Rumble || caps.PlayerLEDs != 0 || caps.LEDs != nil {
+		t.Errorf("expected no capabilities, got %+v", caps)
+	}
+	if err := m.Joystick(0).Rumble(1, 1, time.Second); err != ErrJoystickUnsupported {
+		t.Errorf("expected ErrJoystickUnsupported, got %v", err)
+	}
+
+	j := m.Joystick(1)
+	expected := JoystickCapabilities{Rumble: true, PlayerLEDs: 4, LEDs: []string{"xpad0"}}
+	if caps := j.Capabilities(); !reflect.DeepEqual(caps, expected) {
+		t.Errorf("expected %+v, got %+v", expected, caps)
+	}
+
+	if err := j.StopRumble(); err != nil { // Nothing to stop
+		t.Error(err)
+	}
+	if err := j.Rumble(1, 0.5, 250*time.Millisecond); err != nil {
+		t.Error(err)
+	}
+	if err := j.Rumble(2, -1, 2*time.Minute); err != nil { // Replaces the first, clamped
+		t.Error(err)
+	}
+	if err := j.StopRumble(); err != nil {
+		t.Error(err)
+	}
+	if err := j.SetPlayerIndex(2); err != nil {
+		t.Error(err)
+	}
+	if err := j.SetPlayerIndex(5); err != ErrJoystickUnsupported {
+		t.Errorf("expected ErrJoystickUnsupported, got %v", err)
+	}
+	if err := j.SetLED("xpad0", 0.5); err != nil {
+		t.Error(err)
+	}
+
+	// Disconnecting frees the effect
+	delete(source, "/dev/input/event3")
+	if _, err := m.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedLog := []string{
+		"EVIOCSFF type=0x50 id=3 length=250 strong=0xffff weak=0x7fff",
+		"write type=0x15 code=3 value=1",
+		"EVIOCSFF type=0x50 id=3 length=65535 strong=0xffff weak=0x0",
+		"write type=0x15 code=3 value=1",
+		"write type=0x15 code=3 value=0",
+		"led xpad0=7",
+		"led xpad0=8",
+		"EVIOCRMFF 3",
+	}
+	if !reflect.DeepEqual(device.log, expectedLog) {
+		t.Errorf("expected %q, got %q", expectedLog, device.log)
+	}
+	if !device.closed {
+		t.Error("expected the device to be closed")
+	}
+
+	if err := j.Rumble(1, 1, time.Second); err != ErrJoystickDisconnected {
+		t.Errorf("expected ErrJoystickDisconnected, got %v", err)
+	}
+}
+
+func TestJoystick_PlayerLEDs(t *testing.T) {
+	info := EvdevInfo{
+		Keys: []uint16{0x130},
+		LEDs: map[string]int{
+			"playstation::00:11:22:33:44:55::player-2": 1,
+			"playstation::00:11:22:33:44:55::player-1": 1,
+			"playstation::00:11:22:33:44:55::player-3": 1,
+			"input5:rgb:indicator":                     255,
+		},
+	}
+	device := newFakeFeedbackDevice(info)
+	j := newJoystick(0, "", device, info)
+
+	if caps := j.Capabilities(); caps.Rumble || caps.PlayerLEDs != 3 || len(caps.LEDs) != 4 {
+		t.Errorf("unexpected capabilities %+v", caps)
+	}
+	if err := j.SetPlayerIndex(2); err != nil {
+		t.Error(err)
+	}
+	if err := j.SetLED("input5:rgb:indicator", 1); err != nil {
+		t.Error(err)
+	}
+	if err := j.SetLED("missing", 1); err != ErrJoystickUnsupported {
+		t.Errorf("expected ErrJoystickUnsupported, got %v", err)
+	}
+
+	expectedLog := []string{
+		"led playstation::00:11:22:33:44:55::player-1=0",
+		"led playstation::00:11:22:33:44:55::player-2=1",
+		"led playstation::00:11:22:33:44:55::player-3=0",
+		"led input5:rgb:indicator=255",
+	}
+	if !reflect.DeepEqual(device.log, expectedLog) {
+		t.Errorf("expected %q, got %q", expectedLog, device.log)
+	}
+}
@@ -1,112 +1,288 @@
 // Copyright © 2012 Popog
 package glml
 
+import (
+	"strconv"
+)
+
 // Key codes
 type Key int
 
 const (
-	KeyUnknown   Key = -1   // Unhandled key
-	KeyA         Key = iota // The A key
-	KeyB                    // The B key
-	KeyC                    // The C key
-	KeyD                    // The D key
-	KeyE                    // The E key
-	KeyF                    // The F key
-	KeyG                    // The G key
-	KeyH                    // The H key
-	KeyI                    // The I key
-	KeyJ                    // The J key
-	KeyK                    // The K key
-	KeyL                    // The L key
-	KeyM                    // The M key
-	KeyN                    // The N key
-	KeyO                    // The O key
-	KeyP                    // The P key
-	KeyQ                    // The Q key
-	KeyR                    // The R key
-	KeyS                    // The S key
-	KeyT                    // The T key
-	KeyU                    // The U key
-	KeyV                    // The V key
-	KeyW                    // The W key
-	KeyX                    // The X key
-	KeyY                    // The Y key
-	KeyZ                    // The Z key
-	KeyNum0                 // The 0 key
-	KeyNum1                 // The 1 key
-	KeyNum2                 // The 2 key
-	KeyNum3                 // The 3 key
-	KeyNum4                 // The 4 key
-	KeyNum5                 // The 5 key
-	KeyNum6                 // The 6 key
-	KeyNum7                 // The 7 key
-	KeyNum8                 // The 8 key
-	KeyNum9                 // The 9 key
-	KeyEscape               // The Escape key
-	KeyLControl             // The left Control key
-	KeyLShift               // The left Shift key
-	KeyLAlt                 // The left Alt key
-	KeyLSystem              // The left OS specific key: window (Windows and Linux), apple (MacOS X), ...
-	KeyRControl             // The right Control key
-	KeyRShift               // The right Shift key
-	KeyRAlt                 // The right Alt key
-	KeyRSystem              // The right OS specific key: window (Windows and Linux), apple (MacOS X), ...
-	KeyMenu                 // The Menu key
-	KeyLBracket             // The [ key
-	KeyRBracket             // The ] key
-	KeySemiColon            // The ; key
-	KeyComma                // The , key
-	KeyPeriod               // The . key
-	KeyQuote                // The ' key
-	KeySlash                // The / key
-	KeyBackSlash            // The \ key
-	KeyTilde                // The ~ key
-	KeyEqual                // The = key
-	KeyDash                 // The - key
-	KeySpace                // The Space key
-	KeyReturn               // The Return key
-	KeyBackSpace            // The Backspace key
-	KeyTab                  // The Tabulation key
-	KeyPageUp               // The Page up key
-	KeyPageDown             // The Page down key
-	KeyEnd                  // The End key
-	KeyHome                 // The Home key
-	KeyInsert               // The Insert key
-	KeyDelete               // The Delete key
-	KeyAdd                  // +
-	KeySubtract             // -
-	KeyMultiply             // *
-	KeyDivide               // /
-	KeyLeft                 // Left arrow
-	KeyRight                // Right arrow
-	KeyUp                   // Up arrow
-	KeyDown                 // Down arrow
-	KeyNumpad0              // The numpad 0 key
-	KeyNumpad1              // The numpad 1 key
-	KeyNumpad2              // The numpad 2 key
-	KeyNumpad3              // The numpad 3 key
-	KeyNumpad4              // The numpad 4 key
-	KeyNumpad5              // The numpad 5 key
-	KeyNumpad6              // The numpad 6 key
-	KeyNumpad7              // The numpad 7 key
-	KeyNumpad8              // The numpad 8 key
-	KeyNumpad9              // The numpad 9 key
-	KeyF1                   // The F1 key
-	KeyF2                   // The F2 key
-	KeyF3                   // The F3 key
-	KeyF4                   // The F4 key
-	KeyF5                   // The F5 key
-	KeyF6                   // The F6 key
-	KeyF7                   // The F7 key
-	KeyF8                   // The F8 key
-	KeyF9                   // The F9 key
-	KeyF10                  // The F10 key
-	KeyF11                  // The F11 key
-	KeyF12                  // The F12 key
-	KeyF13                  // The F13 key
-	KeyF14                  // The F14 key
-	KeyF15                  // The F15 key
-	KeyPause                // The Pause key
+	KeyUnknown        Key = -1   // Unhandled key
+	KeyA              Key = iota // The A key
+	KeyB                         // The B key
+	KeyC                         // The C key
+	KeyD                         // The D key
+	KeyE                         // The E key
+	KeyF                         // The F key
+	KeyG                         // The G key
+	KeyH                         // The H key
+	KeyI                         // The I key
+	KeyJ                         // The J key
+	KeyK                         // The K key
+	KeyL                         // The L key
+	KeyM                         // The M key
+	KeyN                         // The N key
+	KeyO                         // The O key
+	KeyP                         // The P key
+	KeyQ                         // The Q key
+	KeyR                         // The R key
+	KeyS                         // The S key
+	KeyT                         // The T key
+	KeyU                         // The U key
+	KeyV                         // The V key
+	KeyW                         // The W key
+	KeyX                         // The X key
+	KeyY                         // The Y key
+	KeyZ                         // The Z key
+	KeyNum0                      // The 0 key
+	KeyNum1                      // The 1 key
+	KeyNum2                      // The 2 key
+	KeyNum3                      // The 3 key
+	KeyNum4                      // The 4 key
+	KeyNum5                      // The 5 key
+	KeyNum6                      // The 6 key
+	KeyNum7                      // The 7 key
+	KeyNum8                      // The 8 key
+	KeyNum9                      // The 9 key
+	KeyEscape                    // The Escape key
+	KeyLControl                  // The left Control key
+	KeyLShift                    // The left Shift key
+	KeyLAlt                      // The left Alt key
+	KeyLSystem                   // The left OS specific key: window (Windows and Linux), apple (MacOS X), ...
+	KeyRControl                  // The right Control key
+	KeyRShift                    // The right Shift key
+	KeyRAlt                      // The right Alt key
+	KeyRSystem                   // The right OS specific key: window (Windows and Linux), apple (MacOS X), ...
+	KeyMenu                      // The Menu key
+	KeyLBracket                  // The [ key
+	KeyRBracket                  // The ] key
+	KeySemiColon                 // The ; key
+	KeyComma                     // The , key
+	KeyPeriod                    // The . key
+	KeyQuote                     // The ' key
+	KeySlash                     // The / key
+	KeyBackSlash                 // The \ key
+	KeyTilde                     // The ~ key
+	KeyEqual                     // The = key
+	KeyDash                      // The - key
+	KeySpace                     // The Space key
+	KeyReturn                    // The Return key
+	KeyBackSpace                 // The Backspace key
+	KeyTab                       // The Tabulation key
+	KeyPageUp                    // The Page up key
+	KeyPageDown                  // The Page down key
+	KeyEnd                       // The End key
+	KeyHome                      // The Home key
+	KeyInsert                    // The Insert key
+	KeyDelete                    // The Delete key
+	KeyAdd                       // +
+	KeySubtract                  // -
+	KeyMultiply                  // *
+	KeyDivide                    // /
+	KeyLeft                      // Left arrow
+	KeyRight                     // Right arrow
+	KeyUp                        // Up arrow
+	KeyDown                      // Down arrow
+	KeyNumpad0                   // The numpad 0 key
+	KeyNumpad1                   // The numpad 1 key
+	KeyNumpad2                   // The numpad 2 key
+	KeyNumpad3                   // The numpad 3 key
+	KeyNumpad4                   // The numpad 4 key
+	KeyNumpad5                   // The numpad 5 key
+	KeyNumpad6                   // The numpad 6 key
+	KeyNumpad7                   // The numpad 7 key
+	KeyNumpad8                   // The numpad 8 key
+	KeyNumpad9                   // The numpad 9 key
+	KeyF1                        // The F1 key
+	KeyF2                        // The F2 key
+	KeyF3                        // The F3 key
+	KeyF4                        // The F4 key
+	KeyF5                        // The F5 key
+	KeyF6                        // The F6 key
+	KeyF7                        // The F7 key
+	KeyF8                        // The F8 key
+	KeyF9                        // The F9 key
+	KeyF10                       // The F10 key
+	KeyF11                       // The F11 key
+	KeyF12                       // The F12 key
+	KeyF13                       // The F13 key
+	KeyF14                       // The F14 key
+	KeyF15                       // The F15 key
+	KeyPause                     // The Pause key
+	KeyCapsLock                  // The Caps Lock key
+	KeyNumLock                   // The Num Lock key
+	KeyScrollLock                // The Scroll Lock key
+	KeyPrintScreen               // The Print Screen key
+	KeyNumpadEnter               // The numpad Enter key
+	KeyNumpadDecimal             // The numpad . key
+	KeyNumpadEqual               // The numpad = key
+	KeyF16                       // The F16 key
+	KeyF17                       // The F17 key
+	KeyF18                       // The F18 key
+	KeyF19                       // The F19 key
+	KeyF20                       // The F20 key
+	KeyF21                       // The F21 key
+	KeyF22                       // The F22 key
+	KeyF23                       // The F23 key
+	KeyF24                       // The F24 key
+	KeyVolumeMute                // The Mute key
+	KeyVolumeDown                // The Volume Down key
+	KeyVolumeUp                  // The Volume Up key
+	KeyMediaPlayPause            // The Play/Pause media key
+	KeyMediaStop                 // The Stop media key
+	KeyMediaNext                 // The Next Track media key
+	KeyMediaPrevious             // The Previous Track media key
+	KeyISOBackslash              // The extra key next to left Shift on ISO keyboards
+	KeyJISRo                     // The Ro key on JIS keyboards
+	KeyJISYen                    // The Yen key on JIS keyboards
+	KeyKana                      // The Kana key on JIS keyboards, the Hangul key on Korean keyboards
+	KeyKanji                     // The Kanji key on JIS keyboards, the Hanja key on Korean keyboards
+	KeyConvert                   // The Henkan (convert) key on JIS keyboards
+	KeyNonConvert                // The Muhenkan (non-convert) key on JIS keyboards
 
 	KeyCount // Keep last -- the total number of keyboard keys
 )
+
+// The names of the keys, without the Key prefix
+var keyNames = [KeyCount]string{
+	KeyA:              "A",
+	KeyB:              "B",
+	KeyC:              "C",
+	KeyD:              "D",
+	KeyE:              "E",
+	KeyF:              "F",
+	KeyG:              "G",
+	KeyH:              "H",
+	KeyI:              "I",
+	KeyJ:              "J",
+	KeyK:              "K",
+	KeyL:              "L",
+	KeyM:              "M",
+	KeyN:              "N",
+	KeyO:              "O",
+	KeyP:              "P",
+	KeyQ:              "Q",
+	KeyR:              "R",
+	KeyS:              "S",
+	KeyT:              "T",
+	KeyU:              "U",
+	KeyV:              "V",
+	KeyW:              "W",
+	KeyX:              "X",
+	KeyY:              "Y",
+	KeyZ:              "Z",
+	KeyNum0:           "Num0",
+	KeyNum1:           "Num1",
+	KeyNum2:           "Num2",
+	KeyNum3:           "Num3",
+	KeyNum4:           "Num4",
+	KeyNum5:           "Num5",
+	KeyNum6:           "Num6",
+	KeyNum7:           "Num7",
+	KeyNum8:           "Num8",
+	KeyNum9:           "Num9",
+	KeyEscape:         "Escape",
+	KeyLControl:       "LControl",
+	KeyLShift:         "LShift",
+	KeyLAlt:           "LAlt",
+	KeyLSystem:        "LSystem",
+	KeyRControl:       "RControl",
+	KeyRShift:         "RShift",
+	KeyRAlt:           "RAlt",
+	KeyRSystem:        "RSystem",
+	KeyMenu:           "Menu",
+	KeyLBracket:       "LBracket",
+	KeyRBracket:       "RBracket",
+	KeySemiColon:      "SemiColon",
+	KeyComma:          "Comma",
+	KeyPeriod:         "Period",
+	KeyQuote:          "Quote",
+	KeySlash:          "Slash",
+	KeyBackSlash:      "BackSlash",
+	KeyTilde:          "Tilde",
+	KeyEqual:          "Equal",
+	KeyDash:           "Dash",
+	KeySpace:          "Space",
+	KeyReturn:         "Return",
+	KeyBackSpace:      "BackSpace",
+	KeyTab:            "Tab",
+	KeyPageUp:         "PageUp",
+	KeyPageDown:       "PageDown",
+	KeyEnd:            "End",
+	KeyHome:           "Home",
+	KeyInsert:         "Insert",
+	KeyDelete:         "Delete",
+	KeyAdd:            "Add",
+	KeySubtract:       "Subtract",
+	KeyMultiply:       "Multiply",
+	KeyDivide:         "Divide",
+	KeyLeft:           "Left",
+	KeyRight:          "Right",
+	KeyUp:             "Up",
+	KeyDown:           "Down",
+	KeyNumpad0:        "Numpad0",
+	KeyNumpad1:        "Numpad1",
+	KeyNumpad2:        "Numpad2",
+	KeyNumpad3:        "Numpad3",
+	KeyNumpad4:        "Numpad4",
+	KeyNumpad5:        "Numpad5",
+	KeyNumpad6:        "Numpad6",
+	KeyNumpad7:        "Numpad7",
+	KeyNumpad8:        "Numpad8",
+	KeyNumpad9:        "Numpad9",
+	KeyF1:             "F1",
+	KeyF2:             "F2",
+	KeyF3:             "F3",
+	KeyF4:             "F4",
+	KeyF5:             "F5",
+	KeyF6:             "F6",
+	KeyF7:             "F7",
+	KeyF8:             "F8",
+	KeyF9:             "F9",
+	KeyF10:            "F10",
+	KeyF11:            "F11",
+	KeyF12:            "F12",
+	KeyF13:            "F13",
+	KeyF14:            "F14",
+	KeyF15:            "F15",
+	KeyPause:          "Pause",
+	KeyCapsLock:       "CapsLock",
+	KeyNumLock:        "NumLock",
+	KeyScrollLock:     "ScrollLock",
+	KeyPrintScreen:    "PrintScreen",
+	KeyNumpadEnter:    "NumpadEnter",
+	KeyNumpadDecimal:  "NumpadDecimal",
+	KeyNumpadEqual:    "NumpadEqual",
+	KeyF16:            "F16",
+	KeyF17:            "F17",
+	KeyF18:            "F18",
+	KeyF19:            "F19",
+	KeyF20:            "F20",
+	KeyF21:            "F21",
+	KeyF22:            "F22",
+	KeyF23:            "F23",
+	KeyF24:            "F24",
+	KeyVolumeMute:     "VolumeMute",
+	KeyVolumeDown:     "VolumeDown",
+	KeyVolumeUp:       "VolumeUp",
+	KeyMediaPlayPause: "MediaPlayPause",
+	KeyMediaStop:      "MediaStop",
+	KeyMediaNext:      "MediaNext",
+	KeyMediaPrevious:  "MediaPrevious",
+	KeyISOBackslash:   "ISOBackslash",
+	KeyJISRo:          "JISRo",
+	KeyJISYen:         "JISYen",
+	KeyKana:           "Kana",
+	KeyKanji:          "Kanji",
+	KeyConvert:        "Convert",
+	KeyNonConvert:     "NonConvert",
+}
+
+func (k Key) String() string {
+	if k < 0 || k >= KeyCount || keyNames[k] == "" {
+		return "Key(" + strconv.Itoa(int(k)) + ")"
+	}
+	return keyNames[k]
+}
@@ -3,6 +3,9 @@ package glml
 
 // #include "helper_windows.h"
 import "C"
+import (
+	"sync"
+)
 
 var keyboard_vkeys_map = make(map[C.int]Key)
 var keyboard_vkeys = [KeyCount]C.int{
@@ -107,28 +110,285 @@ var keyboard_vkeys = [KeyCount]C.int{
 	KeyF12:       C.VK_F12,
 	KeyF13:       C.VK_F13,
 	KeyF14:       C.VK_F14,
-	KeyF15:       C.VK_F16,
+	KeyF15:       C.VK_F15,
 	KeyPause:     C.VK_PAUSE,
+
+	KeyCapsLock:       C.VK_CAPITAL,
+	KeyNumLock:        C.VK_NUMLOCK,
+	KeyScrollLock:     C.VK_SCROLL,
+	KeyPrintScreen:    C.VK_SNAPSHOT,
+	KeyNumpadEnter:    0, // VK_RETURN with the extended flag, see virtualKeyCodeToSF
+	KeyNumpadDecimal:  C.VK_DECIMAL,
+	KeyNumpadEqual:    C.VK_OEM_NEC_EQUAL,
+	KeyF16:            C.VK_F16,
+	KeyF17:            C.VK_F17,
+	KeyF18:            C.VK_F18,
+	KeyF19:            C.VK_F19,
+	KeyF20:            C.VK_F20,
+	KeyF21:            C.VK_F21,
+	KeyF22:            C.VK_F22,
+	KeyF23:            C.VK_F23,
+	KeyF24:            C.VK_F24,
+	KeyVolumeMute:     C.VK_VOLUME_MUTE,
+	KeyVolumeDown:     C.VK_VOLUME_DOWN,
+	KeyVolumeUp:       C.VK_VOLUME_UP,
+	KeyMediaPlayPause: C.VK_MEDIA_PLAY_PAUSE,
+	KeyMediaStop:      C.VK_MEDIA_STOP,
+	KeyMediaNext:      C.VK_MEDIA_NEXT_TRACK,
+	KeyMediaPrevious:  C.VK_MEDIA_PREV_TRACK,
+	KeyISOBackslash:   C.VK_OEM_102,
+	KeyJISRo:          0, // VK_OEM_102 at scancode 0x73, see virtualKeyCodeToSF
+	KeyJISYen:         0, // VK_OEM_5 at scancode 0x7D, see virtualKeyCodeToSF
+	KeyKana:           C.VK_KANA,
+	KeyKanji:          C.VK_KANJI,
+	KeyConvert:        C.VK_CONVERT,
+	KeyNonConvert:     C.VK_NONCONVERT,
+}
+
+// Set 1 make codes, with 0xE000 added for extended keys
+var scancodePhysicalKeys = map[Scancode]PhysicalKey{
+	0x1E: PhysicalKeyA,
+	0x30: PhysicalKeyB,
+	0x2E: PhysicalKeyC,
+	0x20: PhysicalKeyD,
+	0x12: PhysicalKeyE,
+	0x21: PhysicalKeyF,
+	0x22: PhysicalKeyG,
+	0x23: PhysicalKeyH,
+	0x17: PhysicalKeyI,
+	0x24: PhysicalKeyJ,
+	0x25: PhysicalKeyK,
+	0x26: PhysicalKeyL,
+	0x32: PhysicalKeyM,
+	0x31: PhysicalKeyN,
+	0x18: PhysicalKeyO,
+	0x19: PhysicalKeyP,
+	0x10: PhysicalKeyQ,
+	0x13: PhysicalKeyR,
+	0x1F: PhysicalKeyS,
+	0x14: PhysicalKeyT,
+	0x16: PhysicalKeyU,
+	0x2F: PhysicalKeyV,
+	0x11: PhysicalKeyW,
+	0x2D: PhysicalKeyX,
+	0x15: PhysicalKeyY,
+	0x2C: PhysicalKeyZ,
+
+	0x02: PhysicalKey1,
+	0x03: PhysicalKey2,
+	0x04: PhysicalKey3,
+	0x05: PhysicalKey4,
+	0x06: PhysicalKey5,
+	0x07: PhysicalKey6,
+	0x08: PhysicalKey7,
+	0x09: PhysicalKey8,
+	0x0A: PhysicalKey9,
+	0x0B: PhysicalKey0,
+
+	0x1C: PhysicalKeyEnter,
+	0x01: PhysicalKeyEscape,
+	0x0E: PhysicalKeyBackspace,
+	0x0F: PhysicalKeyTab,
+	0x39: PhysicalKeySpace,
+	0x0C: PhysicalKeyMinus,
+	0x0D: PhysicalKeyEqual,
+	0x1A: PhysicalKeyLeftBracket,
+	0x1B: PhysicalKeyRightBracket,
+	0x2B: PhysicalKeyBackslash, // Also PhysicalKeyNonUSHash, they share a scancode
+	0x27: PhysicalKeySemicolon,
+	0x28: PhysicalKeyApostrophe,
+	0x29: PhysicalKeyGrave,
+	0x33: PhysicalKeyComma,
+	0x34: PhysicalKeyPeriod,
+	0x35: PhysicalKeySlash,
+	0x3A: PhysicalKeyCapsLock,
+	0x3B: PhysicalKeyF1,
+	0x3C: PhysicalKeyF2,
+	0x3D: PhysicalKeyF3,
+	0x3E: PhysicalKeyF4,
+	0x3F: PhysicalKeyF5,
+	0x40: PhysicalKeyF6,
+	0x41: PhysicalKeyF7,
+	0x42: PhysicalKeyF8,
+	0x43: PhysicalKeyF9,
+	0x44: PhysicalKeyF10,
+	0x57: PhysicalKeyF11,
+	0x58: PhysicalKeyF12,
+
+	0xE037: PhysicalKeyPrintScreen,
+	0x46:   PhysicalKeyScrollLock,
+	0x45:   PhysicalKeyPause, // Windows reports Pause without the E1 prefix
+	0xE052: PhysicalKeyInsert,
+	0xE047: PhysicalKeyHome,
+	0xE049: PhysicalKeyPageUp,
+	0xE053: PhysicalKeyDelete,
+	0xE04F: PhysicalKeyEnd,
+	0xE051: PhysicalKeyPageDown,
+	0xE04D: PhysicalKeyRight,
+	0xE04B: PhysicalKeyLeft,
+	0xE050: PhysicalKeyDown,
+	0xE048: PhysicalKeyUp,
+
+	0xE045: PhysicalKeyNumLock,
+	0xE035: PhysicalKeyNumpadDivide,
+	0x37:   PhysicalKeyNumpadMultiply,
+	0x4A:   PhysicalKeyNumpadSubtract,
+	0x4E:   PhysicalKeyNumpadAdd,
+	0xE01C: PhysicalKeyNumpadEnter,
+	0x4F:   PhysicalKeyNumpad1,
+	0x50:   PhysicalKeyNumpad2,
+	0x51:   PhysicalKeyNumpad3,
+	0x4B:   PhysicalKeyNumpad4,
+	0x4C:   PhysicalKeyNumpad5,
+	0x4D:   PhysicalKeyNumpad6,
+	0x47:   PhysicalKeyNumpad7,
+	0x48:   PhysicalKeyNumpad8,
+	0x49:   PhysicalKeyNumpad9,
+	0x52:   PhysicalKeyNumpad0,
+	0x53:   PhysicalKeyNumpadDecimal,
+	0x59:   PhysicalKeyNumpadEqual,
+
+	0x56:   PhysicalKeyNonUSBackslash,
+	0xE05D: PhysicalKeyApplication,
+	0xE05E: PhysicalKeyPower,
+
+	0x64: PhysicalKeyF13,
+	0x65: PhysicalKeyF14,
+	0x66: PhysicalKeyF15,
+	0x67: PhysicalKeyF16,
+	0x68: PhysicalKeyF17,
+	0x69: PhysicalKeyF18,
+	0x6A: PhysicalKeyF19,
+	0x6B: PhysicalKeyF20,
+	0x6C: PhysicalKeyF21,
+	0x6D: PhysicalKeyF22,
+	0x6E: PhysicalKeyF23,
+	0x76: PhysicalKeyF24,
+
+	0xE020: PhysicalKeyMute,
+	0xE030: PhysicalKeyVolumeUp,
+	0xE02E: PhysicalKeyVolumeDown,
+
+	0x73: PhysicalKeyInternational1,
+	0x70: PhysicalKeyInternational2,
+	0x7D: PhysicalKeyInternational3,
+	0x79: PhysicalKeyInternational4,
+	0x7B: PhysicalKeyInternational5,
+	0x72: PhysicalKeyLang1,
+	0x71: PhysicalKeyLang2,
+
+	0x1D:   PhysicalKeyLeftControl,
+	0x2A:   PhysicalKeyLeftShift,
+	0x38:   PhysicalKeyLeftAlt,
+	0xE05B: PhysicalKeyLeftSystem,
+	0xE01D: PhysicalKeyRightControl,
+	0x36:   PhysicalKeyRightShift,
+	0xE038: PhysicalKeyRightAlt,
+	0xE05C: PhysicalKeyRightSystem,
+}
+
+// Control is used for shortcuts on Windows
+const primaryShortcutModifier = ModControl
+
+var modifierVirtualKeys = [...]struct {
+	vkey   C.int
+	mod    Modifiers
+	toggle bool // Use the toggle state instead of whether the key is held
+}{
+	{C.VK_LSHIFT, ModLShift, false},
+	{C.VK_RSHIFT, ModRShift, false},
+	{C.VK_LCONTROL, ModLControl, false},
+	{C.VK_RCONTROL, ModRControl, false},
+	{C.VK_LMENU, ModLAlt, false},
+	{C.VK_RMENU, ModRAlt, false},
+	{C.VK_LWIN, ModLSystem, false},
+	{C.VK_RWIN, ModRSystem, false},
+	{C.VK_CAPITAL, ModCapsLock, true},
+	{C.VK_NUMLOCK, ModNumLock, true},
+	{C.VK_SCROLL, ModScrollLock, true},
 }
 
-var lShift = C.MapVirtualKey(C.VK_LSHIFT, C.MAPVK_VK_TO_VSC)
+// Get the modifiers at the time the message being processed was posted.
+// Unlike GetAsyncKeyState, GetKeyState follows the message queue.
+func messageModifiers() Modifiers {
+	var mods Modifiers
+	for _, m := range modifierVirtualKeys {
+		state := uint16(C.GetKeyState(m.vkey))
+		if (m.toggle && state&1 != 0) || (!m.toggle && state&0x8000 != 0) {
+			mods |= m.mod
+		}
+	}
+	return mods
+}
+
+// The scancode of the left shift key, set by Init
+var lShift C.UINT
 
 func init() {
 	for kk, vk := range keyboard_vkeys {
-		keyboard_vkeys_map[vk] = Key(kk)
+		if vk != 0 {
+			keyboard_vkeys_map[vk] = Key(kk)
+		}
 	}
 }
 
-// Check if a key is pressed
+// The keys which share a virtual key with another key, and the virtual key
+var sharedVirtualKeys = map[Key]C.int{
+	KeyNumpadEnter: C.VK_RETURN,
+	KeyJISRo:       C.VK_OEM_102,
+	KeyJISYen:      C.VK_OEM_5,
+}
+
+// Which keys of sharedVirtualKeys are held, as last seen in the key
+// messages of a window
+var sharedKeysPressed = make(map[Key]bool)
+var sharedKeysMutex sync.Mutex // Guards sharedKeysPressed, which any goroutine may read
+
+// Record a key message of a key which shares its virtual key
+func noteSharedKey(vkey C.WPARAM, flags C.LPARAM, pressed bool) {
+	key := virtualKeyCodeToSF(vkey, flags)
+	if _, ok := sharedVirtualKeys[key]; !ok {
+		return
+	}
+
+	sharedKeysMutex.Lock()
+	defer sharedKeysMutex.Unlock()
+	sharedKeysPressed[key] = pressed
+}
+
+// Check if a key is pressed, asking the OS. See InputState for the state
+// which agrees with a window's events.
+//
+// The OS can't tell apart the keys which share a virtual key, e.g.
+// KeyNumpadEnter and KeyReturn. Those are only reported as pressed while
+// their virtual key is held and the last key message a window got for them
+// was a press.
 func IsKeyPressed(key Key) bool {
 	if key < 0 || key >= KeyCount {
 		return false
 	}
 
+	if vkey, ok := sharedVirtualKeys[key]; ok {
+		sharedKeysMutex.Lock()
+		pressed := sharedKeysPressed[key]
+		sharedKeysMutex.Unlock()
+		return pressed && uint16(C.GetAsyncKeyState(vkey))&0x8000 != 0
+	}
+
 	return uint16(C.GetAsyncKeyState(C.int(keyboard_vkeys[key])))&0x8000 != 0
 }
 
 func virtualKeyCodeToSF(vkey C.WPARAM, flags C.LPARAM) Key {
+	// Keys which share a virtual key code with another key
+	switch scancode := keyMessageScancode(flags); {
+	case vkey == C.VK_RETURN && scancode == 0xE01C:
+		return KeyNumpadEnter
+	case vkey == C.VK_OEM_102 && scancode == 0x73:
+		return KeyJISRo
+	case vkey == C.VK_OEM_5 && scancode == 0x7D:
+		return KeyJISYen
+	}
+
 	if key, ok := keyboard_vkeys_map[C.int(vkey)]; ok {
 		return key
 	}
@@ -160,3 +420,51 @@ func virtualKeyCodeToSF(vkey C.WPARAM, flags C.LPARAM) Key {
 
 	return KeyUnknown
 }
+
+// Get the scancode from the flags of a keyboard message
+func keyMessageScancode(flags C.LPARAM) Scancode {
+	scancode := Scancode((flags >> 16) & 0xFF)
+	if C.__HIWORD(C.DWORD(flags))&C.KF_EXTENDED != 0 {
+		scancode |= 0xE000
+	}
+	return scancode
+}
+
+// Uses the keyboard layout of the calling thread
+func scancodeToKey(scancode Scancode) Key {
+	vkey := C.MapVirtualKeyW(C.UINT(scancode), C.MAPVK_VSC_TO_VK_EX)
+	if vkey == 0 {
+		return KeyUnknown
+	}
+
+	if key, ok := keyboard_vkeys_map[C.int(vkey)]; ok {
+		return key
+	}
+	return KeyUnknown
+}
+
+// Uses the keyboard layout of the calling thread
+func keyToScancode(key Key) Scancode {
+	vkey := keyboard_vkeys[key]
+	if vkey == 0 {
+		return 0
+	}
+
+	// Extended keys are returned with an E0 prefix, as in our scancodes
+	return Scancode(C.MapVirtualKeyW(C.UINT(vkey), C.MAPVK_VK_TO_VSC_EX))
+}
+
+// Get the localized name of the key at a scancode, or "" if it has none
+func scancodeName(scancode Scancode) string {
+	flags := C.LONG(scancode&0xFF) << 16
+	if scancode&0xE000 != 0 {
+		flags |= 1 << 24
+	}
+
+	var name [64]C.WCHAR
+	length := C.GetKeyNameTextW(flags, &name[0], C.int(len(name)))
+	if length <= 0 {
+		return ""
+	}
+	return utf16ConvertFrom(name[:length])
+}
@@ -0,0 +1,97 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestVirtualKeys(t *testing.T) {
+	// Keys reported by message flags rather than a virtual key of their own
+	shared := map[Key]bool{
+		KeyNumpadEnter: true,
+		KeyJISRo:       true,
+		KeyJISYen:      true,
+	}
+
+	for key := Key(0); key < KeyCount; key++ {
+		vkey := keyboard_vkeys[key]
+		if key == 0 || shared[key] {
+			if vkey != 0 {
+				t.Errorf("key %d should not have a virtual key", key)
+			}
+			continue
+		}
+		if _, ok := sharedVirtualKeys[key]; ok {
+			t.Errorf("key %d has a virtual key of its own, but is shared", key)
+		}
+
+		if vkey == 0 {
+			t.Errorf("key %d has no virtual key", key)
+		} else if k, ok := keyboard_vkeys_map[vkey]; !ok || k != key {
+			t.Errorf("key %d round trips to %d", key, k)
+		}
+	}
+
+	// Keys which have been mapped to the wrong virtual key before
+	tests := []struct {
+		key  Key
+		vkey int
+	}{
+		{KeyF15, 0x7E},
+		{KeyF16, 0x7F},
+		{KeyF24, 0x87},
+		{KeyCapsLock, 0x14},
+		{KeyPrintScreen, 0x2C},
+		{KeyNumpadDecimal, 0x6E},
+		{KeyMediaPlayPause, 0xB3},
+		{KeyISOBackslash, 0xE2},
+	}
+
+	for _, test := range tests {
+		if vkey := int(keyboard_vkeys[test.key]); vkey != test.vkey {
+			t.Errorf("key %d: expected virtual key 0x%X, got 0x%X", test.key, test.vkey, vkey)
+		}
+	}
+}
+
+// The keys sharing a virtual key round trip through the scancode of their
+// key messages. The flags hold the scancode in bits 16 to 23 and the
+// extended flag in bit 24.
+func TestSharedVirtualKeys(t *testing.T) {
+	vkeys := map[Key]int{KeyNumpadEnter: 0x0D, KeyJISRo: 0xE2, KeyJISYen: 0xDC}
+	for key, expected := range vkeys {
+		if vkey, ok := sharedVirtualKeys[key]; !ok || int(vkey) != expected {
+			t.Errorf("key %d: expected shared virtual key 0x%X, got 0x%X", key, expected, int(vkey))
+		}
+	}
+
+	tests := []struct {
+		expected, got Key
+	}{
+		{KeyNumpadEnter, virtualKeyCodeToSF(0x0D, 0x011C<<16)},
+		{KeyReturn, virtualKeyCodeToSF(0x0D, 0x1C<<16)},
+		{KeyJISRo, virtualKeyCodeToSF(0xE2, 0x73<<16)},
+		{KeyISOBackslash, virtualKeyCodeToSF(0xE2, 0x56<<16)},
+		{KeyJISYen, virtualKeyCodeToSF(0xDC, 0x7D<<16)},
+		{KeyBackSlash, virtualKeyCodeToSF(0xDC, 0x2B<<16)},
+	}
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("expected key %d, got %d", test.expected, test.got)
+		}
+	}
+
+	// Only the shared key's own messages change its state
+	noteSharedKey(0x0D, 0x1C<<16, true)
+	if sharedKeysPressed[KeyNumpadEnter] {
+		t.Error("expected KeyReturn not to press KeyNumpadEnter")
+	}
+	noteSharedKey(0x0D, 0x011C<<16, true)
+	if !sharedKeysPressed[KeyNumpadEnter] {
+		t.Error("expected KeyNumpadEnter to be pressed")
+	}
+	noteSharedKey(0x0D, 0x011C<<16, false)
+	if sharedKeysPressed[KeyNumpadEnter] {
+		t.Error("expected KeyNumpadEnter to be released")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A snapshot of a keyboard layout: the characters each Key produces, and
+// the names to show for keys and shortcuts. Get the current one with
+// Window.ThreadGetKeymap, and a new one from each
+// KeyboardLayoutChangedEvent.
+type Keymap struct {
+	Layout   string // The display name of the layout, e.g. "German (Germany)"
+	LayoutID string // The platform's identifier for the layout
+
+	chars    map[keymapLevel]rune
+	names    map[Key]string
+	keys     map[PhysicalKey]Key
+	modNames map[Modifiers]string // Names of ModControl, ModAlt, ModShift and ModSystem
+}
+
+// The modifiers that select which character a key produces
+type keymapLevel struct {
+	key  Key
+	mods Modifiers // A combination of ModShift, keymapAltGr and ModCapsLock
+}
+
+// AltGr is reported as right Alt and left Control on Windows
+const keymapAltGr = ModRAlt | ModLControl
+
+// The order modifiers are listed in shortcut names
+var keymapModifierOrder = [...]Modifiers{ModControl, ModAlt, ModShift, ModSystem}
+
+func newKeymap(layout, layoutID string) *Keymap {
+	return &Keymap{
+		Layout:   layout,
+		LayoutID: layoutID,
+		chars:    make(map[keymapLevel]rune),
+		names:    make(map[Key]string),
+		keys:     make(map[PhysicalKey]Key),
+		modNames: map[Modifiers]string{
+			ModControl: "Ctrl",
+			ModAlt:     "Alt",
+			ModShift:   "Shift",
+			ModSystem:  "Win",
+		},
+	}
+}
+
+// Reduce modifiers to the ones which select a character
+func keymapLevelModifiers(mods Modifiers) Modifiers {
+	var level Modifiers
+	if mods.Shift() {
+		level |= ModShift
+	}
+	if mods.Control() && mods.Alt() {
+		level |= keymapAltGr
+	}
+	if mods.Any(ModCapsLock) {
+		level |= ModCapsLock
+	}
+	return level
+}
+
+// Record the character a key produces with the given modifiers
+func (k *Keymap) setChar(key Key, mods Modifiers, char rune) {
+	k.chars[keymapLevel{key, keymapLevelModifiers(mods)}] = char
+}
+
+// Get the character a key produces with the given modifiers. Shift, Caps
+// Lock and AltGr (Control and Alt together) select the character; other
+// modifiers are ignored. Dead keys produce the accent they add.
+func (k *Keymap) Char(key Key, mods Modifiers) (rune, bool) {
+	char, ok := k.chars[keymapLevel{key, keymapLevelModifiers(mods)}]
+	return char, ok
+}
+
+// Get the Key produced by a physical key in this layout, or KeyUnknown
+func (k *Keymap) Key(key PhysicalKey) Key {
+	if key, ok := k.keys[key]; ok {
+		return key
+	}
+	return KeyUnknown
+}
+
+// Get the label of a key in this layout, e.g. "Z", "#" or "Enter"
+func (k *Keymap) KeyName(key Key) string {
+	if char, ok := k.Char(key, 0); ok && unicode.IsGraphic(char) && !unicode.IsSpace(char) {
+		return string(unicode.ToUpper(char))
+	}
+	return k.names[key]
+}
+
+// Get the label of a shortcut in this layout, e.g. "Ctrl+Z" or "Strg+Y".
+// Left and right modifiers are not distinguished, and lock states are
+// ignored.
+func (k *Keymap) ShortcutName(key Key, mods Modifiers) string {
+	var parts []string
+	for _, mod := range keymapModifierOrder {
+		if mods.Any(mod) {
+			parts = append(parts, k.modNames[mod])
+		}
+	}
+	return strings.Join(append(parts, k.KeyName(key)), "+")
+}
+
+// Expects to be called on InitialThread()
+// Get the keymap of the window's current keyboard layout
+func (w *Window) ThreadGetKeymap(thread *Thread) *Keymap {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return currentKeymap()
+}
+
+// A thread command helper for Window.ThreadGetKeymap
+func WindowThreadGetKeymap(results chan<- *Keymap) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		results <- t.(*Window).ThreadGetKeymap(thread)
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+// A fixed excerpt of the German (QWERTZ) layout
+func germanKeymap() *Keymap {
+	km := newKeymap("German (Germany)", "00000407")
+	km.setChar(KeyY, 0, 'y')
+	km.setChar(KeyY, ModShift, 'Y')
+	km.setChar(KeyZ, 0, 'z')
+	km.setChar(KeyZ, ModLShift, 'Z')
+	km.setChar(KeyQ, 0, 'q')
+	km.setChar(KeyQ, keymapAltGr, '@')
+	km.setChar(KeySlash, 0, '#')
+	km.setChar(KeySlash, ModShift, '\'')
+	km.setChar(KeyTilde, 0, '^') // Dead key
+	km.setChar(KeySpace, 0, ' ')
+	km.names[KeySpace] = "Leertaste"
+	km.names[KeyReturn] = "Eingabe"
+	km.keys[PhysicalKeyY] = KeyZ
+	km.keys[PhysicalKeyZ] = KeyY
+	km.modNames[ModControl] = "Strg"
+	km.modNames[ModShift] = "Umschalt"
+	return km
+}
+
+func TestKeymap_Char(t *testing.T) {
+	km := germanKeymap()
+	tests := []struct {
+		key  Key
+		mods Modifiers
+		char rune
+		ok   bool
+	}{
+		{KeyZ, 0, 'z', true},
+		{KeyZ, ModRShift, 'Z', true},
+		{KeyZ, ModLShift | ModNumLock, 'Z', true},
+		{KeyZ, ModLControl, 'z', true},
+		{KeyQ, ModRAlt | ModLControl, '@', true},
+		{KeyQ, ModControl | ModAlt, '@', true},
+		{KeyQ, ModShift, 0, false},
+		{KeyA, 0, 0, false},
+	}
+
+	for _, test := range tests {
+		if char, ok := km.Char(test.key, test.mods); char != test.char || ok != test.ok {
+			t.Errorf("Char(%d, %s) = %q, %v", test.key, test.mods, char, ok)
+		}
+	}
+}
+
+func TestKeymap_Names(t *testing.T) {
+	km := germanKeymap()
+
+	if key := km.Key(PhysicalKeyZ); key != KeyY {
+		t.Errorf("expected the physical Z key to produce KeyY, got %d", key)
+	}
+	if key := km.Key(PhysicalKeyA); key != KeyUnknown {
+		t.Errorf("unexpected key %d", key)
+	}
+
+	tests := []struct {
+		key  Key
+		mods Modifiers
+		name string
+	}{
+		{KeySlash, 0, "#"},
+		{KeyTilde, 0, "^"},
+		{KeySpace, 0, "Leertaste"},
+		{KeyReturn, ModAlt, "Alt+Eingabe"},
+		{km.Key(PhysicalKeyZ), ModLControl, "Strg+Y"},
+		{KeyZ, ModRControl | ModShift | ModCapsLock, "Strg+Umschalt+Z"},
+	}
+
+	for _, test := range tests {
+		if name := km.ShortcutName(test.key, test.mods); name != test.name {
+			t.Errorf("expected %q, got %q", test.name, name)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "helper_windows.h"
+import "C"
+import (
+	"unicode"
+	"unicode/utf16"
+	"unsafe"
+)
+
+// The modifier combinations a keymap records characters for, and the
+// virtual keys held (or toggled, for Caps Lock) to produce them
+var keymapLevels = [...]struct {
+	mods  Modifiers
+	vkeys []C.int
+}{
+	{0, nil},
+	{ModLShift, []C.int{C.VK_SHIFT, C.VK_LSHIFT}},
+	{keymapAltGr, []C.int{C.VK_CONTROL, C.VK_LCONTROL, C.VK_MENU, C.VK_RMENU}},
+	{ModLShift | keymapAltGr, []C.int{C.VK_SHIFT, C.VK_LSHIFT, C.VK_CONTROL, C.VK_LCONTROL, C.VK_MENU, C.VK_RMENU}},
+	{ModCapsLock, []C.int{C.VK_CAPITAL}},
+	{ModCapsLock | ModLShift, []C.int{C.VK_CAPITAL, C.VK_SHIFT, C.VK_LSHIFT}},
+}
+
+// Don't change the keyboard state, e.g. a pending dead key. Older versions
+// than Windows 10 1607 ignore the flag.
+const toUnicodeNoStateChange = 1 << 2
+
+// Does ToUnicodeEx honour toUnicodeNoStateChange? Set by Init, and until
+// then the workaround for older versions is used, which works everywhere.
+var toUnicodeKeepsState bool
+
+// Translate a key without disturbing the keyboard state. Where
+// toUnicodeNoStateChange is ignored, a dead key is left pending, and is
+// cleared by translating it a second time.
+func toUnicodeQuery(vkey, scancode C.UINT, state *C.BYTE, buffer []C.WCHAR, layout C.HKL) C.int {
+	if toUnicodeKeepsState {
+		return C.ToUnicodeEx(vkey, scancode, state, &buffer[0], C.int(len(buffer)), toUnicodeNoStateChange, layout)
+	}
+
+	length := C.ToUnicodeEx(vkey, scancode, state, &buffer[0], C.int(len(buffer)), 0, layout)
+	if length < 0 {
+		var discard [8]C.WCHAR
+		C.ToUnicodeEx(vkey, scancode, state, &discard[0], C.int(len(discard)), 0, layout)
+	}
+	return length
+}
+
+// Get the display name of a language, e.g. "German (Germany)"
+func languageName(langid C.WORD) string {
+	var name [128]C.WCHAR
+	length := C.GetLocaleInfoW(C.LCID(langid), C.LOCALE_SLANGUAGE, &name[0], C.int(len(name)))
+	if length <= 1 {
+		return ""
+	}
+	return utf16ConvertFrom(name[:length-1])
+}
+
+// Build a keymap of the calling thread's keyboard layout
+func currentKeymap() *Keymap {
+	layout := C.GetKeyboardLayout(0)
+
+	var id [C.KL_NAMELENGTH]C.WCHAR
+	layoutID := ""
+	if C.GetKeyboardLayoutNameW(&id[0]) != 0 {
+		layoutID = utf16ConvertFrom(id[:len(id)-1])
+	}
+
+	// The low word of the layout handle is its language
+	km := newKeymap(languageName(C.WORD(uintptr(unsafe.Pointer(layout))&0xFFFF)), layoutID)
+
+	for key := Key(0); key < KeyCount; key++ {
+		vkey := keyboard_vkeys[key]
+		if vkey == 0 {
+			continue
+		}
+
+		scancode := C.MapVirtualKeyExW(C.UINT(vkey), C.MAPVK_VK_TO_VSC, layout)
+		for _, level := range keymapLevels {
+			var state [256]C.BYTE
+			for _, held := range level.vkeys {
+				if held == C.VK_CAPITAL {
+					state[held] = 0x01 // Toggled
+				} else {
+					state[held] = 0x80 // Held
+				}
+			}
+
+			var buffer [8]C.WCHAR
+			length := toUnicodeQuery(C.UINT(vkey), scancode, &state[0], buffer[:], layout)
+			if length < 0 {
+				length = 1 // A dead key, the buffer holds its accent
+			}
+			if length <= 0 {
+				continue
+			}
+
+			units := make([]uint16, length)
+			for i := range units {
+				units[i] = uint16(buffer[i])
+			}
+			if chars := utf16.Decode(units); len(chars) == 1 && !unicode.IsControl(chars[0]) {
+				km.setChar(key, level.mods, chars[0])
+			}
+		}
+
+		if name := scancodeName(keyToScancode(key)); name != "" {
+			km.names[key] = name
+		}
+	}
+
+	for physical := range physicalKeyScancodes {
+		if key := PhysicalKeyToKey(physical); key != KeyUnknown {
+			km.keys[physical] = key
+		}
+	}
+
+	// Localized modifier names, e.g. "Strg" on German systems
+	for mod, scancode := range map[Modifiers]Scancode{ModControl: 0x1D, ModAlt: 0x38, ModShift: 0x2A} {
+		if name := scancodeName(scancode); name != "" {
+			km.modNames[mod] = name
+		}
+	}
+
+	return km
+}
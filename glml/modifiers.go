@@ -0,0 +1,103 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strconv"
+	"strings"
+)
+
+// The state of the modifier and lock keys when an event occurred
+type Modifiers uint32
+
+const (
+	ModLShift     Modifiers = 1 << iota // The left Shift key is held
+	ModRShift                           // The right Shift key is held
+	ModLControl                         // The left Control key is held
+	ModRControl                         // The right Control key is held
+	ModLAlt                             // The left Alt key is held
+	ModRAlt                             // The right Alt key is held
+	ModLSystem                          // The left OS specific key is held
+	ModRSystem                          // The right OS specific key is held
+	ModCapsLock                         // Caps Lock is on
+	ModNumLock                          // Num Lock is on
+	ModScrollLock                       // Scroll Lock is on
+
+	modCount = iota // Keep after the last modifier -- the number of modifiers
+
+	ModShift   = ModLShift | ModRShift     // Either Shift key is held
+	ModControl = ModLControl | ModRControl // Either Control key is held
+	ModAlt     = ModLAlt | ModRAlt         // Either Alt key is held
+	ModSystem  = ModLSystem | ModRSystem   // Either OS specific key is held
+)
+
+var modifierNames = [modCount]string{
+	"LShift",
+	"RShift",
+	"LControl",
+	"RControl",
+	"LAlt",
+	"RAlt",
+	"LSystem",
+	"RSystem",
+	"CapsLock",
+	"NumLock",
+	"ScrollLock",
+}
+
+// Returns true if any of the given modifiers are set
+func (m Modifiers) Any(mods Modifiers) bool {
+	return m&mods != 0
+}
+
+// Returns true if all of the given modifiers are set
+func (m Modifiers) All(mods Modifiers) bool {
+	return m&mods == mods
+}
+
+// Returns true if either Shift key is held
+func (m Modifiers) Shift() bool {
+	return m.Any(ModShift)
+}
+
+// Returns true if either Control key is held
+func (m Modifiers) Control() bool {
+	return m.Any(ModControl)
+}
+
+// Returns true if either Alt key is held
+func (m Modifiers) Alt() bool {
+	return m.Any(ModAlt)
+}
+
+// Returns true if either OS specific key is held
+func (m Modifiers) System() bool {
+	return m.Any(ModSystem)
+}
+
+// Returns true if the platform's modifier for shortcuts such as copy and
+// paste is held: Control on Windows and Linux, Command (System) on MacOS X
+func (m Modifiers) HasPrimaryShortcutModifier() bool {
+	return m.Any(primaryShortcutModifier)
+}
+
+// Only the held keys, without the lock states
+func (m Modifiers) Held() Modifiers {
+	return m &^ (ModCapsLock | ModNumLock | ModScrollLock)
+}
+
+func (m Modifiers) String() string {
+	if m == 0 {
+		return "0"
+	}
+
+	var names []string
+	for i, name := range modifierNames {
+		if m&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	if rest := m &^ (1<<modCount - 1); rest != 0 {
+		names = append(names, "Modifiers(0x"+strconv.FormatUint(uint64(rest), 16)+")")
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,44 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestModifiers(t *testing.T) {
+	m := ModRShift | ModLControl | ModCapsLock
+
+	if !m.Shift() || !m.Control() || m.Alt() || m.System() {
+		t.Errorf("unexpected held modifiers in %s", m)
+	}
+	if !m.Any(ModShift|ModAlt) || m.All(ModShift) || !m.All(ModRShift|ModLControl) {
+		t.Errorf("unexpected Any/All for %s", m)
+	}
+	if held := m.Held(); held != ModRShift|ModLControl {
+		t.Errorf("unexpected held modifiers %s", held)
+	}
+	if !(primaryShortcutModifier & (ModRShift | ModRControl | ModRAlt | ModRSystem)).HasPrimaryShortcutModifier() {
+		t.Error("the right hand shortcut modifier is not recognized")
+	}
+	if (ModCapsLock | ModNumLock).HasPrimaryShortcutModifier() {
+		t.Error("lock keys are shortcut modifiers")
+	}
+}
+
+func TestModifiers_String(t *testing.T) {
+	tests := []struct {
+		mods Modifiers
+		name string
+	}{
+		{0, "0"},
+		{ModLShift, "LShift"},
+		{ModControl | ModNumLock, "LControl|RControl|NumLock"},
+		{ModScrollLock | 1<<20, "ScrollLock|Modifiers(0x100000)"},
+	}
+
+	for _, test := range tests {
+		if name := test.mods.String(); name != test.name {
+			t.Errorf("expected %q, got %q", test.name, name)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 // Copyright © 2012 Popog
 package glml
 
-import "errors"
+import (
+	"errors"
+	"strconv"
+)
 
 // Mouse buttons
 type MouseButton uint
@@ -25,7 +28,30 @@ const (
 	MouseButtonCount // Keep last -- the total number of mouse buttons
 )
 
-// Check if a mouse button is pressed
+// The names of the buttons, without the Mouse prefix
+var mouseButtonNames = [MouseButtonCount]string{
+	MouseLeftRH:          "LeftRH",
+	MouseRightRH:         "RightRH",
+	MouseLeftLH:          "LeftLH",
+	MouseRightLH:         "RightLH",
+	MouseMiddle:          "Middle",
+	MouseXButton1:        "XButton1",
+	MouseXButton2:        "XButton2",
+	MouseButtonPrimary:   "ButtonPrimary",
+	MouseButtonSecondary: "ButtonSecondary",
+	MouseButtonLeft:      "ButtonLeft",
+	MouseButtonRight:     "ButtonRight",
+}
+
+func (b MouseButton) String() string {
+	if b >= MouseButtonCount {
+		return "MouseButton(" + strconv.FormatUint(uint64(b), 10) + ")"
+	}
+	return mouseButtonNames[b]
+}
+
+// Check if a mouse button is pressed, asking the OS. See InputState for the
+// state which agrees with a window's events.
 func IsMouseButtonPressed(button MouseButton) bool {
 	if button >= MouseButtonCount {
 		panic(errors.New("button out of range"))
@@ -66,3 +92,58 @@ func GetMousePosition() (x, y int) {
 func SetMousePosition(x, y int) {
 	setMousePosition(x, y)
 }
+
+// Accumulates scroll deltas smaller than a wheel notch into whole notches
+type wheelAccumulator struct {
+	remainder int
+}
+
+// Add a delta, in units where a notch is step. Returns the number of whole
+// notches scrolled, keeping the rest for later. Reversing direction
+// discards the rest.
+func (a *wheelAccumulator) add(delta, step int) int {
+	if (delta < 0) != (a.remainder < 0) {
+		a.remainder = 0
+	}
+
+	total := a.remainder + delta
+	notches := total / step
+	a.remainder = total - notches*step
+	return notches
+}
+
+// How the mouse cursor behaves over a window
+type CursorMode int
+
+const (
+	CursorModeNormal   CursorMode = iota // The cursor is visible and moves freely
+	CursorModeHidden                     // The cursor is hidden while over the window
+	CursorModeConfined                   // The cursor is visible but can't leave the window
+	CursorModeRelative                   // The cursor is hidden and locked, and movement is reported by MouseRawMotionEvent
+
+	CursorModeCount // Keep last -- the total number of cursor modes
+)
+
+var cursorModeNames = [CursorModeCount]string{
+	CursorModeNormal:   "Normal",
+	CursorModeHidden:   "Hidden",
+	CursorModeConfined: "Confined",
+	CursorModeRelative: "Relative",
+}
+
+func (m CursorMode) String() string {
+	if m < 0 || m >= CursorModeCount {
+		return "CursorMode(" + strconv.Itoa(int(m)) + ")"
+	}
+	return cursorModeNames[m]
+}
+
+// Does the mode hide the cursor over the window?
+func (m CursorMode) hidesCursor() bool {
+	return m == CursorModeHidden || m == CursorModeRelative
+}
+
+// Does the mode keep the cursor inside the window?
+func (m CursorMode) confinesCursor() bool {
+	return m == CursorModeConfined || m == CursorModeRelative
+}
@@ -0,0 +1,53 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestWheelAccumulator(t *testing.T) {
+	var a wheelAccumulator
+	tests := []struct {
+		delta, notches int
+	}{
+		{120, 1},
+		{240, 2},
+		{40, 0},
+		{40, 0},
+		{40, 1},
+		{60, 0},
+		{-30, 0}, // Reversing discards the 60
+		{-90, -1},
+		{-250, -2},
+		{-110, -1},
+	}
+
+	for i, test := range tests {
+		if notches := a.add(test.delta, 120); notches != test.notches {
+			t.Errorf("%d: add(%d) = %d, expected %d", i, test.delta, notches, test.notches)
+		}
+	}
+}
+
+func TestCursorMode(t *testing.T) {
+	tests := []struct {
+		mode             CursorMode
+		hidden, confined bool
+		name             string
+	}{
+		{CursorModeNormal, false, false, "Normal"},
+		{CursorModeHidden, true, false, "Hidden"},
+		{CursorModeConfined, false, true, "Confined"},
+		{CursorModeRelative, true, true, "Relative"},
+		{CursorModeCount, false, false, "CursorMode(4)"},
+	}
+
+	for _, test := range tests {
+		if test.mode.hidesCursor() != test.hidden || test.mode.confinesCursor() != test.confined {
+			t.Errorf("%s: hidden %v, confined %v", test.mode, test.mode.hidesCursor(), test.mode.confinesCursor())
+		}
+		if name := test.mode.String(); name != test.name {
+			t.Errorf("expected %q, got %q", test.name, name)
+		}
+	}
+}
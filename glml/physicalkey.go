@@ -0,0 +1,383 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strconv"
+)
+
+// A platform specific code for a key's position on the keyboard, as reported
+// by the hardware. On Windows, this is the set 1 make code, with 0xE000
+// added for extended keys.
+type Scancode uint32
+
+// A key's position on the keyboard, independent of the keyboard layout.
+// The values are the USB HID keyboard usage IDs (usage page 0x07), and
+// each constant is named after the key at that position on a US keyboard.
+//
+// Use PhysicalKey for bindings that should stay in place across layouts
+// (e.g. WASD on an AZERTY keyboard), and Key for bindings that should
+// follow the label on the key.
+type PhysicalKey int
+
+const (
+	PhysicalKeyUnknown PhysicalKey = 0
+
+	PhysicalKeyA PhysicalKey = 0x04
+	PhysicalKeyB PhysicalKey = 0x05
+	PhysicalKeyC PhysicalKey = 0x06
+	PhysicalKeyD PhysicalKey = 0x07
+	PhysicalKeyE PhysicalKey = 0x08
+	PhysicalKeyF PhysicalKey = 0x09
+	PhysicalKeyG PhysicalKey = 0x0A
+	PhysicalKeyH PhysicalKey = 0x0B
+	PhysicalKeyI PhysicalKey = 0x0C
+	PhysicalKeyJ PhysicalKey = 0x0D
+	PhysicalKeyK PhysicalKey = 0x0E
+	PhysicalKeyL PhysicalKey = 0x0F
+	PhysicalKeyM PhysicalKey = 0x10
+	PhysicalKeyN PhysicalKey = 0x11
+	PhysicalKeyO PhysicalKey = 0x12
+	PhysicalKeyP PhysicalKey = 0x13
+	PhysicalKeyQ PhysicalKey = 0x14
+	PhysicalKeyR PhysicalKey = 0x15
+	PhysicalKeyS PhysicalKey = 0x16
+	PhysicalKeyT PhysicalKey = 0x17
+	PhysicalKeyU PhysicalKey = 0x18
+	PhysicalKeyV PhysicalKey = 0x19
+	PhysicalKeyW PhysicalKey = 0x1A
+	PhysicalKeyX PhysicalKey = 0x1B
+	PhysicalKeyY PhysicalKey = 0x1C
+	PhysicalKeyZ PhysicalKey = 0x1D
+
+	PhysicalKey1 PhysicalKey = 0x1E
+	PhysicalKey2 PhysicalKey = 0x1F
+	PhysicalKey3 PhysicalKey = 0x20
+	PhysicalKey4 PhysicalKey = 0x21
+	PhysicalKey5 PhysicalKey = 0x22
+	PhysicalKey6 PhysicalKey = 0x23
+	PhysicalKey7 PhysicalKey = 0x24
+	PhysicalKey8 PhysicalKey = 0x25
+	PhysicalKey9 PhysicalKey = 0x26
+	PhysicalKey0 PhysicalKey = 0x27
+
+	PhysicalKeyEnter          PhysicalKey = 0x28
+	PhysicalKeyEscape         PhysicalKey = 0x29
+	PhysicalKeyBackspace      PhysicalKey = 0x2A
+	PhysicalKeyTab            PhysicalKey = 0x2B
+	PhysicalKeySpace          PhysicalKey = 0x2C
+	PhysicalKeyMinus          PhysicalKey = 0x2D
+	PhysicalKeyEqual          PhysicalKey = 0x2E
+	PhysicalKeyLeftBracket    PhysicalKey = 0x2F
+	PhysicalKeyRightBracket   PhysicalKey = 0x30
+	PhysicalKeyBackslash      PhysicalKey = 0x31
+	PhysicalKeyNonUSHash      PhysicalKey = 0x32 // Next to Enter on ISO keyboards
+	PhysicalKeySemicolon      PhysicalKey = 0x33
+	PhysicalKeyApostrophe     PhysicalKey = 0x34
+	PhysicalKeyGrave          PhysicalKey = 0x35
+	PhysicalKeyComma          PhysicalKey = 0x36
+	PhysicalKeyPeriod         PhysicalKey = 0x37
+	PhysicalKeySlash          PhysicalKey = 0x38
+	PhysicalKeyCapsLock       PhysicalKey = 0x39
+	PhysicalKeyF1             PhysicalKey = 0x3A
+	PhysicalKeyF2             PhysicalKey = 0x3B
+	PhysicalKeyF3             PhysicalKey = 0x3C
+	PhysicalKeyF4             PhysicalKey = 0x3D
+	PhysicalKeyF5             PhysicalKey = 0x3E
+	PhysicalKeyF6             PhysicalKey = 0x3F
+	PhysicalKeyF7             PhysicalKey = 0x40
+	PhysicalKeyF8             PhysicalKey = 0x41
+	PhysicalKeyF9             PhysicalKey = 0x42
+	PhysicalKeyF10            PhysicalKey = 0x43
+	PhysicalKeyF11            PhysicalKey = 0x44
+	PhysicalKeyF12            PhysicalKey = 0x45
+	PhysicalKeyPrintScreen    PhysicalKey = 0x46
+	PhysicalKeyScrollLock     PhysicalKey = 0x47
+	PhysicalKeyPause          PhysicalKey = 0x48
+	PhysicalKeyInsert         PhysicalKey = 0x49
+	PhysicalKeyHome           PhysicalKey = 0x4A
+	PhysicalKeyPageUp         PhysicalKey = 0x4B
+	PhysicalKeyDelete         PhysicalKey = 0x4C
+	PhysicalKeyEnd            PhysicalKey = 0x4D
+	PhysicalKeyPageDown       PhysicalKey = 0x4E
+	PhysicalKeyRight          PhysicalKey = 0x4F
+	PhysicalKeyLeft           PhysicalKey = 0x50
+	PhysicalKeyDown           PhysicalKey = 0x51
+	PhysicalKeyUp             PhysicalKey = 0x52
+	PhysicalKeyNumLock        PhysicalKey = 0x53
+	PhysicalKeyNumpadDivide   PhysicalKey = 0x54
+	PhysicalKeyNumpadMultiply PhysicalKey = 0x55
+	PhysicalKeyNumpadSubtract PhysicalKey = 0x56
+	PhysicalKeyNumpadAdd      PhysicalKey = 0x57
+	PhysicalKeyNumpadEnter    PhysicalKey = 0x58
+	PhysicalKeyNumpad1        PhysicalKey = 0x59
+	PhysicalKeyNumpad2        PhysicalKey = 0x5A
+	PhysicalKeyNumpad3        PhysicalKey = 0x5B
+	PhysicalKeyNumpad4        PhysicalKey = 0x5C
+	PhysicalKeyNumpad5        PhysicalKey = 0x5D
+	PhysicalKeyNumpad6        PhysicalKey = 0x5E
+	PhysicalKeyNumpad7        PhysicalKey = 0x5F
+	PhysicalKeyNumpad8        PhysicalKey = 0x60
+	PhysicalKeyNumpad9        PhysicalKey = 0x61
+	PhysicalKeyNumpad0        PhysicalKey = 0x62
+	PhysicalKeyNumpadDecimal  PhysicalKey = 0x63
+	PhysicalKeyNonUSBackslash PhysicalKey = 0x64 // Next to Left Shift on ISO keyboards
+	PhysicalKeyApplication    PhysicalKey = 0x65 // The Menu key
+	PhysicalKeyPower          PhysicalKey = 0x66
+	PhysicalKeyNumpadEqual    PhysicalKey = 0x67
+	PhysicalKeyF13            PhysicalKey = 0x68
+	PhysicalKeyF14            PhysicalKey = 0x69
+	PhysicalKeyF15            PhysicalKey = 0x6A
+	PhysicalKeyF16            PhysicalKey = 0x6B
+	PhysicalKeyF17            PhysicalKey = 0x6C
+	PhysicalKeyF18            PhysicalKey = 0x6D
+	PhysicalKeyF19            PhysicalKey = 0x6E
+	PhysicalKeyF20            PhysicalKey = 0x6F
+	PhysicalKeyF21            PhysicalKey = 0x70
+	PhysicalKeyF22            PhysicalKey = 0x71
+	PhysicalKeyF23            PhysicalKey = 0x72
+	PhysicalKeyF24            PhysicalKey = 0x73
+	PhysicalKeyMute           PhysicalKey = 0x7F
+	PhysicalKeyVolumeUp       PhysicalKey = 0x80
+	PhysicalKeyVolumeDown     PhysicalKey = 0x81
+	PhysicalKeyInternational1 PhysicalKey = 0x87 // Ro on JIS keyboards
+	PhysicalKeyInternational2 PhysicalKey = 0x88 // Katakana/Hiragana on JIS keyboards
+	PhysicalKeyInternational3 PhysicalKey = 0x89 // Yen on JIS keyboards
+	PhysicalKeyInternational4 PhysicalKey = 0x8A // Henkan on JIS keyboards
+	PhysicalKeyInternational5 PhysicalKey = 0x8B // Muhenkan on JIS keyboards
+	PhysicalKeyLang1          PhysicalKey = 0x90 // Hangul/English on Korean keyboards
+	PhysicalKeyLang2          PhysicalKey = 0x91 // Hanja on Korean keyboards
+	PhysicalKeyLeftControl    PhysicalKey = 0xE0
+	PhysicalKeyLeftShift      PhysicalKey = 0xE1
+	PhysicalKeyLeftAlt        PhysicalKey = 0xE2
+	PhysicalKeyLeftSystem     PhysicalKey = 0xE3 // The left OS specific key: window (Windows and Linux), apple (MacOS X), ...
+	PhysicalKeyRightControl   PhysicalKey = 0xE4
+	PhysicalKeyRightShift     PhysicalKey = 0xE5
+	PhysicalKeyRightAlt       PhysicalKey = 0xE6
+	PhysicalKeyRightSystem    PhysicalKey = 0xE7 // The right OS specific key: window (Windows and Linux), apple (MacOS X), ...
+)
+
+var physicalKeyNames = map[PhysicalKey]string{
+	PhysicalKeyA: "A", PhysicalKeyB: "B", PhysicalKeyC: "C", PhysicalKeyD: "D",
+	PhysicalKeyE: "E", PhysicalKeyF: "F", PhysicalKeyG: "G", PhysicalKeyH: "H",
+	PhysicalKeyI: "I", PhysicalKeyJ: "J", PhysicalKeyK: "K", PhysicalKeyL: "L",
+	PhysicalKeyM: "M", PhysicalKeyN: "N", PhysicalKeyO: "O", PhysicalKeyP: "P",
+	PhysicalKeyQ: "Q", PhysicalKeyR: "R", PhysicalKeyS: "S", PhysicalKeyT: "T",
+	PhysicalKeyU: "U", PhysicalKeyV: "V", PhysicalKeyW: "W", PhysicalKeyX: "X",
+	PhysicalKeyY: "Y", PhysicalKeyZ: "Z",
+
+	PhysicalKey1: "1", PhysicalKey2: "2", PhysicalKey3: "3", PhysicalKey4: "4",
+	PhysicalKey5: "5", PhysicalKey6: "6", PhysicalKey7: "7", PhysicalKey8: "8",
+	PhysicalKey9: "9", PhysicalKey0: "0",
+
+	PhysicalKeyEnter:          "Enter",
+	PhysicalKeyEscape:         "Escape",
+	PhysicalKeyBackspace:      "Backspace",
+	PhysicalKeyTab:            "Tab",
+	PhysicalKeySpace:          "Space",
+	PhysicalKeyMinus:          "Minus",
+	PhysicalKeyEqual:          "Equal",
+	PhysicalKeyLeftBracket:    "LeftBracket",
+	PhysicalKeyRightBracket:   "RightBracket",
+	PhysicalKeyBackslash:      "Backslash",
+	PhysicalKeyNonUSHash:      "NonUSHash",
+	PhysicalKeySemicolon:      "Semicolon",
+	PhysicalKeyApostrophe:     "Apostrophe",
+	PhysicalKeyGrave:          "Grave",
+	PhysicalKeyComma:          "Comma",
+	PhysicalKeyPeriod:         "Period",
+	PhysicalKeySlash:          "Slash",
+	PhysicalKeyCapsLock:       "CapsLock",
+	PhysicalKeyF1:             "F1",
+	PhysicalKeyF2:             "F2",
+	PhysicalKeyF3:             "F3",
+	PhysicalKeyF4:             "F4",
+	PhysicalKeyF5:             "F5",
+	PhysicalKeyF6:             "F6",
+	PhysicalKeyF7:             "F7",
+	PhysicalKeyF8:             "F8",
+	PhysicalKeyF9:             "F9",
+	PhysicalKeyF10:            "F10",
+	PhysicalKeyF11:            "F11",
+	PhysicalKeyF12:            "F12",
+	PhysicalKeyPrintScreen:    "PrintScreen",
+	PhysicalKeyScrollLock:     "ScrollLock",
+	PhysicalKeyPause:          "Pause",
+	PhysicalKeyInsert:         "Insert",
+	PhysicalKeyHome:           "Home",
+	PhysicalKeyPageUp:         "PageUp",
+	PhysicalKeyDelete:         "Delete",
+	PhysicalKeyEnd:            "End",
+	PhysicalKeyPageDown:       "PageDown",
+	PhysicalKeyRight:          "Right",
+	PhysicalKeyLeft:           "Left",
+	PhysicalKeyDown:           "Down",
+	PhysicalKeyUp:             "Up",
+	PhysicalKeyNumLock:        "NumLock",
+	PhysicalKeyNumpadDivide:   "NumpadDivide",
+	PhysicalKeyNumpadMultiply: "NumpadMultiply",
+	PhysicalKeyNumpadSubtract: "NumpadSubtract",
+	PhysicalKeyNumpadAdd:      "NumpadAdd",
+	PhysicalKeyNumpadEnter:    "NumpadEnter",
+	PhysicalKeyNumpad1:        "Numpad1",
+	PhysicalKeyNumpad2:        "Numpad2",
+	PhysicalKeyNumpad3:        "Numpad3",
+	PhysicalKeyNumpad4:        "Numpad4",
+	PhysicalKeyNumpad5:        "Numpad5",
+	PhysicalKeyNumpad6:        "Numpad6",
+	PhysicalKeyNumpad7:        "Numpad7",
+	PhysicalKeyNumpad8:        "Numpad8",
+	PhysicalKeyNumpad9:        "Numpad9",
+	PhysicalKeyNumpad0:        "Numpad0",
+	PhysicalKeyNumpadDecimal:  "NumpadDecimal",
+	PhysicalKeyNonUSBackslash: "NonUSBackslash",
+	PhysicalKeyApplication:    "Application",
+	PhysicalKeyPower:          "Power",
+	PhysicalKeyNumpadEqual:    "NumpadEqual",
+	PhysicalKeyF13:            "F13",
+	PhysicalKeyF14:            "F14",
+	PhysicalKeyF15:            "F15",
+	PhysicalKeyF16:            "F16",
+	PhysicalKeyF17:            "F17",
+	PhysicalKeyF18:            "F18",
+	PhysicalKeyF19:            "F19",
+	PhysicalKeyF20:            "F20",
+	PhysicalKeyF21:            "F21",
+	PhysicalKeyF22:            "F22",
+	PhysicalKeyF23:            "F23",
+	PhysicalKeyF24:            "F24",
+	PhysicalKeyMute:           "Mute",
+	PhysicalKeyVolumeUp:       "VolumeUp",
+	PhysicalKeyVolumeDown:     "VolumeDown",
+	PhysicalKeyInternational1: "International1",
+	PhysicalKeyInternational2: "International2",
+	PhysicalKeyInternational3: "International3",
+	PhysicalKeyInternational4: "International4",
+	PhysicalKeyInternational5: "International5",
+	PhysicalKeyLang1:          "Lang1",
+	PhysicalKeyLang2:          "Lang2",
+	PhysicalKeyLeftControl:    "LeftControl",
+	PhysicalKeyLeftShift:      "LeftShift",
+	PhysicalKeyLeftAlt:        "LeftAlt",
+	PhysicalKeyLeftSystem:     "LeftSystem",
+	PhysicalKeyRightControl:   "RightControl",
+	PhysicalKeyRightShift:     "RightShift",
+	PhysicalKeyRightAlt:       "RightAlt",
+	PhysicalKeyRightSystem:    "RightSystem",
+}
+
+// The English name of the key's position, for logging and configuration
+// files. Use PhysicalKeyName for display to users.
+func (k PhysicalKey) String() string {
+	if name, ok := physicalKeyNames[k]; ok {
+		return name
+	}
+	return "PhysicalKey(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Keys whose meaning doesn't depend on the keyboard layout. The system's
+// layout tables report some of these as something else, e.g. the numpad
+// digits as navigation keys when Num Lock is off.
+var physicalKeyFixedKeys = map[PhysicalKey]Key{
+	PhysicalKeyNumpad0:        KeyNumpad0,
+	PhysicalKeyNumpad1:        KeyNumpad1,
+	PhysicalKeyNumpad2:        KeyNumpad2,
+	PhysicalKeyNumpad3:        KeyNumpad3,
+	PhysicalKeyNumpad4:        KeyNumpad4,
+	PhysicalKeyNumpad5:        KeyNumpad5,
+	PhysicalKeyNumpad6:        KeyNumpad6,
+	PhysicalKeyNumpad7:        KeyNumpad7,
+	PhysicalKeyNumpad8:        KeyNumpad8,
+	PhysicalKeyNumpad9:        KeyNumpad9,
+	PhysicalKeyNumpadDivide:   KeyDivide,
+	PhysicalKeyNumpadMultiply: KeyMultiply,
+	PhysicalKeyNumpadSubtract: KeySubtract,
+	PhysicalKeyNumpadAdd:      KeyAdd,
+	PhysicalKeyPause:          KeyPause,
+	PhysicalKeyNumpadEnter:    KeyNumpadEnter,
+	PhysicalKeyNumpadDecimal:  KeyNumpadDecimal,
+	PhysicalKeyNumpadEqual:    KeyNumpadEqual,
+	PhysicalKeyInternational1: KeyJISRo,
+	PhysicalKeyInternational3: KeyJISYen,
+	PhysicalKeyLeftControl:    KeyLControl,
+	PhysicalKeyLeftShift:      KeyLShift,
+	PhysicalKeyLeftAlt:        KeyLAlt,
+	PhysicalKeyLeftSystem:     KeyLSystem,
+	PhysicalKeyRightControl:   KeyRControl,
+	PhysicalKeyRightShift:     KeyRShift,
+	PhysicalKeyRightAlt:       KeyRAlt,
+	PhysicalKeyRightSystem:    KeyRSystem,
+}
+
+// The reverse of the platform's scancodePhysicalKeys
+var physicalKeyScancodes = make(map[PhysicalKey]Scancode)
+
+func init() {
+	for scancode, key := range scancodePhysicalKeys {
+		physicalKeyScancodes[key] = scancode
+	}
+}
+
+// Get the physical key at a scancode's position, or PhysicalKeyUnknown
+func ScancodeToPhysicalKey(scancode Scancode) PhysicalKey {
+	if key, ok := scancodePhysicalKeys[scancode]; ok {
+		return key
+	}
+	return PhysicalKeyUnknown
+}
+
+// Get the scancode for a physical key, or 0 if the platform has none
+func PhysicalKeyToScancode(key PhysicalKey) Scancode {
+	return physicalKeyScancodes[key]
+}
+
+// Get the Key produced by a physical key in the current keyboard layout,
+// or KeyUnknown
+func PhysicalKeyToKey(key PhysicalKey) Key {
+	if k, ok := physicalKeyFixedKeys[key]; ok {
+		return k
+	}
+
+	scancode, ok := physicalKeyScancodes[key]
+	if !ok {
+		return KeyUnknown
+	}
+	return scancodeToKey(scancode)
+}
+
+// Get the physical key which produces a Key in the current keyboard layout,
+// or PhysicalKeyUnknown
+func KeyToPhysicalKey(key Key) PhysicalKey {
+	if key < 0 || key >= KeyCount {
+		return PhysicalKeyUnknown
+	}
+
+	for pk, k := range physicalKeyFixedKeys {
+		if k == key {
+			return pk
+		}
+	}
+	return ScancodeToPhysicalKey(keyToScancode(key))
+}
+
+// Get the Key produced by a scancode in the current keyboard layout, or
+// KeyUnknown
+func ScancodeToKey(scancode Scancode) Key {
+	return PhysicalKeyToKey(ScancodeToPhysicalKey(scancode))
+}
+
+// Get the scancode which produces a Key in the current keyboard layout, or 0
+func KeyToScancode(key Key) Scancode {
+	return PhysicalKeyToScancode(KeyToPhysicalKey(key))
+}
+
+// Get the name of a physical key in the current keyboard layout and system
+// language, for display in e.g. a key binding menu. Falls back to
+// PhysicalKey.String if the system has no name for the key.
+func PhysicalKeyName(key PhysicalKey) string {
+	if scancode, ok := physicalKeyScancodes[key]; ok {
+		if name := scancodeName(scancode); name != "" {
+			return name
+		}
+	}
+	return key.String()
+}
@@ -0,0 +1,61 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"testing"
+)
+
+func TestPhysicalKey_String(t *testing.T) {
+	tests := []struct {
+		key  PhysicalKey
+		name string
+	}{
+		{PhysicalKeyA, "A"},
+		{PhysicalKey0, "0"},
+		{PhysicalKeyNumpadEnter, "NumpadEnter"},
+		{PhysicalKeyRightSystem, "RightSystem"},
+		{PhysicalKey(0x200), "PhysicalKey(512)"},
+	}
+
+	for _, test := range tests {
+		if name := test.key.String(); name != test.name {
+			t.Errorf("expected %q, got %q", test.name, name)
+		}
+	}
+}
+
+func TestScancodePhysicalKeys(t *testing.T) {
+	if len(physicalKeyScancodes) != len(scancodePhysicalKeys) {
+		t.Errorf("%d scancodes share a physical key", len(scancodePhysicalKeys)-len(physicalKeyScancodes))
+	}
+
+	for scancode, key := range scancodePhysicalKeys {
+		if _, ok := physicalKeyNames[key]; !ok {
+			t.Errorf("scancode 0x%X maps to unnamed %s", scancode, key)
+		}
+		if sc := PhysicalKeyToScancode(key); sc != scancode {
+			t.Errorf("%s: expected scancode 0x%X, got 0x%X", key, scancode, sc)
+		}
+		if pk := ScancodeToPhysicalKey(scancode); pk != key {
+			t.Errorf("0x%X: expected %s, got %s", scancode, key, pk)
+		}
+	}
+
+	if pk := ScancodeToPhysicalKey(0xFFFF); pk != PhysicalKeyUnknown {
+		t.Errorf("unexpected physical key %s", pk)
+	}
+}
+
+func TestFixedPhysicalKeys(t *testing.T) {
+	for pk, key := range physicalKeyFixedKeys {
+		if _, ok := scancodePhysicalKeys[PhysicalKeyToScancode(pk)]; !ok {
+			t.Errorf("%s has no scancode", pk)
+		}
+		if k := PhysicalKeyToKey(pk); k != key {
+			t.Errorf("%s: expected key %d, got %d", pk, key, k)
+		}
+		if p := KeyToPhysicalKey(key); p != pk {
+			t.Errorf("key %d: expected %s, got %s", key, pk, p)
+		}
+	}
+}
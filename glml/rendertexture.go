@@ -0,0 +1,341 @@
+// Copyright © 2012 Popog
+package glml
+
+// #include "glproc.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"image"
+	"unsafe"
+)
+
+// The framebuffer object entry points, either from OpenGL 3.0 /
+// ARB_framebuffer_object or from EXT_framebuffer_object
+type framebufferProcs struct {
+	genFramebuffers         func(n int32, framebuffers unsafe.Pointer)
+	deleteFramebuffers      func(n int32, framebuffers unsafe.Pointer)
+	bindFramebuffer         func(target uint32, framebuffer uint32)
+	framebufferTexture2D    func(target uint32, attachment uint32, textarget uint32, texture uint32, level int32)
+	checkFramebufferStatus  func(target uint32) uint32
+	genRenderbuffers        func(n int32, renderbuffers unsafe.Pointer)
+	deleteRenderbuffers     func(n int32, renderbuffers unsafe.Pointer)
+	bindRenderbuffer        func(target uint32, renderbuffer uint32)
+	renderbufferStorage     func(target uint32, internalformat uint32, width int32, height int32)
+	framebufferRenderbuffer func(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32)
+}
+
+// Returns nil if the context does not support framebuffer objects
+func getFramebufferProcs(c *Context) *framebufferProcs {
+	gl := &c.gl
+	switch {
+	case (c.info.MajorVersion >= 3 || c.extensions.Has("GL_ARB_framebuffer_object")) && gl.procs.p_glGenFramebuffers != nil:
+		return &framebufferProcs{
+			genFramebuffers:         gl.GenFramebuffers,
+			deleteFramebuffers:      gl.DeleteFramebuffers,
+			bindFramebuffer:         gl.BindFramebuffer,
+			framebufferTexture2D:    gl.FramebufferTexture2D,
+			checkFramebufferStatus:  gl.CheckFramebufferStatus,
+			genRenderbuffers:        gl.GenRenderbuffers,
+			deleteRenderbuffers:     gl.DeleteRenderbuffers,
+			bindRenderbuffer:        gl.BindRenderbuffer,
+			renderbufferStorage:     gl.RenderbufferStorage,
+			framebufferRenderbuffer: gl.FramebufferRenderbuffer,
+		}
+
+	case c.extensions.Has("GL_EXT_framebuffer_object") && gl.procs.p_glGenFramebuffersEXT != nil:
+		return &framebufferProcs{
+			genFramebuffers:         gl.GenFramebuffersEXT,
+			deleteFramebuffers:      gl.DeleteFramebuffersEXT,
+			bindFramebuffer:         gl.BindFramebufferEXT,
+			framebufferTexture2D:    gl.FramebufferTexture2DEXT,
+			checkFramebufferStatus:  gl.CheckFramebufferStatusEXT,
+			genRenderbuffers:        gl.GenRenderbuffersEXT,
+			deleteRenderbuffers:     gl.DeleteRenderbuffersEXT,
+			bindRenderbuffer:        gl.BindRenderbufferEXT,
+			renderbufferStorage:     gl.RenderbufferStorageEXT,
+			framebufferRenderbuffer: gl.FramebufferRenderbufferEXT,
+		}
+	}
+	return nil
+}
+
+// An offscreen render target of a fixed size, owned by a Context.
+//
+// The color buffer is a 2D texture which can be used by any context that
+// shares objects with the owner. A framebuffer object is used where
+// available, otherwise a pbuffer sharing objects with the owner is rendered
+// to and copied into the texture on ThreadUnbind.
+//
+// All methods expect to be called on a Thread where the owning Context is
+// active. Only one render texture of a context can be bound at a time, and
+// it stays bound across commands until ThreadUnbind, or until the context
+// is deactivated. Render textures which aren't closed are freed when the
+// owning Context closes.
+type RenderTexture struct {
+	context       *Context
+	width, height int
+	format        RenderTextureFormat
+	depth         bool // Whether or not there is a depth buffer
+
+	texture C.GLuint // The color texture
+
+	fbo         *framebufferProcs // nil when using a pbuffer
+	framebuffer uint32
+	depthbuffer uint32
+
+	pbuffer *pbufferInternal // nil when using a framebuffer object
+
+	bound           bool
+	prevFramebuffer C.GLint    // The framebuffer bound before ThreadBind
+	prevViewport    [4]C.GLint // The viewport before ThreadBind
+	closed          bool
+}
+
+// Expects to be called on a Thread
+// Create an offscreen render target of the given size and format, with an
+// optional 24 bit depth buffer.
+func (c *Context) ThreadCreateRenderTexture(width, height int, format RenderTextureFormat, depth bool) (*RenderTexture, ThreadError) {
+	if err := checkRenderTextureSize(width, height); err != nil {
+		return nil, NewThreadError(err, false)
+	}
+
+	rt := &RenderTexture{
+		context: c,
+		format:  format,
+		depth:   depth,
+		fbo:     getFramebufferProcs(c),
+	}
+
+	if rt.fbo == nil && !c.internal.supportsPbuffers() {
+		return nil, NewThreadError(errors.New("neither framebuffer objects nor pbuffers are supported"), false)
+	}
+
+	C.glGenTextures(1, &rt.texture)
+	if rt.fbo != nil {
+		rt.fbo.genFramebuffers(1, unsafe.Pointer(&rt.framebuffer))
+		if depth {
+			rt.fbo.genRenderbuffers(1, unsafe.Pointer(&rt.depthbuffer))
+		}
+	}
+
+	if err := rt.ThreadResize(width, height); err != nil {
+		rt.ThreadClose()
+		return nil, err
+	}
+
+	if c.renderTextures == nil {
+		c.renderTextures = make(map[*RenderTexture]bool)
+	}
+	c.renderTextures[rt] = true
+	return rt, nil
+}
+
+// Expects to be called on a Thread
+// Create an offscreen render target in the window's context
+func (w *Window) ThreadCreateRenderTexture(width, height int, format RenderTextureFormat, depth bool) (*RenderTexture, ThreadError) {
+	return w.context.ThreadCreateRenderTexture(width, height, format, depth)
+}
+
+// Get the size of the render texture
+func (rt *RenderTexture) GetSize() (width, height int) {
+	return rt.width, rt.height
+}
+
+// Get the name of the color texture. It is valid in every context sharing
+// objects with the owner.
+func (rt *RenderTexture) Texture() uint32 {
+	return uint32(rt.texture)
+}
+
+// Expects to be called on a Thread
+// Change the size of the render texture. The contents are lost.
+func (rt *RenderTexture) ThreadResize(width, height int) ThreadError {
+	if err := checkRenderTextureSize(width, height); err != nil {
+		return NewThreadError(err, false)
+	}
+	if rt.bound {
+		return NewThreadError(errors.New("cannot resize a bound render texture"), false)
+	}
+	rt.width, rt.height = width, height
+
+	// (Re)allocate the color texture
+	clearGLErrors()
+	var previous C.GLint
+	C.glGetIntegerv(C.GL_TEXTURE_BINDING_2D, &previous)
+	C.glBindTexture(C.GL_TEXTURE_2D, rt.texture)
+	C.glTexParameteri(C.GL_TEXTURE_2D, C.GL_TEXTURE_MIN_FILTER, C.GL_LINEAR)
+	C.glTexParameteri(C.GL_TEXTURE_2D, C.GL_TEXTURE_MAG_FILTER, C.GL_LINEAR)
+	C.glTexImage2D(C.GL_TEXTURE_2D, 0, C.GLint(rt.format.internalFormat()), C.GLsizei(width), C.GLsizei(height), 0, C.GL_RGBA, C.GL_UNSIGNED_BYTE, nil)
+	C.glBindTexture(C.GL_TEXTURE_2D, C.GLuint(previous))
+	if err := C.glGetError(); err != C.GL_NO_ERROR {
+		return NewThreadError(fmt.Errorf("glTexImage2D failed (0x%x)", err), false)
+	}
+
+	if rt.fbo == nil {
+		// Pbuffers have a fixed size, so replace it
+		if rt.pbuffer != nil {
+			rt.pbuffer.close()
+			rt.pbuffer = nil
+		}
+
+		pbuffer, err := rt.context.internal.createPbuffer(width, height, rt.format.bitsPerPixel(), rt.depth)
+		if err != nil {
+			return err
+		}
+		rt.pbuffer = pbuffer
+		return nil
+	}
+
+	// Attach everything to the framebuffer object
+	var previousFramebuffer C.GLint
+	C.glGetIntegerv(GL_FRAMEBUFFER_BINDING, &previousFramebuffer)
+	rt.fbo.bindFramebuffer(GL_FRAMEBUFFER, rt.framebuffer)
+	rt.fbo.framebufferTexture2D(GL_FRAMEBUFFER, GL_COLOR_ATTACHMENT0, C.GL_TEXTURE_2D, uint32(rt.texture), 0)
+	if rt.depth {
+		rt.fbo.bindRenderbuffer(GL_RENDERBUFFER, rt.depthbuffer)
+		rt.fbo.renderbufferStorage(GL_RENDERBUFFER, GL_DEPTH_COMPONENT24, int32(width), int32(height))
+		rt.fbo.framebufferRenderbuffer(GL_FRAMEBUFFER, GL_DEPTH_ATTACHMENT, GL_RENDERBUFFER, rt.depthbuffer)
+		rt.fbo.bindRenderbuffer(GL_RENDERBUFFER, 0)
+	}
+	status := rt.fbo.checkFramebufferStatus(GL_FRAMEBUFFER)
+	rt.fbo.bindFramebuffer(GL_FRAMEBUFFER, uint32(previousFramebuffer))
+
+	if err := framebufferStatusError(status); err != nil {
+		return NewThreadError(err, false)
+	}
+	return nil
+}
+
+// Expects to be called on a Thread
+// Make the render texture the target of all following rendering, until
+// ThreadUnbind is called. The viewport is set to cover the whole texture.
+func (rt *RenderTexture) ThreadBind() ThreadError {
+	if rt.bound {
+		return nil
+	}
+	if rt.context.boundTexture != nil {
+		return NewThreadError(errors.New("another render texture of the context is bound"), false)
+	}
+
+	if rt.pbuffer != nil {
+		if err := rt.pbuffer.activate(); err != nil {
+			return err
+		}
+	} else {
+		C.glGetIntegerv(GL_FRAMEBUFFER_BINDING, &rt.prevFramebuffer)
+		C.glGetIntegerv(C.GL_VIEWPORT, &rt.prevViewport[0])
+		rt.fbo.bindFramebuffer(GL_FRAMEBUFFER, rt.framebuffer)
+		C.glViewport(0, 0, C.GLsizei(rt.width), C.GLsizei(rt.height))
+	}
+
+	rt.bound = true
+	rt.context.boundTexture = rt
+	return nil
+}
+
+// Expects to be called on a Thread
+// Restore the render target that was in use before ThreadBind. With a
+// pbuffer, this is when the rendered image is copied into the texture.
+func (rt *RenderTexture) ThreadUnbind() ThreadError {
+	if !rt.bound {
+		return nil
+	}
+	rt.bound = false
+	rt.context.boundTexture = nil
+
+	if rt.pbuffer != nil {
+		rt.copyPbuffer()
+		return rt.pbuffer.deactivate()
+	}
+
+	rt.fbo.bindFramebuffer(GL_FRAMEBUFFER, uint32(rt.prevFramebuffer))
+	C.glViewport(rt.prevViewport[0], rt.prevViewport[1], C.GLsizei(rt.prevViewport[2]), C.GLsizei(rt.prevViewport[3]))
+	return nil
+}
+
+// Discard the errors of earlier calls, so that glGetError only reports the
+// following ones. glGetError may never return GL_NO_ERROR without a current
+// context, so give up after a while.
+func clearGLErrors() {
+	for i := 0; i < 32 && C.glGetError() != C.GL_NO_ERROR; i++ {
+	}
+}
+
+// Expects the pbuffer to be current
+func (rt *RenderTexture) copyPbuffer() {
+	var previous C.GLint
+	C.glGetIntegerv(C.GL_TEXTURE_BINDING_2D, &previous)
+	C.glBindTexture(C.GL_TEXTURE_2D, rt.texture)
+	C.glCopyTexSubImage2D(C.GL_TEXTURE_2D, 0, 0, 0, 0, 0, C.GLsizei(rt.width), C.GLsizei(rt.height))
+	C.glBindTexture(C.GL_TEXTURE_2D, C.GLuint(previous))
+}
+
+// Expects to be called on a Thread
+// Read the contents of the render texture back into an image
+func (rt *RenderTexture) ThreadReadImage() (*image.RGBA, ThreadError) {
+	// Make sure the texture is up to date
+	if rt.bound && rt.pbuffer != nil {
+		rt.copyPbuffer()
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, rt.width, rt.height))
+
+	clearGLErrors()
+	var previous, alignment C.GLint
+	C.glGetIntegerv(C.GL_TEXTURE_BINDING_2D, &previous)
+	C.glGetIntegerv(C.GL_PACK_ALIGNMENT, &alignment)
+	C.glBindTexture(C.GL_TEXTURE_2D, rt.texture)
+	C.glPixelStorei(C.GL_PACK_ALIGNMENT, 1)
+	C.glGetTexImage(C.GL_TEXTURE_2D, 0, C.GL_RGBA, C.GL_UNSIGNED_BYTE, unsafe.Pointer(&img.Pix[0]))
+	C.glPixelStorei(C.GL_PACK_ALIGNMENT, alignment)
+	C.glBindTexture(C.GL_TEXTURE_2D, C.GLuint(previous))
+	if err := C.glGetError(); err != C.GL_NO_ERROR {
+		return nil, NewThreadError(fmt.Errorf("glGetTexImage failed (0x%x)", err), false)
+	}
+
+	flipRows(img.Pix, img.Stride, rt.height)
+
+	return img, nil
+}
+
+// A thread command helper for RenderTexture.ThreadReadImage
+// If an error occurs, results will not be sent, so be sure to check Errors()
+func RenderTextureThreadReadImage(rt *RenderTexture, results chan<- image.Image) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, _ Threadable) ThreadError {
+		img, err := rt.ThreadReadImage()
+		if err != nil {
+			return err
+		}
+
+		results <- img
+		return nil
+	}
+}
+
+// Expects to be called on a Thread
+// Free the texture and render target. The render texture cannot be used
+// afterwards. Everything is freed even if unbinding fails, and the error is
+// returned.
+func (rt *RenderTexture) ThreadClose() ThreadError {
+	if rt.closed {
+		return nil
+	}
+	err := rt.ThreadUnbind()
+
+	if rt.pbuffer != nil {
+		rt.pbuffer.close()
+		rt.pbuffer = nil
+	}
+	if rt.fbo != nil {
+		rt.fbo.deleteFramebuffers(1, unsafe.Pointer(&rt.framebuffer))
+		if rt.depth {
+			rt.fbo.deleteRenderbuffers(1, unsafe.Pointer(&rt.depthbuffer))
+		}
+	}
+	C.glDeleteTextures(1, &rt.texture)
+
+	delete(rt.context.renderTextures, rt)
+	rt.closed = true
+	return err
+}
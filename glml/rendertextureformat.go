@@ -0,0 +1,77 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"fmt"
+)
+
+// The pixel format of a RenderTexture's color buffer
+type RenderTextureFormat int
+
+const (
+	RenderTextureRGBA8 RenderTextureFormat = iota // 8 bits per channel with alpha
+	RenderTextureRGB8                             // 8 bits per channel without alpha
+)
+
+// The sized internal format of the color texture. These come from OpenGL
+// 1.1, so the generated constants don't have them.
+func (f RenderTextureFormat) internalFormat() uint32 {
+	if f == RenderTextureRGB8 {
+		return 0x8051 // GL_RGB8
+	}
+	return 0x8058 // GL_RGBA8
+}
+
+// The color bits of a pbuffer holding the format
+func (f RenderTextureFormat) bitsPerPixel() uint {
+	if f == RenderTextureRGB8 {
+		return 24
+	}
+	return 32
+}
+
+// Check the size of a render texture
+func checkRenderTextureSize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("invalid render texture size %dx%d", width, height)
+	}
+	return nil
+}
+
+// The names of the incomplete statuses of glCheckFramebufferStatus
+var framebufferStatusNames = map[uint32]string{
+	GL_FRAMEBUFFER_UNDEFINED:                     "undefined",
+	GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT:         "incomplete attachment",
+	GL_FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT: "missing attachment",
+	GL_FRAMEBUFFER_INCOMPLETE_DIMENSIONS_EXT:     "attachments of different sizes",
+	GL_FRAMEBUFFER_INCOMPLETE_FORMATS_EXT:        "attachments of different formats",
+	GL_FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER:        "incomplete draw buffer",
+	GL_FRAMEBUFFER_INCOMPLETE_READ_BUFFER:        "incomplete read buffer",
+	GL_FRAMEBUFFER_UNSUPPORTED:                   "unsupported combination of formats",
+	GL_FRAMEBUFFER_INCOMPLETE_MULTISAMPLE:        "mismatched multisampling",
+}
+
+// Turn the result of glCheckFramebufferStatus into an error, or nil if the
+// framebuffer is complete
+func framebufferStatusError(status uint32) error {
+	if status == GL_FRAMEBUFFER_COMPLETE {
+		return nil
+	}
+	if name, ok := framebufferStatusNames[status]; ok {
+		return fmt.Errorf("framebuffer incomplete: %s (0x%x)", name, status)
+	}
+	return fmt.Errorf("framebuffer incomplete (0x%x)", status)
+}
+
+// Flip an image upside down in place. OpenGL's origin is the bottom left,
+// image's is the top left.
+func flipRows(pix []uint8, stride, height int) {
+	row := make([]uint8, stride)
+	for top, bottom := 0, height-1; top < bottom; top, bottom = top+1, bottom-1 {
+		t := pix[top*stride : (top+1)*stride]
+		b := pix[bottom*stride : (bottom+1)*stride]
+		copy(row, t)
+		copy(t, b)
+		copy(b, row)
+	}
+}
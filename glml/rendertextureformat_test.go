@@ -0,0 +1,89 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderTextureFormat(t *testing.T) {
+	tests := []struct {
+		format         RenderTextureFormat
+		internalFormat uint32
+		bitsPerPixel   uint
+	}{
+		{RenderTextureRGBA8, 0x8058, 32},
+		{RenderTextureRGB8, 0x8051, 24},
+	}
+	for _, test := range tests {
+		if f := test.format.internalFormat(); f != test.internalFormat {
+			t.Errorf("%d: expected internal format 0x%x, got 0x%x", test.format, test.internalFormat, f)
+		}
+		if bits := test.format.bitsPerPixel(); bits != test.bitsPerPixel {
+			t.Errorf("%d: expected %d bits, got %d", test.format, test.bitsPerPixel, bits)
+		}
+	}
+}
+
+func TestCheckRenderTextureSize(t *testing.T) {
+	tests := []struct {
+		width, height int
+		valid         bool
+	}{
+		{1, 1, true},
+		{640, 480, true},
+		{0, 480, false},
+		{640, 0, false},
+		{-1, 1, false},
+	}
+	for _, test := range tests {
+		if err := checkRenderTextureSize(test.width, test.height); (err == nil) != test.valid {
+			t.Errorf("%dx%d: expected valid %v, got %v", test.width, test.height, test.valid, err)
+		}
+	}
+}
+
+func TestFramebufferStatusError(t *testing.T) {
+	tests := []struct {
+		status   uint32
+		expected string // A part of the error, or "" for none
+	}{
+		{GL_FRAMEBUFFER_COMPLETE, ""},
+		{GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT, "incomplete attachment (0x8cd6)"},
+		{GL_FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT, "missing attachment"},
+		{GL_FRAMEBUFFER_UNSUPPORTED, "unsupported"},
+		{0x1234, "framebuffer incomplete (0x1234)"},
+	}
+	for _, test := range tests {
+		err := framebufferStatusError(test.status)
+		switch {
+		case test.expected == "" && err != nil:
+			t.Errorf("0x%x: expected no error, got %v", test.status, err)
+		case test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)):
+			t.Errorf("0x%x: expected an error containing %q, got %v", test.status, test.expected, err)
+		}
+	}
+}
+
+func TestFlipRows(t *testing.T) {
+	tests := []struct {
+		pix      []uint8
+		stride   int
+		height   int
+		expected []uint8
+	}{
+		{[]uint8{}, 2, 0, []uint8{}},
+		{[]uint8{1, 2}, 2, 1, []uint8{1, 2}},
+		{[]uint8{1, 2, 3, 4}, 2, 2, []uint8{3, 4, 1, 2}},
+		{[]uint8{1, 2, 3, 4, 5, 6}, 2, 3, []uint8{5, 6, 3, 4, 1, 2}},
+		{[]uint8{1, 2, 3, 4, 5, 6, 7, 8}, 2, 4, []uint8{7, 8, 5, 6, 3, 4, 1, 2}},
+	}
+	for _, test := range tests {
+		pix := append([]uint8{}, test.pix...)
+		flipRows(pix, test.stride, test.height)
+		if !reflect.DeepEqual(pix, test.expected) {
+			t.Errorf("%v: expected %v, got %v", test.pix, test.expected, pix)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"sync"
+)
+
+// A set of contexts which share OpenGL objects (textures, buffers, shaders,
+// ...). Contexts created outside of a share group share nothing.
+//
+// Contexts join the group when they are initialized and leave it when they
+// are closed. Initializing contexts into the same group is serialized, but
+// different groups never wait on each other.
+type ShareGroup struct {
+	mutex   sync.Mutex
+	changed *sync.Cond        // Signalled when a join finishes
+	members map[*Context]bool // The initialized contexts of the group
+	joining bool              // Is a context joining the group?
+	sharing *Context          // The member the joining context shares with, which can't leave yet
+}
+
+// Create an empty share group
+func CreateShareGroup() *ShareGroup {
+	g := &ShareGroup{
+		members: make(map[*Context]bool),
+	}
+	g.changed = sync.NewCond(&g.mutex)
+	return g
+}
+
+// Create a context with default settings and dimensions in the share group
+func (g *ShareGroup) CreateContext() *Context {
+	c := CreateContext()
+	c.group = g
+	return c
+}
+
+// A context with specific settings and back buffer dimensions in the share
+// group
+func (g *ShareGroup) CreateContextFromSettings(settings ContextSettings, width, height int) *Context {
+	c := CreateContextFromSettings(settings, width, height)
+	c.group = g
+	return c
+}
+
+// Construct a new window whose context is in the share group
+//
+// See CreateWindow for a description of the parameters.
+func (g *ShareGroup) CreateWindow(monitor *Monitor, mode VideoMode, title string, style WindowStyle, settings ContextSettings) (*Window, error) {
+	w, err := CreateWindow(monitor, mode, title, style, settings)
+	if err != nil {
+		return nil, err
+	}
+	w.context.group = g
+	return w, nil
+}
+
+// Start joining the group, waiting for any other join to finish. Returns a
+// member to share objects with, or nil if the group is empty. Members which
+// aren't current on a thread are preferred, since they needn't be paused.
+//
+// The mutex isn't held during the join, so members can leave, except for
+// the one returned, whose objects are needed until unlockJoin.
+func (g *ShareGroup) lockJoin() *Context {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for g.joining {
+		g.changed.Wait()
+	}
+	g.joining = true
+
+	g.sharing = nil
+	for c := range g.members {
+		g.sharing = c
+		if !c.IsActive() {
+			break
+		}
+	}
+	return g.sharing
+}
+
+// Finish joining the group. If joined is false the context failed to
+// initialize and is not added.
+func (g *ShareGroup) unlockJoin(c *Context, joined bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if joined {
+		g.members[c] = true
+	}
+	g.joining = false
+	g.sharing = nil
+	g.changed.Broadcast()
+}
+
+// Remove a context from the group. Expects the context not to be active,
+// so a join sharing with it doesn't wait on it to be paused.
+func (g *ShareGroup) leave(c *Context) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for g.sharing == c {
+		g.changed.Wait()
+	}
+	delete(g.members, c)
+}
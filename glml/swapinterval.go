@@ -0,0 +1,43 @@
+// Copyright © 2012 Popog
+package glml
+
+import "fmt"
+
+// Returned when a swap interval cannot be applied. It is never fatal; the
+// previous interval remains in effect.
+//
+// Err is nil when the interval isn't supported, and holds the driver's
+// error when the driver failed to apply a supported interval.
+type SwapIntervalError struct {
+	Interval int    // The requested interval, 0 when reading the interval failed
+	Reason   string // Why the interval was rejected
+	Err      error  // The underlying error, e.g. a syscall.Errno, or nil
+}
+
+func (e *SwapIntervalError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("swap interval %d: %s: %v", e.Interval, e.Reason, e.Err)
+	}
+	return fmt.Sprintf("swap interval %d: %s", e.Interval, e.Reason)
+}
+
+func (e *SwapIntervalError) Unwrap() error { return e.Err }
+
+func (e *SwapIntervalError) Fatal() bool { return false }
+
+// The swap control a backend supports
+type swapControl struct {
+	supported bool // Intervals other than the driver default can be set
+	adaptive  bool // Negative intervals (late swap tearing) can be set
+}
+
+// Check an interval against the backend's swap control
+func (sc swapControl) check(interval int) ThreadError {
+	switch {
+	case !sc.supported:
+		return &SwapIntervalError{Interval: interval, Reason: "swap control is not supported"}
+	case interval < 0 && !sc.adaptive:
+		return &SwapIntervalError{Interval: interval, Reason: "adaptive vsync (swap control tear) is not supported"}
+	}
+	return nil
+}
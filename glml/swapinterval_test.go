@@ -0,0 +1,58 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSwapControl_Check(t *testing.T) {
+	tests := []struct {
+		control  swapControl
+		interval int
+		ok       bool
+	}{
+		{swapControl{}, 0, false},
+		{swapControl{supported: true}, 0, true},
+		{swapControl{supported: true}, 1, true},
+		{swapControl{supported: true}, 4, true},
+		{swapControl{supported: true}, -1, false},
+		{swapControl{supported: true, adaptive: true}, -1, true},
+	}
+
+	for _, test := range tests {
+		err := test.control.check(test.interval)
+		if (err == nil) != test.ok {
+			t.Errorf("%+v.check(%d) = %v", test.control, test.interval, err)
+		}
+		if err == nil {
+			continue
+		}
+		if err.Fatal() {
+			t.Errorf("%+v.check(%d) is fatal", test.control, test.interval)
+		}
+		if e, ok := err.(*SwapIntervalError); !ok || e.Interval != test.interval {
+			t.Errorf("%+v.check(%d) returned %#v", test.control, test.interval, err)
+		}
+	}
+}
+
+func TestSwapIntervalError_Unwrap(t *testing.T) {
+	driver := errors.New("driver failure")
+	tests := []struct {
+		err      *SwapIntervalError
+		expected string
+	}{
+		{&SwapIntervalError{Interval: 1, Reason: "swap control is not supported"}, "swap interval 1: swap control is not supported"},
+		{&SwapIntervalError{Interval: 2, Reason: "wglSwapIntervalEXT failed", Err: driver}, "swap interval 2: wglSwapIntervalEXT failed: driver failure"},
+	}
+
+	for _, test := range tests {
+		if msg := test.err.Error(); msg != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, msg)
+		}
+		if errors.Is(test.err, driver) != (test.err.Err != nil) {
+			t.Errorf("%q: errors.Is reports %v", test.expected, errors.Is(test.err, driver))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Turns the platform's text input, which arrives as UTF-16 code units and
+// input method composition updates, into text events
+type textInput struct {
+	highSurrogate rune // The first half of a surrogate pair, or 0
+	composing     bool // Is composition text being shown?
+}
+
+// Convert a UTF-16 offset into text to a byte offset into the UTF-8
+// encoded string. Offsets past the end are clamped.
+func utf16OffsetToByte(text []uint16, offset int) int {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	if offset <= 0 {
+		return 0
+	}
+	return len(string(utf16.Decode(text[:offset])))
+}
+
+// Report committed text as a TextCommitEvent and a TextEnteredEvent per
+// character
+func (ti *textInput) commitEvents(header EventHeader, text string) []Event {
+	events := []Event{TextCommitEvent{EventHeader: header, Text: text}}
+	for _, r := range text {
+		events = append(events, TextEnteredEvent{EventHeader: header, Character: r})
+	}
+	return events
+}
+
+// A single UTF-16 code unit was typed
+func (ti *textInput) char(header EventHeader, unit uint16) []Event {
+	r := rune(unit)
+	switch {
+	case utf16.IsSurrogate(r) && r < 0xDC00: // High surrogate, wait for the low one
+		var events []Event
+		if ti.highSurrogate != 0 {
+			events = ti.commitEvents(header, string(utf8.RuneError))
+		}
+		ti.highSurrogate = r
+		return events
+
+	case utf16.IsSurrogate(r): // Low surrogate
+		high := ti.highSurrogate
+		ti.highSurrogate = 0
+		if high == 0 {
+			r = utf8.RuneError
+		} else {
+			r = utf16.DecodeRune(high, r)
+		}
+
+	case ti.highSurrogate != 0: // A high surrogate with no low surrogate
+		ti.highSurrogate = 0
+		return append(ti.commitEvents(header, string(utf8.RuneError)), ti.commitEvents(header, string(r))...)
+	}
+
+	return ti.commitEvents(header, string(r))
+}
+
+// The input method changed the composition text. The cursor and the
+// selection (the clause being converted) are UTF-16 offsets into text, and
+// selection is empty if selectionStart == selectionEnd.
+func (ti *textInput) compose(header EventHeader, text []uint16, cursor, selectionStart, selectionEnd int) []Event {
+	if len(text) == 0 && !ti.composing {
+		return nil
+	}
+
+	ti.composing = len(text) != 0
+	return []Event{TextCompositionEvent{
+		EventHeader:    header,
+		Text:           string(utf16.Decode(text)),
+		Cursor:         utf16OffsetToByte(text, cursor),
+		SelectionStart: utf16OffsetToByte(text, selectionStart),
+		SelectionEnd:   utf16OffsetToByte(text, selectionEnd),
+	}}
+}
+
+// The input method committed text
+func (ti *textInput) commit(header EventHeader, text []uint16) []Event {
+	if len(text) == 0 {
+		return nil
+	}
+	return ti.commitEvents(header, string(utf16.Decode(text)))
+}
+
+// The input method ended composition, clearing any composition text
+func (ti *textInput) end(header EventHeader) []Event {
+	return ti.compose(header, nil, 0, 0, 0)
+}
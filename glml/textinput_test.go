@@ -0,0 +1,106 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// Drives a textInput the way a platform input method would
+type stubInputMethod struct {
+	ti     textInput
+	events []Event
+}
+
+func (im *stubInputMethod) typeText(s string) {
+	for _, unit := range utf16.Encode([]rune(s)) {
+		im.events = append(im.events, im.ti.char(EventHeader{}, unit)...)
+	}
+}
+
+func (im *stubInputMethod) compose(s string, cursor, selectionStart, selectionEnd int) {
+	im.events = append(im.events, im.ti.compose(EventHeader{}, utf16.Encode([]rune(s)), cursor, selectionStart, selectionEnd)...)
+}
+
+func (im *stubInputMethod) commit(s string) {
+	im.events = append(im.events, im.ti.commit(EventHeader{}, utf16.Encode([]rune(s)))...)
+}
+
+func (im *stubInputMethod) end() {
+	im.events = append(im.events, im.ti.end(EventHeader{})...)
+}
+
+func TestTextInput_Surrogates(t *testing.T) {
+	var im stubInputMethod
+	im.typeText("a😀")
+
+	expected := []Event{
+		TextCommitEvent{Text: "a"},
+		TextEnteredEvent{Character: 'a'},
+		TextCommitEvent{Text: "😀"},
+		TextEnteredEvent{Character: '😀'},
+	}
+	if !reflect.DeepEqual(im.events, expected) {
+		t.Errorf("expected %v, got %v", expected, im.events)
+	}
+
+	// Unpaired halves
+	im = stubInputMethod{}
+	im.events = append(im.events, im.ti.char(EventHeader{}, 0xDC00)...)
+	im.events = append(im.events, im.ti.char(EventHeader{}, 0xD83D)...)
+	im.typeText("b")
+
+	expected = []Event{
+		TextCommitEvent{Text: "�"},
+		TextEnteredEvent{Character: '�'},
+		TextCommitEvent{Text: "�"},
+		TextEnteredEvent{Character: '�'},
+		TextCommitEvent{Text: "b"},
+		TextEnteredEvent{Character: 'b'},
+	}
+	if !reflect.DeepEqual(im.events, expected) {
+		t.Errorf("expected %v, got %v", expected, im.events)
+	}
+}
+
+func TestTextInput_Composition(t *testing.T) {
+	var im stubInputMethod
+
+	// "にほん" converting its last two characters, with the cursor at the end
+	im.compose("にほん", 3, 1, 3)
+	im.commit("日本")
+	im.end()
+	im.end() // Nothing left to clear
+
+	expected := []Event{
+		TextCompositionEvent{Text: "にほん", Cursor: 9, SelectionStart: 3, SelectionEnd: 9},
+		TextCommitEvent{Text: "日本"},
+		TextEnteredEvent{Character: '日'},
+		TextEnteredEvent{Character: '本'},
+		TextCompositionEvent{},
+	}
+	if !reflect.DeepEqual(im.events, expected) {
+		t.Errorf("expected %v, got %v", expected, im.events)
+	}
+}
+
+func TestUTF16OffsetToByte(t *testing.T) {
+	text := utf16.Encode([]rune("a😀é"))
+	tests := []struct {
+		offset, expected int
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 1},
+		{3, 5},
+		{4, 7},
+		{10, 7},
+	}
+
+	for _, test := range tests {
+		if offset := utf16OffsetToByte(text, test.offset); offset != test.expected {
+			t.Errorf("utf16OffsetToByte(%d) = %d, expected %d", test.offset, offset, test.expected)
+		}
+	}
+}
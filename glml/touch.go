@@ -0,0 +1,255 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strconv"
+)
+
+// What a pen did
+type PenAction int
+
+const (
+	PenHover PenAction = iota // The pen moved in range without touching the surface
+	PenDown                   // The pen touched the surface
+	PenMove                   // The pen moved while touching the surface
+	PenUp                     // The pen was lifted from the surface
+	PenLeft                   // The pen went out of range
+
+	PenActionCount // Keep last -- the total number of pen actions
+)
+
+var penActionNames = [PenActionCount]string{
+	PenHover: "Hover",
+	PenDown:  "Down",
+	PenMove:  "Move",
+	PenUp:    "Up",
+	PenLeft:  "Left",
+}
+
+func (a PenAction) String() string {
+	if a < 0 || a >= PenActionCount {
+		return "PenAction(" + strconv.Itoa(int(a)) + ")"
+	}
+	return penActionNames[a]
+}
+
+// Is the pen touching the surface after the action?
+func (a PenAction) inContact() bool {
+	return a == PenDown || a == PenMove
+}
+
+// A pen sample from the platform
+type penSample struct {
+	x, y         int     // Position relative to the top-left of the window
+	contact      bool    // Is the pen touching the surface?
+	pressure     float64 // From 0 to 1
+	tiltX, tiltY float64 // In degrees, from -90 to 90
+	eraser       bool    // Is the eraser end or button in use?
+	barrel       bool    // Is the barrel button pressed?
+}
+
+// Turns the platform's touch and pen samples, which are identified by
+// platform pointer IDs, into touch and pen events. Finger numbers are
+// assigned here so that they're small and the same on every platform.
+type touchInput struct {
+	touches map[uint32]touchPoint // The active touches by pointer ID
+	pens    map[uint32]PenEvent   // The last event of each pen in range by pointer ID
+}
+
+// An active touch
+type touchPoint struct {
+	finger int // The number reported as Finger
+	x, y   int // The last position
+}
+
+// Convert a position in a window to fractions of its size
+func normalizedPosition(x, y int, width, height uint) (float64, float64) {
+	var nx, ny float64
+	if width != 0 {
+		nx = float64(x) / float64(width)
+	}
+	if height != 0 {
+		ny = float64(y) / float64(height)
+	}
+	return nx, ny
+}
+
+// The lowest finger number no active touch has
+func (ti *touchInput) freeFinger() int {
+	for finger := 0; ; finger++ {
+		used := false
+		for _, touch := range ti.touches {
+			if touch.finger == finger {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return finger
+		}
+	}
+}
+
+// A finger touched the window
+func (ti *touchInput) began(header EventHeader, pointer uint32, x, y int, width, height uint) []Event {
+	if ti.touches == nil {
+		ti.touches = make(map[uint32]touchPoint)
+	}
+
+	// A touch that didn't end is replaced
+	var events []Event
+	if _, ok := ti.touches[pointer]; ok {
+		events = ti.cancelled(header, pointer, width, height)
+	}
+
+	touch := touchPoint{finger: ti.freeFinger(), x: x, y: y}
+	ti.touches[pointer] = touch
+
+	nx, ny := normalizedPosition(x, y, width, height)
+	return append(events, TouchBeganEvent{
+		EventHeader: header,
+		Finger:      touch.finger,
+		X:           x,
+		Y:           y,
+		NormalizedX: nx,
+		NormalizedY: ny,
+	})
+}
+
+// A finger moved. A finger whose touch wasn't seen beginning begins.
+func (ti *touchInput) moved(header EventHeader, pointer uint32, x, y int, width, height uint) []Event {
+	touch, ok := ti.touches[pointer]
+	if !ok {
+		return ti.began(header, pointer, x, y, width, height)
+	}
+	if touch.x == x && touch.y == y {
+		return nil
+	}
+
+	touch.x, touch.y = x, y
+	ti.touches[pointer] = touch
+
+	nx, ny := normalizedPosition(x, y, width, height)
+	return []Event{TouchMovedEvent{
+		EventHeader: header,
+		Finger:      touch.finger,
+		X:           x,
+		Y:           y,
+		NormalizedX: nx,
+		NormalizedY: ny,
+	}}
+}
+
+// A finger was lifted
+func (ti *touchInput) ended(header EventHeader, pointer uint32, x, y int, width, height uint) []Event {
+	touch, ok := ti.touches[pointer]
+	if !ok {
+		return nil
+	}
+	delete(ti.touches, pointer)
+
+	nx, ny := normalizedPosition(x, y, width, height)
+	return []Event{TouchEndedEvent{
+		EventHeader: header,
+		Finger:      touch.finger,
+		X:           x,
+		Y:           y,
+		NormalizedX: nx,
+		NormalizedY: ny,
+	}}
+}
+
+// The system took a touch away, e.g. for a gesture, at its last position
+func (ti *touchInput) cancelled(header EventHeader, pointer uint32, width, height uint) []Event {
+	touch, ok := ti.touches[pointer]
+	if !ok {
+		return nil
+	}
+	delete(ti.touches, pointer)
+
+	nx, ny := normalizedPosition(touch.x, touch.y, width, height)
+	return []Event{TouchCancelledEvent{
+		EventHeader: header,
+		Finger:      touch.finger,
+		X:           touch.x,
+		Y:           touch.y,
+		NormalizedX: nx,
+		NormalizedY: ny,
+	}}
+}
+
+// Cancel every touch and lift every pen, e.g. when the window loses focus
+// and won't see them end
+func (ti *touchInput) cancelAll(header EventHeader, width, height uint) []Event {
+	var events []Event
+	for finger := 0; len(ti.touches) != 0; finger++ {
+		for pointer, touch := range ti.touches {
+			if touch.finger == finger {
+				events = append(events, ti.cancelled(header, pointer, width, height)...)
+			}
+		}
+	}
+	for pointer := range ti.pens {
+		events = append(events, ti.penLeft(header, pointer)...)
+	}
+	return events
+}
+
+// A pen moved, touched or was lifted
+func (ti *touchInput) pen(header EventHeader, pointer uint32, sample penSample, width, height uint) []Event {
+	if ti.pens == nil {
+		ti.pens = make(map[uint32]PenEvent)
+	}
+
+	wasDown := ti.pens[pointer].Action.inContact()
+	action := PenHover
+	switch {
+	case sample.contact && wasDown:
+		action = PenMove
+	case sample.contact:
+		action = PenDown
+	case wasDown:
+		action = PenUp
+	}
+
+	nx, ny := normalizedPosition(sample.x, sample.y, width, height)
+	event := PenEvent{
+		EventHeader: header,
+		Action:      action,
+		X:           sample.x,
+		Y:           sample.y,
+		NormalizedX: nx,
+		NormalizedY: ny,
+		Pressure:    sample.pressure,
+		TiltX:       sample.tiltX,
+		TiltY:       sample.tiltY,
+		Eraser:      sample.eraser,
+		Barrel:      sample.barrel,
+	}
+	if !sample.contact {
+		event.Pressure = 0
+	}
+	ti.pens[pointer] = event
+	return []Event{event}
+}
+
+// A pen went out of range, at its last position. A pen which was touching
+// the surface is lifted first.
+func (ti *touchInput) penLeft(header EventHeader, pointer uint32) []Event {
+	last, ok := ti.pens[pointer]
+	if !ok {
+		return nil
+	}
+	delete(ti.pens, pointer)
+
+	last.EventHeader = header
+	last.Pressure = 0
+
+	var events []Event
+	if last.Action.inContact() {
+		last.Action = PenUp
+		events = append(events, last)
+	}
+	last.Action = PenLeft
+	return append(events, last)
+}
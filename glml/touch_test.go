@@ -0,0 +1,110 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Describe touch and pen events without their times
+func describeTouchEvents(events []Event) []string {
+	var descriptions []string
+	for _, e := range events {
+		switch e := e.(type) {
+		case TouchBeganEvent:
+			descriptions = append(descriptions, fmt.Sprintf("began %d %d,%d %.2f,%.2f", e.Finger, e.X, e.Y, e.NormalizedX, e.NormalizedY))
+		case TouchMovedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("moved %d %d,%d %.2f,%.2f", e.Finger, e.X, e.Y, e.NormalizedX, e.NormalizedY))
+		case TouchEndedEvent:
+			descriptions = append(descriptions, fmt.Sprintf("ended %d %d,%d %.2f,%.2f", e.Finger, e.X, e.Y, e.NormalizedX, e.NormalizedY))
+		case TouchCancelledEvent:
+			descriptions = append(descriptions, fmt.Sprintf("cancelled %d %d,%d %.2f,%.2f", e.Finger, e.X, e.Y, e.NormalizedX, e.NormalizedY))
+		case PenEvent:
+			descriptions = append(descriptions, fmt.Sprintf("pen %s %d,%d %.2f tilt %v,%v eraser %v barrel %v", e.Action, e.X, e.Y, e.Pressure, e.TiltX, e.TiltY, e.Eraser, e.Barrel))
+		default:
+			descriptions = append(descriptions, fmt.Sprintf("%T", e))
+		}
+	}
+	return descriptions
+}
+
+func TestTouchInput_Touches(t *testing.T) {
+	var ti touchInput
+	var events []Event
+
+	// A 200x100 window, with pointer IDs like a platform's
+	events = append(events, ti.began(EventHeader{}, 512, 50, 25, 200, 100)...)
+	events = append(events, ti.began(EventHeader{}, 513, 100, 50, 200, 100)...)
+	events = append(events, ti.moved(EventHeader{}, 512, 60, 25, 200, 100)...)
+	events = append(events, ti.moved(EventHeader{}, 512, 60, 25, 200, 100)...) // Didn't move
+	events = append(events, ti.ended(EventHeader{}, 512, 60, 30, 200, 100)...)
+	events = append(events, ti.ended(EventHeader{}, 512, 60, 30, 200, 100)...) // Already ended
+
+	// The first free finger is reused, and a move without a beginning begins
+	events = append(events, ti.moved(EventHeader{}, 514, 0, 0, 200, 100)...)
+	events = append(events, ti.began(EventHeader{}, 515, 200, 100, 200, 100)...)
+	events = append(events, ti.cancelled(EventHeader{}, 513, 200, 100)...)
+	events = append(events, ti.began(EventHeader{}, 514, 10, 10, 200, 100)...) // Replaces the touch
+
+	expected := []string{
+		"began 0 50,25 0.25,0.25",
+		"began 1 100,50 0.50,0.50",
+		"moved 0 60,25 0.30,0.25",
+		"ended 0 60,30 0.30,0.30",
+		"began 0 0,0 0.00,0.00",
+		"began 2 200,100 1.00,1.00",
+		"cancelled 1 100,50 0.50,0.50",
+		"cancelled 0 0,0 0.00,0.00",
+		"began 0 10,10 0.05,0.10",
+	}
+	if descriptions := describeTouchEvents(events); !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("expected %q, got %q", expected, descriptions)
+	}
+
+	// Closing cancels in finger order
+	expected = []string{"cancelled 0 10,10 0.05,0.10", "cancelled 2 200,100 1.00,1.00"}
+	if descriptions := describeTouchEvents(ti.cancelAll(EventHeader{}, 200, 100)); !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("expected %q, got %q", expected, descriptions)
+	}
+	if len(ti.touches) != 0 {
+		t.Errorf("expected no touches, got %v", ti.touches)
+	}
+}
+
+func TestTouchInput_Pen(t *testing.T) {
+	var ti touchInput
+	var events []Event
+
+	events = append(events, ti.pen(EventHeader{}, 1, penSample{x: 10, y: 10, pressure: 0.5, tiltX: -20}, 200, 100)...)
+	events = append(events, ti.pen(EventHeader{}, 1, penSample{x: 10, y: 10, contact: true, pressure: 0.25, tiltX: -20}, 200, 100)...)
+	events = append(events, ti.pen(EventHeader{}, 1, penSample{x: 12, y: 11, contact: true, pressure: 0.75, tiltY: 15, barrel: true}, 200, 100)...)
+	events = append(events, ti.pen(EventHeader{}, 1, penSample{x: 12, y: 11}, 200, 100)...)
+	events = append(events, ti.penLeft(EventHeader{}, 1)...)
+	events = append(events, ti.penLeft(EventHeader{}, 1)...) // Already left
+
+	// Leaving while touching lifts the pen first
+	events = append(events, ti.pen(EventHeader{}, 2, penSample{x: 5, y: 6, contact: true, pressure: 1, eraser: true}, 200, 100)...)
+	events = append(events, ti.cancelAll(EventHeader{}, 200, 100)...)
+
+	expected := []string{
+		"pen Hover 10,10 0.00 tilt -20,0 eraser false barrel false",
+		"pen Down 10,10 0.25 tilt -20,0 eraser false barrel false",
+		"pen Move 12,11 0.75 tilt 0,15 eraser false barrel true",
+		"pen Up 12,11 0.00 tilt 0,0 eraser false barrel false",
+		"pen Left 12,11 0.00 tilt 0,0 eraser false barrel false",
+		"pen Down 5,6 1.00 tilt 0,0 eraser true barrel false",
+		"pen Up 5,6 0.00 tilt 0,0 eraser true barrel false",
+		"pen Left 5,6 0.00 tilt 0,0 eraser true barrel false",
+	}
+	if descriptions := describeTouchEvents(events); !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("expected %q, got %q", expected, descriptions)
+	}
+
+	if e := events[0].(PenEvent); e.NormalizedX != 0.05 || e.NormalizedY != 0.1 {
+		t.Errorf("unexpected normalized position %v,%v", e.NormalizedX, e.NormalizedY)
+	}
+	if s := PenAction(7).String(); s != "PenAction(7)" {
+		t.Errorf("unexpected name %s", s)
+	}
+}
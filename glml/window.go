@@ -3,6 +3,7 @@ package glml
 
 import (
 	"image"
+	"sync"
 )
 
 // Windows contain an context, but note that fatal errors on the context will not close the window
@@ -10,9 +11,13 @@ import (
 type Window struct {
 	initialize func(c *Window) ThreadError // The initialization function. Nil if already initialized
 
-	thread   *Thread
-	internal windowInternal
-	context  *Context
+	thread      *Thread
+	internal    windowInternal
+	context     *Context
+	events      *eventQueue // Nil unless EnableEventChannel was called
+	eventsMutex sync.Mutex  // Guards events, which any goroutine may enable
+	filter      EventFilter // Nil unless ThreadSetEventFilter was called
+	vsyncSet    bool        // Whether the default vsync has been set, which waits for the first activation
 }
 
 // Construct a new window
@@ -23,7 +28,13 @@ type Window struct {
 // style    Customize the look and behaviour of the window (borders, title bar, resizable, closable, ...)
 //          If style is StyleFullscreen, then mode must be a valid video mode.
 // settings Additional settings for the underlying OpenGL context.
+//
+// Returns ErrNotInitialized if Init has not been called.
 func CreateWindow(monitor *Monitor, mode VideoMode, title string, style WindowStyle, settings ContextSettings) (*Window, error) {
+	if !IsInitialized() {
+		return nil, ErrNotInitialized
+	}
+
 	// Check the style
 	if err := style.Check(); err != nil {
 		return nil, err
@@ -40,6 +51,7 @@ func CreateWindow(monitor *Monitor, mode VideoMode, title string, style WindowSt
 			return w.internal.initialize(monitor, mode, title, style)
 		},
 	}
+	w.internal.owner = w
 	w.context = createFromOwner(settings, &w.internal, mode.BitsPerPixel)
 
 	return w, nil
@@ -87,6 +99,11 @@ func (w *Window) Close() {
 		return
 	}
 
+	// Stop the event pump first, so it can't block the thread
+	if q := w.eventQueue(); q != nil {
+		q.close()
+	}
+
 	// deactivate if need be
 	if w.IsActive() {
 		w.GetThread().SetActive(nil)
@@ -150,12 +167,6 @@ func (w *Window) ThreadInitialize(thread *Thread) ThreadError {
 		}
 		w.ThreadReportError(err)
 	}
-	if err := w.ThreadSetVSyncEnabled(false); err != nil {
-		if err.Fatal() {
-			return err
-		}
-		w.ThreadReportError(err)
-	}
 	if err := w.ThreadSetKeyRepeatEnabled(thread, true); err != nil {
 		if err.Fatal() {
 			return err
@@ -174,6 +185,10 @@ func (w *Window) ThreadClose(thread *Thread) {
 		panic("thread is not initialThread")
 	}
 
+	if q := w.eventQueue(); q != nil {
+		q.close()
+	}
+
 	// close the context
 	w.context.ThreadClose(thread)
 
@@ -187,7 +202,30 @@ func (w *Window) ThreadClose(thread *Thread) {
 
 // Expects to be called on a Thread
 func (w *Window) ThreadActivate(thread *Thread) ThreadError {
-	return w.context.ThreadActivate(thread)
+	if err := w.context.ThreadActivate(thread); err != nil {
+		return err
+	}
+
+	// The swap interval can only be set once the context is current. It is
+	// left alone if the driver has no swap control.
+	if !w.vsyncSet {
+		w.vsyncSet = true
+		if w.context.internal.getSwapControl(w.context.extensions).supported {
+			if err := w.ThreadSetVSyncEnabled(false); err != nil {
+				w.ThreadReportError(err)
+			}
+		}
+	}
+	return nil
+}
+
+// Expects to be called on a Thread
+// Get the OpenGL functions of the window's context
+//
+// The returned GL is only valid while the window is active on the calling
+// thread.
+func (w *Window) ThreadGL() *GL {
+	return w.context.ThreadGL()
 }
 
 // Expects to be called on a Thread
@@ -245,6 +283,43 @@ func WindowThreadGetSettings(results chan<- ContextSettings) func(thread *Thread
 	}
 }
 
+// Expects to be called on a Thread
+// Get the GL and platform (WGL, GLX, EGL) extensions supported by the
+// window's context
+func (w *Window) ThreadExtensions() Extensions {
+	return w.context.ThreadExtensions()
+}
+
+// Expects to be called on a Thread
+// Returns true if the window's context supports the named GL or platform
+// extension
+func (w *Window) ThreadHasExtension(name string) bool {
+	return w.context.ThreadHasExtension(name)
+}
+
+// A thread command helper for Window.ThreadExtensions
+func WindowThreadExtensions(results chan<- Extensions) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		results <- t.(*Window).ThreadExtensions()
+		return nil
+	}
+}
+
+// Expects to be called on a Thread
+// Retrieve the vendor, renderer and version strings of the OpenGL
+// implementation behind the window's context
+func (w *Window) ThreadGetInfo() ContextInfo {
+	return w.context.ThreadGetInfo()
+}
+
+// A thread command helper for Window.ThreadGetInfo
+func WindowThreadGetInfo(results chan<- ContextInfo) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		results <- t.(*Window).ThreadGetInfo()
+		return nil
+	}
+}
+
 // Expects to be called on a Thread
 // Enable or disable vertical synchronization.
 //
@@ -263,6 +338,41 @@ func WindowThreadSetVSyncEnabled(enabled bool) func(thread *Thread, t Threadable
 	}
 }
 
+// Expects to be called on a Thread
+// Set the number of vertical blanks to wait between buffer swaps.
+//
+// See Context.ThreadSetSwapInterval.
+func (w *Window) ThreadSetSwapInterval(interval int) ThreadError {
+	return w.context.ThreadSetSwapInterval(interval)
+}
+
+// A thread command helper for Window.ThreadSetSwapInterval
+func WindowThreadSetSwapInterval(interval int) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetSwapInterval(interval)
+	}
+}
+
+// Expects to be called on a Thread
+// Get the swap interval actually applied by the driver
+func (w *Window) ThreadGetSwapInterval() (int, ThreadError) {
+	return w.context.ThreadGetSwapInterval()
+}
+
+// A thread command helper for Window.ThreadGetSwapInterval
+// If an error occurs, results will not be sent, so be sure to check Window.Errors()
+func WindowThreadGetSwapInterval(results chan<- int) func(thread *Thread, t Threadable) ThreadError {
+	return func(_ *Thread, t Threadable) ThreadError {
+		interval, err := t.(*Window).ThreadGetSwapInterval()
+		if err != nil {
+			return err
+		}
+
+		results <- interval
+		return nil
+	}
+}
+
 // Expects to be called on InitialThread()
 // Get the position of the window
 func (w *Window) ThreadGetPosition(thread *Thread) (x, y int) {
@@ -355,6 +465,54 @@ func (w *Window) ThreadSetMouseCursorVisible(thread *Thread, visible bool) Threa
 	return w.internal.setMouseCursorVisible(visible)
 }
 
+// Expects to be called on InitialThread()
+// Change the mouse cursor displayed over the window
+//
+// The window keeps a reference to the cursor until another cursor is set
+// or the window is closed, so the caller may Release it right away. A nil
+// cursor displays the OS default arrow, which is the default.
+func (w *Window) ThreadSetCursor(thread *Thread, cursor *Cursor) ThreadError {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return w.internal.setCursor(cursor)
+}
+
+// A thread command helper for Window.ThreadSetCursor
+func WindowThreadSetCursor(cursor *Cursor) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetCursor(thread, cursor)
+	}
+}
+
+// Expects to be called on InitialThread()
+// Set how the mouse cursor behaves over the window
+//
+// In CursorModeConfined and CursorModeRelative the cursor is kept inside
+// the window while it has focus, and released when it loses focus. In
+// CursorModeRelative, MouseRawMotionEvents are sent instead of
+// MouseMoveEvents.
+//
+// The cursor mode is CursorModeNormal by default.
+func (w *Window) ThreadSetCursorMode(thread *Thread, mode CursorMode) ThreadError {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+	if mode < 0 || mode >= CursorModeCount {
+		panic("cursor mode out of range")
+	}
+
+	return w.internal.setCursorMode(mode)
+}
+
+// A thread command helper for Window.ThreadSetCursorMode
+func WindowThreadSetCursorMode(mode CursorMode) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetCursorMode(thread, mode)
+	}
+}
+
 // Expects to be called on InitialThread()
 // Enable or disable automatic key-repeat
 //
@@ -376,12 +534,18 @@ func (w *Window) ThreadSetKeyRepeatEnabled(thread *Thread, enabled bool) ThreadE
 // 
 // If block is true, this function will wait for an event. If block is false
 // and there are no pending events then the return value is nil.
+//
+// The events are passed through the filter set by ThreadSetEventFilter.
 func (w *Window) ThreadPollEvents(thread *Thread, block bool) ([]Event, []ThreadError) {
 	if w.InitialThread() != thread {
 		panic("thread is not initialThread")
 	}
 
-	return w.internal.pollEvents(block)
+	events, errors := w.internal.pollEvents(block)
+	if w.filter != nil {
+		events = w.filter.FilterEvents(events)
+	}
+	return events, errors
 }
 
 // Get the current position of the mouse in window coordinates
@@ -404,6 +568,29 @@ func (w *Window) ThreadSetMousePosition(thread *Thread, x, y int) ThreadError {
 	return w.internal.setMousePosition(x, y)
 }
 
+// Expects to be called on InitialThread()
+// Tell the input method where text is being entered, in window
+// coordinates, so its candidate window appears next to it rather than
+// covering it. Call it whenever the text cursor moves.
+//
+// Setting a rect also means the application draws the composition from
+// TextCompositionEvent, so the input method's composition window is hidden.
+// Until then, the input method draws the composition itself.
+func (w *Window) ThreadSetTextInputRect(thread *Thread, x, y int, width, height uint) ThreadError {
+	if w.InitialThread() != thread {
+		panic("thread is not initialThread")
+	}
+
+	return w.internal.setTextInputRect(x, y, width, height)
+}
+
+// A thread command helper for Window.ThreadSetTextInputRect
+func WindowThreadSetTextInputRect(x, y int, width, height uint) func(thread *Thread, t Threadable) ThreadError {
+	return func(thread *Thread, t Threadable) ThreadError {
+		return t.(*Window).ThreadSetTextInputRect(thread, x, y, width, height)
+	}
+}
+
 // Expects to be called on InitialThread()
 // Get the OS-specific handle of the window
 //
@@ -67,3 +67,184 @@ func TestWindowStyles(t *testing.T) {
 	}
 	thread.Close()
 }
+
+func TestEnableEventChannel_Concurrent(t *testing.T) {
+	mode := VideoMode{Width: 320, Height: 240, BitsPerPixel: 32}
+	window, err := CreateWindow(GetDefaultMonitor(), mode, "Events", WindowStyleDefault, ContextSettingsDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread := CreateThread()
+	defer thread.Close()
+	if err := thread.SetActive(window); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only one of the calls enables the channel
+	results := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func() { results <- window.EnableEventChannel(EventChannelOptionsDefault) }()
+	}
+	enabled := 0
+	for i := 0; i < 8; i++ {
+		if <-results == nil {
+			enabled++
+		}
+	}
+	if enabled != 1 {
+		t.Errorf("expected the channel to be enabled once, got %d", enabled)
+	}
+
+	events := window.Events()
+	if events == nil {
+		t.Fatal("expected an event channel")
+	}
+	window.Close()
+	for range events {
+	}
+}
+
+// Resizing sends WM_SIZE rather than posting it, so the resize event must
+// be stamped when it happens, not with the time of earlier queued input
+func TestWindowResizeTimestamp(t *testing.T) {
+	mode := VideoMode{Width: 320, Height: 240, BitsPerPixel: 32}
+	window, err := CreateWindow(GetDefaultMonitor(), mode, "Timestamps", WindowStyleDefault, ContextSettingsDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread := CreateThread()
+	defer thread.Close()
+	defer window.Close()
+	if err := thread.SetActive(window); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(command func(thread *Thread, w *Window)) {
+		t.Helper()
+		finished := make(chan bool, 1)
+		window.Commands() <- func(thread *Thread, t Threadable) ThreadError {
+			command(thread, t.(*Window))
+			finished <- true
+			return nil
+		}
+		select {
+		case err := <-window.Errors():
+			t.Fatal(err)
+		case <-finished:
+		}
+	}
+
+	// Handle the queued messages of the window's creation, then let them age
+	run(func(thread *Thread, w *Window) { w.ThreadPollEvents(thread, false) })
+	time.Sleep(200 * time.Millisecond)
+
+	var before time.Duration
+	var events []Event
+	run(func(thread *Thread, w *Window) {
+		before = currentTimestamp()
+		w.ThreadSetSize(thread, 400, 300)
+		events, _ = w.ThreadPollEvents(thread, false)
+	})
+
+	resized := false
+	for _, e := range events {
+		if e, ok := e.(WindowResizeEvent); ok {
+			resized = true
+			if e.Time < before {
+				t.Errorf("resize stamped %v before it happened", before-e.Time)
+			}
+		}
+	}
+	if !resized {
+		t.Error("expected a WindowResizeEvent")
+	}
+}
+
+// Creating a window disables vsync once the context is current, without
+// reporting an error
+func TestWindowDefaultVSync(t *testing.T) {
+	mode := VideoMode{Width: 320, Height: 240, BitsPerPixel: 32}
+	window, err := CreateWindow(GetDefaultMonitor(), mode, "VSync", WindowStyleDefault, ContextSettingsDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread := CreateThread()
+	defer thread.Close()
+	defer window.Close()
+	if err := thread.SetActive(window); err != nil {
+		t.Fatal(err)
+	}
+
+	results := make(chan int, 1)
+	window.Commands() <- func(thread *Thread, t Threadable) ThreadError {
+		interval, err := t.(*Window).context.ThreadGetSwapInterval()
+		if _, ok := err.(*SwapIntervalError); err != nil && !ok {
+			return NewThreadError(err, false)
+		}
+		if err != nil {
+			interval = 0 // The driver can't report it
+		}
+		results <- interval
+		return nil
+	}
+
+	select {
+	case err := <-window.Errors():
+		t.Fatal(err)
+	case interval := <-results:
+		if interval != 0 {
+			t.Errorf("expected vsync to be disabled, got swap interval %d", interval)
+		}
+	}
+}
+
+// Raw mouse input is registered for the whole process, so it stays
+// registered while any window is in relative mode
+func TestRelativeModeWindows(t *testing.T) {
+	mode := VideoMode{Width: 320, Height: 240, BitsPerPixel: 32}
+	windows := make([]*Window, 2)
+	for i := range windows {
+		window, err := CreateWindow(GetDefaultMonitor(), mode, "Relative", WindowStyleDefault, ContextSettingsDefault)
+		if err != nil {
+			t.Fatal(err)
+		}
+		thread := CreateThread()
+		defer thread.Close()
+		defer window.Close()
+		if err := thread.SetActive(window); err != nil {
+			t.Fatal(err)
+		}
+		windows[i] = window
+	}
+
+	setMode := func(window *Window, mode CursorMode) {
+		t.Helper()
+		results := make(chan ThreadError, 1)
+		window.Commands() <- func(thread *Thread, t Threadable) ThreadError {
+			results <- t.(*Window).ThreadSetCursorMode(thread, mode)
+			return nil
+		}
+		if err := <-results; err != nil {
+			t.Fatal(err)
+		}
+	}
+	count := func() int {
+		rawMouseMutex.Lock()
+		defer rawMouseMutex.Unlock()
+		return rawMouseWindows
+	}
+
+	base := count()
+	setMode(windows[0], CursorModeRelative)
+	setMode(windows[1], CursorModeRelative)
+	setMode(windows[0], CursorModeNormal)
+	if n := count() - base; n != 1 {
+		t.Errorf("expected 1 window in relative mode, got %d", n)
+	}
+
+	// Closing a window in relative mode leaves it
+	windows[1].Close()
+	if n := count() - base; n != 0 {
+		t.Errorf("expected no window in relative mode, got %d", n)
+	}
+}
@@ -1,26 +1,24 @@
 // Copyright © 2012 Popog
 package glml
 
+// #cgo windows LDFLAGS: -limm32
 // #include "helper_windows.h"
-// 
+// #include <imm.h>
+//
 // extern LRESULT CALLBACK (*pGlobalOnEvent)(HWND handle, UINT message, WPARAM wParam, LPARAM lParam);
 import "C"
 import (
 	"fmt"
 	"image"
+	"sync"
+	"time"
 	"unsafe"
 )
 
-var className, classNameLength = utf16Convert("go-glml/window.Window")
+// Registered by Init, with the class name from InitOptions
 var windowClass = C.WNDCLASSW{
-	lpfnWndProc:   C.pGlobalOnEvent,
-	style:         C.CS_OWNDC | C.CS_HREDRAW | C.CS_VREDRAW,
-	hInstance:     C.HINSTANCE(C.GetModuleHandle(nil)),
-	lpszClassName: className,
-}
-
-func init() {
-	C.RegisterClassW(&windowClass)
+	lpfnWndProc: C.pGlobalOnEvent,
+	style:       C.CS_OWNDC | C.CS_HREDRAW | C.CS_VREDRAW,
 }
 
 type WindowHandle struct {
@@ -32,20 +30,33 @@ func (wh WindowHandle) IsValid() bool {
 }
 
 type windowInternal struct {
+	owner       *Window       // The window this is the implementation of
 	events      []Event       // The events from polling
 	eventErrors []ThreadError // the errors from polling
 
-	devMode              *C.DEVMODEW    // The fullscreen settings
-	monitor              *Monitor       // The monitor we're fullscreen on (nil if we're not a fullscreen window)
-	window               WindowHandle   // Win32 handle of the window
-	callback             unsafe.Pointer // Stores the original event callback function of the control
-	cursor               C.HCURSOR      // The system cursor to display into the window
-	icon                 C.HICON        // Custom icon assigned to the window
-	keyRepeatEnabled     bool           // Automatic key-repeat state for keydown events
-	isCursorIn           bool           // Is the mouse cursor in the window's area ?
-	lastSizeX, lastSizeY uint           // The last handled size of the window
-	resizing             bool           // Is the window being resized ?
-	inactive, minimized  bool           // The current active or not state of the window
+	devMode              *C.DEVMODEW      // The fullscreen settings
+	monitor              *Monitor         // The monitor we're fullscreen on (nil if we're not a fullscreen window)
+	window               WindowHandle     // Win32 handle of the window
+	callback             unsafe.Pointer   // Stores the original event callback function of the control
+	cursor               *Cursor          // The cursor to display into the window, nil for the arrow
+	cursorVisible        bool             // Is the cursor shown over the window?
+	cursorMode           CursorMode       // How the cursor behaves over the window
+	cursorClipped        bool             // Is the cursor confined to the window by us?
+	dispatched           *C.MSG           // The queued message being dispatched, nil outside of DispatchMessage
+	drops                dropInput        // Drag and drop state
+	focused              bool             // Does the window have the keyboard focus?
+	icon                 C.HICON          // Custom icon assigned to the window
+	keyRepeatEnabled     bool             // Automatic key-repeat state for keydown events
+	oleInitialized       bool             // Did we initialize OLE for drag and drop?
+	isCursorIn           bool             // Is the mouse cursor in the window's area ?
+	lastSizeX, lastSizeY uint             // The last handled size of the window
+	resizing             bool             // Is the window being resized ?
+	inactive, minimized  bool             // The current active or not state of the window
+	sizeState            C.WPARAM         // The type of the last WM_SIZE, e.g. SIZE_MAXIMIZED
+	textInput            textInput        // Text and input method composition state
+	drawsComposition     bool             // Does the application draw the composition? Set with the text input rect
+	touches              touchInput       // Touch and pen state
+	wheel                wheelAccumulator // Vertical scrolling of less than a notch
 
 }
 
@@ -91,14 +102,21 @@ func (wi *windowInternal) initialize(monitor *Monitor, mode VideoMode, title str
 		height = C.int(rectangle.bottom - rectangle.top)
 	}
 	wTitle, _ := utf16Convert(title)
-	wi.window.Handle = C.CreateWindowExW(0, className, wTitle, win32Style, left, top, width, height, nil, nil, windowClass.hInstance, C.LPVOID(wi))
+	wi.window.Handle = C.CreateWindowExW(0, windowClass.lpszClassName, wTitle, win32Style, left, top, width, height, nil, nil, windowClass.hInstance, C.LPVOID(wi))
+	if wi.window.Handle == nil {
+		return NewThreadError(fmt.Errorf("CreateWindowExW failed (%d)", C.GetLastError()), true)
+	}
+
+	// Accept files and text dropped on the window. The window works without
+	// it, so a failure is only reported once the window is set up.
+	dropErr := wi.registerDropTarget()
 
 	// Switch to fullscreen if requested
 	if fullscreen {
 		wi.switchToFullscreen(monitor, mode)
 	}
 
-	return nil
+	return dropErr
 }
 
 func (wi *windowInternal) initializeFromExisting(window WindowHandle, settings ContextSettings) ThreadError {
@@ -121,6 +139,7 @@ func (wi *windowInternal) close() ThreadError {
 	if wi.callback == nil {
 		// Destroy the window
 		if wi.window.IsValid() {
+			wi.revokeDropTarget()
 			C.DestroyWindow(wi.window.Handle)
 		}
 	} else {
@@ -128,6 +147,12 @@ func (wi *windowInternal) close() ThreadError {
 		C.__SetWindowLongPtr(wi.window.Handle, C.GWLP_WNDPROC, wi.callback)
 	}
 
+	// Give up our reference to the cursor
+	if wi.cursor != nil {
+		wi.cursor.release()
+		wi.cursor = nil
+	}
+
 	return nil
 }
 
@@ -152,13 +177,15 @@ func (wi *windowInternal) pollEvents(block bool) ([]Event, []ThreadError) {
 	// Wait for messages if we're blocking
 	if block {
 		if C.WaitMessage() == 0 {
-		// TODO error check
+			// TODO error check
 		}
 	}
 
 	for message := (C.MSG{}); C.__PeekMessage(&message, wi.window.Handle, 0, 0, C.PM_REMOVE) == C.TRUE; {
 		C.TranslateMessage(&message)
+		wi.dispatched = &message
 		C.DispatchMessage(&message)
+		wi.dispatched = nil
 
 		// if the last one is fatal, return
 		if len(wi.eventErrors) != 0 && wi.eventErrors[len(wi.eventErrors)-1].Fatal() {
@@ -195,6 +222,15 @@ func (wi *windowInternal) getSize() (x, y uint) {
 	return uint(rect.right - rect.left), uint(rect.bottom - rect.top)
 }
 
+// Get the size of the client area, which pointer positions are relative
+// to. getSize measures the whole window with GetWindowRect, titlebar and
+// borders included, so touches and pens are normalized by this instead.
+func (wi *windowInternal) getClientSize() (x, y uint) {
+	var rect C.RECT
+	C.GetClientRect(wi.window.Handle, &rect)
+	return uint(rect.right - rect.left), uint(rect.bottom - rect.top)
+}
+
 // Change the size of the rendering region of the window
 func (wi *windowInternal) setSize(x, y uint) {
 	// SetWindowPos wants the total size of the window (including title bar and borders),
@@ -263,12 +299,130 @@ func (wi *windowInternal) setVisible(visible bool) ThreadError {
 
 // Show or hide the mouse cursor
 func (wi *windowInternal) setMouseCursorVisible(visible bool) ThreadError {
-	wi.cursor = nil
-	if visible {
-		wi.cursor = C.LoadCursorW(nil, C.__IDC_ARROW)
+	wi.cursorVisible = visible
+	wi.refreshCursor()
+	return nil
+}
+
+// Change the cursor displayed over the window, nil for the arrow
+func (wi *windowInternal) setCursor(cursor *Cursor) ThreadError {
+	if cursor != nil {
+		cursor.retain()
 	}
+	previous := wi.cursor
+	wi.cursor = cursor
+	wi.refreshCursor()
 
-	C.SetCursor(wi.cursor)
+	// Only free the previous cursor once it isn't displayed
+	if previous != nil {
+		previous.release()
+	}
+	return nil
+}
+
+// Display the window's cursor, unless it is hidden. A relative cursor is
+// only hidden while the window has focus.
+func (wi *windowInternal) refreshCursor() {
+	switch {
+	case !wi.cursorVisible, wi.cursorMode.hidesCursor() && (wi.focused || wi.cursorMode != CursorModeRelative):
+		C.SetCursor(nil)
+	case wi.cursor != nil:
+		C.SetCursor(wi.cursor.internal.handle)
+	default:
+		C.SetCursor(C.LoadCursorW(nil, C.__IDC_ARROW))
+	}
+}
+
+// Set how the mouse cursor behaves over the window
+func (wi *windowInternal) setCursorMode(mode CursorMode) ThreadError {
+	switch relative := mode == CursorModeRelative; {
+	case relative && wi.cursorMode != CursorModeRelative:
+		if err := enableRawMouse(); err != nil {
+			return err
+		}
+	case !relative && wi.cursorMode == CursorModeRelative:
+		disableRawMouse()
+	}
+
+	wi.cursorMode = mode
+	if err := wi.clipCursor(); err != nil {
+		return err
+	}
+	wi.refreshCursor()
+	return nil
+}
+
+// The number of windows in relative mode. Raw input is registered for the
+// whole process, so the windows share the registration.
+var rawMouseWindows int
+var rawMouseMutex sync.Mutex // Guards rawMouseWindows, windows may be on any thread
+
+// Start receiving WM_INPUT messages from the mouse, for a window entering
+// relative mode. They follow the keyboard focus, so whichever window in
+// relative mode is focused gets them.
+func enableRawMouse() ThreadError {
+	rawMouseMutex.Lock()
+	defer rawMouseMutex.Unlock()
+
+	if rawMouseWindows == 0 {
+		device := C.RAWINPUTDEVICE{
+			usUsagePage: 0x01, // Generic desktop controls
+			usUsage:     0x02, // Mouse
+		}
+		if C.RegisterRawInputDevices(&device, 1, C.UINT(unsafe.Sizeof(device))) == 0 {
+			return NewThreadError(fmt.Errorf("RegisterRawInputDevices failed (%d)", C.GetLastError()), false)
+		}
+	}
+	rawMouseWindows++
+	return nil
+}
+
+// Stop receiving WM_INPUT messages from the mouse once the last window
+// leaves relative mode. If that fails, the messages are ignored anyway.
+func disableRawMouse() {
+	rawMouseMutex.Lock()
+	defer rawMouseMutex.Unlock()
+
+	rawMouseWindows--
+	if rawMouseWindows == 0 {
+		device := C.RAWINPUTDEVICE{
+			usUsagePage: 0x01, // Generic desktop controls
+			usUsage:     0x02, // Mouse
+			dwFlags:     C.RIDEV_REMOVE,
+		}
+		C.RegisterRawInputDevices(&device, 1, C.UINT(unsafe.Sizeof(device)))
+	}
+}
+
+// Confine the cursor to the client area while the window has focus, if the
+// cursor mode asks for it, or release it. A relative cursor is locked to
+// the center of the client area, so it never reaches an edge and stops
+// producing raw motion in another window.
+func (wi *windowInternal) clipCursor() ThreadError {
+	if !wi.cursorMode.confinesCursor() || !wi.focused {
+		// Only release a clip we set, not one belonging to another window
+		if wi.cursorClipped {
+			wi.cursorClipped = false
+			if C.ClipCursor(nil) == 0 {
+				return NewThreadError(fmt.Errorf("ClipCursor failed (%d)", C.GetLastError()), false)
+			}
+		}
+		return nil
+	}
+
+	var rect C.RECT
+	if C.__GetClientScreenRect(wi.window.Handle, &rect) == 0 {
+		return NewThreadError(fmt.Errorf("GetClientRect failed (%d)", C.GetLastError()), false)
+	}
+	if wi.cursorMode == CursorModeRelative {
+		rect.left = (rect.left + rect.right) / 2
+		rect.top = (rect.top + rect.bottom) / 2
+		rect.right, rect.bottom = rect.left+1, rect.top+1
+	}
+	if C.ClipCursor(&rect) == 0 {
+		return NewThreadError(fmt.Errorf("ClipCursor failed (%d)", C.GetLastError()), false)
+	}
+	wi.cursorClipped = true
 	return nil
 }
 
@@ -360,16 +514,204 @@ func (wi *windowInternal) cleanup() {
 		}
 	}
 
+	// Release the mouse cursor (in case it was grabbed)
+	if wi.cursorMode == CursorModeRelative {
+		disableRawMouse()
+	}
+	wi.cursorMode = CursorModeNormal
+	wi.clipCursor()
+
 	// Unhide the mouse cursor (in case it was hidden)
 	wi.setMouseCursorVisible(true)
 }
 
+// Read a composition string from an input method context
+func immCompositionString(himc C.HIMC, index C.DWORD) []uint16 {
+	size := C.ImmGetCompositionStringW(himc, index, nil, 0)
+	if size <= 0 {
+		return nil
+	}
+
+	text := make([]uint16, size/2)
+	C.ImmGetCompositionStringW(himc, index, C.LPVOID(unsafe.Pointer(&text[0])), C.DWORD(size))
+	return text
+}
+
+// Handle a WM_IME_COMPOSITION message
+func (wi *windowInternal) processComposition(header EventHeader, lParam C.LPARAM) (events []Event) {
+	himc := C.ImmGetContext(wi.window.Handle)
+	if himc == nil {
+		return nil
+	}
+	defer C.ImmReleaseContext(wi.window.Handle, himc)
+
+	if lParam&C.GCS_RESULTSTR != 0 {
+		events = append(events, wi.textInput.commit(header, immCompositionString(himc, C.GCS_RESULTSTR))...)
+	}
+
+	if lParam&C.GCS_COMPSTR != 0 {
+		text := immCompositionString(himc, C.GCS_COMPSTR)
+		cursor := int(C.ImmGetCompositionStringW(himc, C.GCS_CURSORPOS, nil, 0))
+
+		// The clause being converted has target attributes, one byte per code unit
+		start, end := 0, 0
+		if size := C.ImmGetCompositionStringW(himc, C.GCS_COMPATTR, nil, 0); size > 0 {
+			attributes := make([]byte, size)
+			C.ImmGetCompositionStringW(himc, C.GCS_COMPATTR, C.LPVOID(unsafe.Pointer(&attributes[0])), C.DWORD(size))
+
+			start = -1
+			for i, a := range attributes {
+				if a == C.ATTR_TARGET_CONVERTED || a == C.ATTR_TARGET_NOTCONVERTED {
+					if start < 0 {
+						start = i
+					}
+					end = i + 1
+				}
+			}
+			if start < 0 {
+				start = 0
+			}
+		}
+
+		events = append(events, wi.textInput.compose(header, text, cursor, start, end)...)
+	}
+
+	return events
+}
+
+// Place the input method's composition and candidate windows at the text
+// being entered, in window coordinates
+func (wi *windowInternal) setTextInputRect(x, y int, width, height uint) ThreadError {
+	// Setting a rect means the application draws the composition. Hide the
+	// composition window now, rather than the next time the window gets
+	// the focus.
+	if !wi.drawsComposition {
+		wi.drawsComposition = true
+		if wi.focused {
+			C.SendMessage(wi.window.Handle, C.WM_IME_SETCONTEXT, C.TRUE, C.LPARAM(C.ISC_SHOWUIALL))
+		}
+	}
+
+	himc := C.ImmGetContext(wi.window.Handle)
+	if himc == nil {
+		// No input method is active
+		return nil
+	}
+	defer C.ImmReleaseContext(wi.window.Handle, himc)
+
+	composition := C.COMPOSITIONFORM{
+		dwStyle:      C.CFS_POINT,
+		ptCurrentPos: C.POINT{x: C.LONG(x), y: C.LONG(y)},
+	}
+	if C.ImmSetCompositionWindow(himc, &composition) == 0 {
+		return NewThreadError(fmt.Errorf("ImmSetCompositionWindow failed (%d)", C.GetLastError()), false)
+	}
+
+	// Keep the candidates from covering the text
+	candidate := C.CANDIDATEFORM{
+		dwIndex:      0,
+		dwStyle:      C.CFS_EXCLUDE,
+		ptCurrentPos: C.POINT{x: C.LONG(x), y: C.LONG(y + int(height))},
+		rcArea: C.RECT{
+			left:   C.LONG(x),
+			top:    C.LONG(y),
+			right:  C.LONG(x + int(width)),
+			bottom: C.LONG(y + int(height)),
+		},
+	}
+	if C.ImmSetCandidateWindow(himc, &candidate) == 0 {
+		return NewThreadError(fmt.Errorf("ImmSetCandidateWindow failed (%d)", C.GetLastError()), false)
+	}
+
+	return nil
+}
+
+// Get the time of a message being processed, as a monotonic timestamp.
+// Only queued messages have a time of their own. Sent messages, e.g. the
+// WM_SIZE from SetWindowPos, would get the time of the last queued message
+// from GetMessageTime, which may be long past, so they get the current time.
+func (wi *windowInternal) messageTimestamp(message C.UINT) time.Duration {
+	if wi.dispatched == nil || wi.dispatched.message != message || C.InSendMessage() != 0 {
+		return currentTimestamp()
+	}
+
+	// The message time is the low 32 bits of the tick count when the
+	// message was posted
+	now := uint64(C.GetTickCount64())
+	age := uint32(now) - uint32(wi.dispatched.time)
+	if age > 1<<31 {
+		age = 0 // posted "after" now, so it is not from a message queue
+	}
+	return time.Duration(now-uint64(age)) * time.Millisecond
+}
+
+// The current time on the same clock as messageTimestamp
+func currentTimestamp() time.Duration {
+	return time.Duration(C.GetTickCount64()) * time.Millisecond
+}
+
+// Report a WM_POINTERDOWN, WM_POINTERUPDATE or WM_POINTERUP message of a
+// touch or pen. Mouse pointers are reported by the mouse messages.
+func (wi *windowInternal) processPointer(header EventHeader, message C.UINT, wParam C.WPARAM) []Event {
+	pointer := uint32(C.__LOWORD(C.DWORD(wParam)))
+	var sample C.POINTERSAMPLE
+	if C.__GetPointerSample(wi.window.Handle, C.UINT32(pointer), &sample) == 0 {
+		return nil
+	}
+
+	x, y := int(sample.position.x), int(sample.position.y)
+	width, height := wi.getClientSize()
+	contact := sample.flags&C.POINTER_FLAG_INCONTACT != 0
+
+	switch sample.kind {
+	case C.PT_TOUCH:
+		switch {
+		case message == C.WM_POINTERDOWN:
+			return wi.touches.began(header, pointer, x, y, width, height)
+		case message == C.WM_POINTERUP && sample.flags&C.POINTER_FLAG_CANCELED != 0:
+			return wi.touches.cancelled(header, pointer, width, height)
+		case message == C.WM_POINTERUP:
+			return wi.touches.ended(header, pointer, x, y, width, height)
+		case contact: // Some screens also report fingers hovering
+			return wi.touches.moved(header, pointer, x, y, width, height)
+		}
+
+	case C.PT_PEN:
+		pen := penSample{
+			x:       x,
+			y:       y,
+			contact: contact && message != C.WM_POINTERUP,
+			eraser:  sample.penFlags&(C.PEN_FLAG_ERASER|C.PEN_FLAG_INVERTED) != 0,
+			barrel:  sample.penFlags&C.PEN_FLAG_BARREL != 0,
+		}
+		if sample.penMask&C.PEN_MASK_PRESSURE != 0 {
+			pen.pressure = float64(sample.pressure) / 1024
+		} else if pen.contact {
+			pen.pressure = 1
+		}
+		if sample.penMask&C.PEN_MASK_TILT_X != 0 {
+			pen.tiltX = float64(sample.tiltX)
+		}
+		if sample.penMask&C.PEN_MASK_TILT_Y != 0 {
+			pen.tiltY = float64(sample.tiltY)
+		}
+		return wi.touches.pen(header, pointer, pen, width, height)
+	}
+
+	return nil
+}
+
 func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C.LPARAM) (events []Event, eventErrors []ThreadError) {
 	// Don't process any message until window is created
 	if wi.window.Handle == nil {
 		return
 	}
 
+	header := EventHeader{
+		Time:   wi.messageTimestamp(message),
+		Source: wi.owner,
+	}
+
 	switch message {
 	case C.WM_DESTROY: // Destroy event
 		// Here we must cleanup resources !
@@ -412,12 +754,7 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 
 			}
 
-			// Unlock mouse if locked
-			// if !_glfwWin.oldMouseLockValid {
-			// 	_glfwWin.oldMouseLock = _glfwWin.mouseLock;
-			// 	_glfwWin.oldMouseLockValid = GL_TRUE;
-			// 	glfwEnable( GLFW_MOUSE_CURSOR );
-			// }
+			// The mouse cursor is released by WM_KILLFOCUS
 		} else if !wi.inactive || !minimized {
 			// If we are in fullscreen mode we need to maximize
 			if wi.monitor != nil && wi.monitor.IsValid() && wi.minimized {
@@ -439,11 +776,7 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 					C.SetFocus(wi.window.Handle)
 				}
 
-				// Lock mouse, if necessary
-				// if _glfwWin.oldMouseLockValid && _glfwWin.oldMouseLock {
-				// 	glfwDisable( GLFW_MOUSE_CURSOR );
-				// }
-				// _glfwWin.oldMouseLockValid = GL_FALSE;
+				// The mouse cursor is grabbed again by WM_SETFOCUS
 			}
 		}
 
@@ -452,26 +785,85 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 	case C.WM_SETCURSOR: // Set cursor event
 		// The mouse has moved, if the cursor is in our window we must refresh the cursor
 		if C.__LOWORD(C.DWORD(lParam)) == C.HTCLIENT {
-			C.SetCursor(wi.cursor)
+			wi.refreshCursor()
 		}
 
 	case C.WM_CLOSE: // Close event
-		events = append(events, WindowClosedEvent{})
+		events = append(events, WindowClosedEvent{EventHeader: header})
 
 	case C.WM_SIZE: // Resize event
+		// Keep a confined cursor inside the new client area
+		if wi.cursorClipped {
+			if err := wi.clipCursor(); err != nil {
+				eventErrors = append(eventErrors, err)
+			}
+		}
+
+		// Report minimize, maximize and restore transitions
+		if wParam != wi.sizeState {
+			switch wParam {
+			case C.SIZE_MINIMIZED:
+				events = append(events, WindowMinimizedEvent{EventHeader: header})
+			case C.SIZE_MAXIMIZED:
+				events = append(events, WindowMaximizedEvent{EventHeader: header})
+			case C.SIZE_RESTORED:
+				events = append(events, WindowRestoredEvent{EventHeader: header})
+			}
+			if wParam == C.SIZE_MINIMIZED || wParam == C.SIZE_MAXIMIZED || wParam == C.SIZE_RESTORED {
+				wi.sizeState = wParam
+			}
+		}
+
 		// Consider only events triggered by a maximize or a un-maximize
 		if wParam == C.SIZE_MINIMIZED || wi.resizing {
 			break
 		}
 
-		// Ignore cases where the window has only been moved		
+		// Ignore cases where the window has only been moved
 		if x, y := wi.getSize(); wi.lastSizeX == x && wi.lastSizeY == y {
 			break
+		} else {
+			wi.lastSizeX, wi.lastSizeY = x, y
 		}
 
 		events = append(events, WindowResizeEvent{
-			Width:  wi.lastSizeX,
-			Height: wi.lastSizeY,
+			EventHeader: header,
+			Width:       wi.lastSizeX,
+			Height:      wi.lastSizeY,
+		})
+
+	case C.WM_MOVE: // Move event
+		// Keep a confined cursor inside the new client area
+		if wi.cursorClipped {
+			if err := wi.clipCursor(); err != nil {
+				eventErrors = append(eventErrors, err)
+			}
+		}
+
+		// Minimized windows are moved off screen
+		if C.IsIconic(wi.window.Handle) != 0 {
+			break
+		}
+
+		events = append(events, WindowMovedEvent{
+			EventHeader: header,
+			X:           int(int16(C.__LOWORD(C.DWORD(lParam)))),
+			Y:           int(int16(C.__HIWORD(C.DWORD(lParam)))),
+		})
+
+	case C.WM_PAINT: // Expose event
+		// The default window procedure validates the region after this
+		var rect C.RECT
+		if C.GetUpdateRect(wi.window.Handle, &rect, C.FALSE) == 0 {
+			break
+		}
+
+		events = append(events, WindowExposedEvent{
+			EventHeader: header,
+			X:           int(rect.left),
+			Y:           int(rect.top),
+			Width:       uint(rect.right - rect.left),
+			Height:      uint(rect.bottom - rect.top),
 		})
 
 	case C.WM_ENTERSIZEMOVE: // Start resizing
@@ -487,95 +879,154 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 		}
 
 		events = append(events, WindowResizeEvent{
-			Width:  wi.lastSizeX,
-			Height: wi.lastSizeY,
+			EventHeader: header,
+			Width:       wi.lastSizeX,
+			Height:      wi.lastSizeY,
 		})
 
 	case C.WM_KILLFOCUS: // Lost focus event
-		events = append(events, WindowGainedFocusEvent{})
+		// Give the mouse cursor back to the other windows
+		wi.focused = false
+		if err := wi.clipCursor(); err != nil {
+			eventErrors = append(eventErrors, err)
+		}
+
+		// Touches and pens held down won't be seen to end
+		width, height := wi.getClientSize()
+		events = append(events, wi.touches.cancelAll(header, width, height)...)
+
+		events = append(events, WindowLostFocusEvent{EventHeader: header})
 
 	case C.WM_SETFOCUS: // Gain focus event
-		events = append(events, WindowLostFocusEvent{})
+		wi.focused = true
+		if err := wi.clipCursor(); err != nil {
+			eventErrors = append(eventErrors, err)
+		}
+
+		events = append(events, WindowGainedFocusEvent{EventHeader: header})
 
 	case C.WM_CHAR: // Text event
 		if !wi.keyRepeatEnabled && lParam&(1<<30) != 0 {
 			break
 		}
 
-		events = append(events, TextEnteredEvent{
-			Character: rune(wParam),
+		events = append(events, wi.textInput.char(header, uint16(wParam))...)
+
+	case C.WM_IME_COMPOSITION: // Input method composition event
+		events = append(events, wi.processComposition(header, lParam)...)
+
+	case C.WM_IME_ENDCOMPOSITION: // Input method composition end event
+		events = append(events, wi.textInput.end(header)...)
+
+	case C.WM_INPUTLANGCHANGE: // Keyboard layout change event
+		events = append(events, KeyboardLayoutChangedEvent{
+			EventHeader: header,
+			Keymap:      currentKeymap(),
 		})
 
 	case C.WM_KEYDOWN, C.WM_SYSKEYDOWN: // Keydown event
+		noteSharedKey(wParam, lParam, true)
 		if !wi.keyRepeatEnabled && C.__HIWORD(C.DWORD(lParam))&C.KF_REPEAT != 0 {
 			break
 		}
 
 		events = append(events, KeyPressedEvent{
-			Code:    virtualKeyCodeToSF(wParam, lParam),
-			Alt:     C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_MENU))) != 0,
-			Control: C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_CONTROL))) != 0,
-			Shift:   C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_SHIFT))) != 0,
-			System:  C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_LWIN))) != 0 || C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_RWIN))) != 0,
+			EventHeader: header,
+			Code:        virtualKeyCodeToSF(wParam, lParam),
+			Scancode:    keyMessageScancode(lParam),
+			Physical:    ScancodeToPhysicalKey(keyMessageScancode(lParam)),
+			Modifiers:   messageModifiers(),
 		})
 
 	case C.WM_KEYUP, C.WM_SYSKEYUP: // Keyup event
+		noteSharedKey(wParam, lParam, false)
 		events = append(events, KeyReleasedEvent{
-			Code:    virtualKeyCodeToSF(wParam, lParam),
-			Alt:     C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_MENU))) != 0,
-			Control: C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_CONTROL))) != 0,
-			Shift:   C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_SHIFT))) != 0,
-			System:  C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_LWIN))) != 0 || C.__HIWORD(C.DWORD(C.GetAsyncKeyState(C.VK_RWIN))) != 0,
+			EventHeader: header,
+			Code:        virtualKeyCodeToSF(wParam, lParam),
+			Scancode:    keyMessageScancode(lParam),
+			Physical:    ScancodeToPhysicalKey(keyMessageScancode(lParam)),
+			Modifiers:   messageModifiers(),
 		})
 
-	case C.WM_MOUSEWHEEL: // Mouse wheel event
+	case C.WM_MOUSEWHEEL, C.WM_MOUSEHWHEEL: // Mouse wheel event
 		// Mouse position is in screen coordinates, convert it to window coordinates
 		position := C.POINT{
-			x: C.LONG(C.__LOWORD(C.DWORD(lParam))),
-			y: C.LONG(C.__HIWORD(C.DWORD(lParam))),
+			x: C.LONG(int16(C.__LOWORD(C.DWORD(lParam)))),
+			y: C.LONG(int16(C.__HIWORD(C.DWORD(lParam)))),
 		}
 		C.__ScreenToClient(wi.window.Handle, &position)
 
-		events = append(events, MouseWheelEvent{
-			Delta: int(int16(C.__HIWORD(C.DWORD(wParam))) / 120),
-			X:     int(position.x),
-			Y:     int(position.y),
-		})
+		// High resolution wheels and touchpads send fractions of WHEEL_DELTA
+		delta := int(int16(C.__HIWORD(C.DWORD(wParam))))
+		scroll := MouseScrollEvent{
+			EventHeader: header,
+			X:           int(position.x),
+			Y:           int(position.y),
+			Modifiers:   messageModifiers(),
+		}
+
+		if message == C.WM_MOUSEWHEEL {
+			scroll.DeltaY = float64(delta) / C.WHEEL_DELTA
+
+			if notches := wi.wheel.add(delta, C.WHEEL_DELTA); notches != 0 {
+				events = append(events, MouseWheelEvent{
+					EventHeader: header,
+					Delta:       notches,
+					X:           int(position.x),
+					Y:           int(position.y),
+					Modifiers:   scroll.Modifiers,
+				})
+			}
+		} else {
+			scroll.DeltaX = float64(delta) / C.WHEEL_DELTA
+		}
+
+		events = append(events, scroll)
 
 	case C.WM_LBUTTONDOWN, C.WM_RBUTTONDOWN: // Mouse left/right button down event
 		button := mouse_vkeys_handed_map[mouseKey{message, C.GetSystemMetrics(C.SM_SWAPBUTTON) == C.TRUE}]
 		events = append(events, MouseButtonPressedEvent{
-			Button: button,
-			X:      int(C.__LOWORD(C.DWORD(lParam))),
-			Y:      int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			Button:      button,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		})
 
 	case C.WM_LBUTTONUP, C.WM_RBUTTONUP: // Mouse left/right button up event
 		button := mouse_vkeys_handed_map[mouseKey{message, C.GetSystemMetrics(C.SM_SWAPBUTTON) == C.TRUE}]
 		events = append(events, MouseButtonReleasedEvent{
-			Button: button,
-			X:      int(C.__LOWORD(C.DWORD(lParam))),
-			Y:      int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			Button:      button,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		})
 
 	case C.WM_MBUTTONDOWN: // Mouse wheel button down event
 		events = append(events, MouseButtonPressedEvent{
-			Button: MouseMiddle,
-			X:      int(C.__LOWORD(C.DWORD(lParam))),
-			Y:      int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			Button:      MouseMiddle,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		})
 
 	case C.WM_MBUTTONUP: // Mouse wheel button up event
 		events = append(events, MouseButtonReleasedEvent{
-			Button: MouseMiddle,
-			X:      int(C.__LOWORD(C.DWORD(lParam))),
-			Y:      int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			Button:      MouseMiddle,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		})
 
 	case C.WM_XBUTTONDOWN: // Mouse X button down event
 		event := MouseButtonPressedEvent{
-			X: int(C.__LOWORD(C.DWORD(lParam))),
-			Y: int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		}
 
 		switch C.__HIWORD(C.DWORD(wParam)) {
@@ -590,9 +1041,11 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 		events = append(events, event)
 
 	case C.WM_XBUTTONUP: // Mouse X button up event
-		event := MouseButtonPressedEvent{
-			X: int(C.__LOWORD(C.DWORD(lParam))),
-			Y: int(C.__HIWORD(C.DWORD(lParam))),
+		event := MouseButtonReleasedEvent{
+			EventHeader: header,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+			Modifiers:   messageModifiers(),
 		}
 
 		switch C.__HIWORD(C.DWORD(wParam)) {
@@ -618,17 +1071,50 @@ func (wi *windowInternal) processEvent(message C.UINT, wParam C.WPARAM, lParam C
 
 			wi.isCursorIn = true
 
-			events = append(events, MouseEnteredEvent{})
+			events = append(events, MouseEnteredEvent{EventHeader: header})
+		}
+
+		// Movement of a relative cursor is reported by WM_INPUT
+		if wi.cursorMode == CursorModeRelative && wi.focused {
+			break
 		}
 
 		events = append(events, MouseMoveEvent{
-			X: int(C.__LOWORD(C.DWORD(lParam))),
-			Y: int(C.__HIWORD(C.DWORD(lParam))),
+			EventHeader: header,
+			X:           int(C.__LOWORD(C.DWORD(lParam))),
+			Y:           int(C.__HIWORD(C.DWORD(lParam))),
+		})
+
+	case C.WM_INPUT: // Raw mouse motion event
+		var dx, dy C.LONG
+		if wi.cursorMode != CursorModeRelative || !wi.focused || C.__GetRawMouseMotion(lParam, &dx, &dy) == 0 {
+			break
+		}
+		if dx == 0 && dy == 0 {
+			break // e.g. only a button changed
+		}
+
+		events = append(events, MouseRawMotionEvent{
+			EventHeader: header,
+			DX:          int(dx),
+			DY:          int(dy),
 		})
 
 	case C.WM_MOUSELEAVE: // Mouse leave event
 		wi.isCursorIn = false
-		events = append(events, MouseLeftEvent{})
+		events = append(events, MouseLeftEvent{EventHeader: header})
+
+	case C.WM_POINTERDOWN, C.WM_POINTERUPDATE, C.WM_POINTERUP: // Touch and pen event
+		events = append(events, wi.processPointer(header, message, wParam)...)
+
+	case C.WM_POINTERLEAVE: // Pen out of range event
+		events = append(events, wi.touches.penLeft(header, uint32(C.__LOWORD(C.DWORD(wParam))))...)
+
+	case C.WM_POINTERCAPTURECHANGED: // Touch and pen taken by another window event
+		pointer := uint32(C.__LOWORD(C.DWORD(wParam)))
+		width, height := wi.getClientSize()
+		events = append(events, wi.touches.cancelled(header, pointer, width, height)...)
+		events = append(events, wi.touches.penLeft(header, pointer)...)
 
 	}
 
@@ -647,8 +1133,18 @@ func globalOnEvent(handle C.HWND, message C.UINT, wParam C.WPARAM, lParam C.LPAR
 	}
 
 	// Get the WindowImpl instance corresponding to the window handle
+	wi := (*windowInternal)(C.__GetWindowLongPtr(handle, C.GWLP_USERDATA))
+	drawsComposition := wi != nil && wi.drawsComposition
+
+	// Once the application draws the composition from TextCompositionEvent,
+	// hide the input method's composition window. The candidate list is
+	// still shown.
+	if message == C.WM_IME_SETCONTEXT && drawsComposition {
+		lParam = C.LPARAM(uintptr(lParam) &^ C.ISC_SHOWUICOMPOSITIONWINDOW)
+	}
+
 	// Forward the event to the appropriate function
-	if wi := (*windowInternal)(C.__GetWindowLongPtr(handle, C.GWLP_USERDATA)); wi != nil {
+	if wi != nil {
 		events, errors := wi.processEvent(message, wParam, lParam)
 		wi.events = append(wi.events, events...)
 		wi.eventErrors = append(wi.eventErrors, errors...)
@@ -663,5 +1159,16 @@ func globalOnEvent(handle C.HWND, message C.UINT, wParam C.WPARAM, lParam C.LPAR
 		return 0
 	}
 
+	// Once the application draws the composition, we don't forward the
+	// composition messages, which would draw it over the application's
+	if drawsComposition && (message == C.WM_IME_STARTCOMPOSITION || message == C.WM_IME_COMPOSITION) {
+		return 0
+	}
+
+	// We don't forward WM_IME_CHAR, which would repeat the committed text as WM_CHAR
+	if message == C.WM_IME_CHAR {
+		return 0
+	}
+
 	return C.DefWindowProcW(handle, message, wParam, lParam)
 }
@@ -0,0 +1,178 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"strconv"
+	"time"
+)
+
+// The number of controllers XInput reports, XUSER_MAX_COUNT
+const xinputMaxCount = 4
+
+// How often the empty slots are checked for a new controller. Querying an
+// empty slot can stall for milliseconds, so it isn't done every frame.
+const xinputScanInterval = time.Second
+
+// The XInput slots with a controller connected
+type xinputSlots struct {
+	connected [xinputMaxCount]bool
+	scanned   time.Duration // When the empty slots were last checked
+	scanning  bool          // Whether they have been checked at all
+}
+
+// List the connected controllers, as "xinput0" to "xinput3". The connected
+// slots are queried every time, the empty ones only when a scan is due.
+func (s *xinputSlots) devices(now time.Duration, query func(slot int) bool) []string {
+	scan := !s.scanning || now-s.scanned >= xinputScanInterval
+	if scan {
+		s.scanned, s.scanning = now, true
+	}
+
+	var paths []string
+	for i := range s.connected {
+		if s.connected[i] || scan {
+			s.connected[i] = query(i)
+		}
+		if s.connected[i] {
+			paths = append(paths, "xinput"+strconv.Itoa(i))
+		}
+	}
+	return paths
+}
+
+// The state of an XInput controller, from XINPUT_GAMEPAD
+type xinputState struct {
+	packet                    uint32 // Changes whenever the state does
+	buttons                   uint16 // XINPUT_GAMEPAD_* bits
+	leftTrigger, rightTrigger uint8
+	thumbLX, thumbLY          int16 // Up is positive
+	thumbRX, thumbRY          int16
+}
+
+// The evdev codes XInput buttons are reported as, the ones the Linux xpad
+// driver uses, so they are numbered as SDL numbers XInput buttons
+var xinputButtons = [...]struct {
+	mask uint16
+	code uint16
+}{
+	{0x1000, 0x130}, // XINPUT_GAMEPAD_A as BTN_SOUTH
+	{0x2000, 0x131}, // XINPUT_GAMEPAD_B as BTN_EAST
+	{0x4000, 0x133}, // XINPUT_GAMEPAD_X as BTN_NORTH
+	{0x8000, 0x134}, // XINPUT_GAMEPAD_Y as BTN_WEST
+	{0x0100, 0x136}, // XINPUT_GAMEPAD_LEFT_SHOULDER as BTN_TL
+	{0x0200, 0x137}, // XINPUT_GAMEPAD_RIGHT_SHOULDER as BTN_TR
+	{0x0020, 0x13A}, // XINPUT_GAMEPAD_BACK as BTN_SELECT
+	{0x0010, 0x13B}, // XINPUT_GAMEPAD_START as BTN_START
+	{0x0040, 0x13D}, // XINPUT_GAMEPAD_LEFT_THUMB as BTN_THUMBL
+	{0x0080, 0x13E}, // XINPUT_GAMEPAD_RIGHT_THUMB as BTN_THUMBR
+}
+
+// The directional pad bits, reported as the first hat
+const (
+	xinputDpadUp    = 0x0001
+	xinputDpadDown  = 0x0002
+	xinputDpadLeft  = 0x0004
+	xinputDpadRight = 0x0008
+)
+
+// The name XInput controllers are reported with. XInput doesn't tell the
+// vendor and product, so the GUID is made from it.
+const xinputName = "XInput Controller"
+
+// The layout of xinputEvents, as a gamepad mapping without a GUID
+const xinputMapping = xinputName + ",a:b0,b:b1,x:b2,y:b3,leftshoulder:b4,rightshoulder:b5,back:b6,start:b7,leftstick:b8,rightstick:b9," +
+	"leftx:a0,lefty:a1,lefttrigger:a2,rightx:a3,righty:a4,righttrigger:a5,dpup:h0.1,dpright:h0.2,dpdown:h0.4,dpleft:h0.8"
+
+// The gamepad mapping of XInput controllers
+func xinputGamepadMapping() string {
+	return joystickGUID(xinputInfo(xinputState{})) + "," + xinputMapping
+}
+
+// Report the axes of a state as evdev axes: the sticks as ABS_X, ABS_Y,
+// ABS_RX and ABS_RY, the triggers as ABS_Z and ABS_RZ, and the directional
+// pad as ABS_HAT0X and ABS_HAT0Y. As with evdev, down is positive.
+func xinputAxes(state xinputState) []EvdevAxis {
+	hat := func(negative, positive uint16) int32 {
+		switch {
+		case state.buttons&negative != 0:
+			return -1
+		case state.buttons&positive != 0:
+			return 1
+		}
+		return 0
+	}
+
+	// Flipping every bit maps -32768 to 32767 and back, keeping the range
+	return []EvdevAxis{
+		{Code: 0x00, Value: int32(state.thumbLX), Min: -32768, Max: 32767, Flat: 128},
+		{Code: 0x01, Value: int32(^state.thumbLY), Min: -32768, Max: 32767, Flat: 128},
+		{Code: 0x02, Value: int32(state.leftTrigger), Min: 0, Max: 255},
+		{Code: 0x03, Value: int32(state.thumbRX), Min: -32768, Max: 32767, Flat: 128},
+		{Code: 0x04, Value: int32(^state.thumbRY), Min: -32768, Max: 32767, Flat: 128},
+		{Code: 0x05, Value: int32(state.rightTrigger), Min: 0, Max: 255},
+		{Code: evdevAbsHat0X, Value: hat(xinputDpadLeft, xinputDpadRight), Min: -1, Max: 1},
+		{Code: evdevAbsHat0X + 1, Value: hat(xinputDpadUp, xinputDpadDown), Min: -1, Max: 1},
+	}
+}
+
+// Describe an XInput controller as an evdev device, which can rumble
+func xinputInfo(state xinputState) EvdevInfo {
+	info := EvdevInfo{
+		Name:          xinputName,
+		Axes:          xinputAxes(state),
+		ForceFeedback: []uint16{evdevFFRumble},
+		Effects:       1,
+	}
+	for _, button := range xinputButtons {
+		info.Keys = append(info.Keys, button.code)
+		if state.buttons&button.mask != 0 {
+			info.Pressed = append(info.Pressed, button.code)
+		}
+	}
+	return info
+}
+
+// Report the changes between two states as evdev events, ending with a
+// SYN_REPORT, or nothing if the state didn't change
+func xinputEvents(previous, current xinputState) []evdevEvent {
+	var events []evdevEvent
+	for _, button := range xinputButtons {
+		if (previous.buttons^current.buttons)&button.mask != 0 {
+			value := int32(0)
+			if current.buttons&button.mask != 0 {
+				value = 1
+			}
+			events = append(events, evdevEvent{evdevKey, button.code, value})
+		}
+	}
+
+	previousAxes := xinputAxes(previous)
+	for i, axis := range xinputAxes(current) {
+		if axis.Value != previousAxes[i].Value {
+			events = append(events, evdevEvent{evdevAbs, axis.Code, axis.Value})
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+	return append(events, evdevEvent{evdevSyn, evdevSynReport, 0})
+}
+
+// A rumble effect uploaded to an XInput controller
+type xinputRumble struct {
+	strong, weak uint16        // The magnitudes of the low and high frequency motors
+	length       time.Duration // 0 to rumble until stopped
+}
+
+// Decode the FF_RUMBLE struct ff_effect made by encodeEvdevRumble
+func decodeXInputRumble(effect []byte) (xinputRumble, bool) {
+	if len(effect) < 20 || evdevByteOrder.Uint16(effect[0:]) != evdevFFRumble {
+		return xinputRumble{}, false
+	}
+	return xinputRumble{
+		strong: evdevByteOrder.Uint16(effect[16:]),
+		weak:   evdevByteOrder.Uint16(effect[18:]),
+		length: time.Duration(evdevByteOrder.Uint16(effect[10:])) * time.Millisecond,
+	}, true
+}
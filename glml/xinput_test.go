@@ -0,0 +1,117 @@
+// Copyright © 2012 Popog
+package glml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestXInputEvents(t *testing.T) {
+	previous := xinputState{packet: 1}
+	current := xinputState{packet: 2, buttons: 0x1000 | xinputDpadUp, thumbLY: 32767, rightTrigger: 255}
+	expected := []evdevEvent{
+		{evdevKey, 0x130, 1},
+		{evdevAbs, 0x01, -32768},
+		{evdevAbs, 0x05, 255},
+		{evdevAbs, evdevAbsHat0X + 1, -1},
+		{evdevSyn, evdevSynReport, 0},
+	}
+	if events := xinputEvents(previous, current); !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %v, got %v", expected, events)
+	}
+
+	if events := xinputEvents(current, current); events != nil {
+		t.Errorf("expected no events for an unchanged state, got %v", events)
+	}
+}
+
+func TestXInputGamepadMapping(t *testing.T) {
+	pad := &fakeJoystickDevice{info: xinputInfo(xinputState{})}
+	m := NewJoystickManagerFromSource(fakeJoystickSource{"xinput0": pad})
+	if err := m.AddGamepadMappings(strings.NewReader(xinputGamepadMapping())); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	j := m.Joystick(0)
+	if j == nil || !j.IsGamepad() {
+		t.Fatalf("expected a gamepad, got %+v", j)
+	}
+	if j.ButtonCount() != 10 || j.AxisCount() != 6 || j.HatCount() != 1 {
+		t.Errorf("expected 10 buttons, 6 axes and 1 hat, got %d, %d and %d", j.ButtonCount(), j.AxisCount(), j.HatCount())
+	}
+	if caps := j.Capabilities(); caps.Rumble {
+		t.Error("expected no rumble from a device without feedback")
+	}
+
+	// Up and left on the stick, Y, the left trigger and down on the pad
+	pad.record(xinputEvents(xinputState{}, xinputState{
+		packet:      1,
+		buttons:     0x8000 | xinputDpadDown,
+		thumbLX:     -32768,
+		thumbLY:     32767,
+		leftTrigger: 255,
+	})...)
+	if _, err := m.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	state, ok := j.Gamepad()
+	if !ok {
+		t.Fatal("expected a gamepad state")
+	}
+	if !state.Buttons[GamepadY] || !state.Buttons[GamepadDPadDown] || state.Buttons[GamepadA] || state.Buttons[GamepadDPadUp] {
+		t.Errorf("unexpected gamepad buttons %v", state.Buttons)
+	}
+	if state.Axes[GamepadLeftX] != -1 || state.Axes[GamepadLeftY] != -1 || state.Axes[GamepadLeftTrigger] != 1 || state.Axes[GamepadRightTrigger] != 0 {
+		t.Errorf("unexpected gamepad axes %v", state.Axes)
+	}
+}
+
+func TestDecodeXInputRumble(t *testing.T) {
+	rumble, ok := decodeXInputRumble(encodeEvdevRumble(-1, 0xFFFF, 0x8000, 250*time.Millisecond))
+	expected := xinputRumble{strong: 0xFFFF, weak: 0x8000, length: 250 * time.Millisecond}
+	if !ok || rumble != expected {
+		t.Errorf("expected %+v, got %+v, %v", expected, rumble, ok)
+	}
+
+	if _, ok := decodeXInputRumble(make([]byte, evdevEffectSize)); ok {
+		t.Error("expected an effect which isn't FF_RUMBLE to be rejected")
+	}
+}
+
+func TestXInputSlots(t *testing.T) {
+	var slots xinputSlots
+	connected := map[int]bool{1: true}
+	var queried []int
+	devices := func(now time.Duration) []string {
+		queried = nil
+		return slots.devices(now, func(slot int) bool {
+			queried = append(queried, slot)
+			return connected[slot]
+		})
+	}
+
+	// The first call checks every slot
+	if paths := devices(0); !reflect.DeepEqual(paths, []string{"xinput1"}) || len(queried) != xinputMaxCount {
+		t.Errorf("expected xinput1 from every slot, got %v from %v", paths, queried)
+	}
+
+	// Until the next scan, only the connected slot is queried
+	connected[3] = true
+	if paths := devices(xinputScanInterval / 2); !reflect.DeepEqual(paths, []string{"xinput1"}) || !reflect.DeepEqual(queried, []int{1}) {
+		t.Errorf("expected xinput1 from slot 1, got %v from %v", paths, queried)
+	}
+	if paths := devices(xinputScanInterval); !reflect.DeepEqual(paths, []string{"xinput1", "xinput3"}) || len(queried) != xinputMaxCount {
+		t.Errorf("expected xinput1 and xinput3 from every slot, got %v from %v", paths, queried)
+	}
+
+	// A controller which is unplugged is noticed right away
+	delete(connected, 1)
+	if paths := devices(xinputScanInterval + 1); !reflect.DeepEqual(paths, []string{"xinput3"}) || !reflect.DeepEqual(queried, []int{1, 3}) {
+		t.Errorf("expected xinput3 from slots 1 and 3, got %v from %v", paths, queried)
+	}
+}